@@ -0,0 +1,9 @@
+// Retrieve a specific Azure DNS Task Group by filters
+data "nios_cloud_azure_dns_task_group" "get_azure_dns_task_group_by_filters" {
+  filters = {
+    name = "example_azure_task_group"
+  }
+}
+
+// Retrieve all Azure DNS Task Groups
+data "nios_cloud_azure_dns_task_group" "get_all_azure_dns_task_groups" {}
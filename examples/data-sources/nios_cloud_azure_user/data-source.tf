@@ -0,0 +1,9 @@
+// Retrieve a specific Azure User by filters
+data "nios_cloud_azure_user" "get_azure_user_by_filters" {
+  filters = {
+    name = "azure-user"
+  }
+}
+
+// Retrieve all Azure Users
+data "nios_cloud_azure_user" "get_all_azure_users" {}
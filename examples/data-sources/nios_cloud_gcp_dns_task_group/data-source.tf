@@ -0,0 +1,9 @@
+// Retrieve a specific GCP DNS Task Group by filters
+data "nios_cloud_gcp_dns_task_group" "get_gcp_dns_task_group_by_filters" {
+  filters = {
+    name = "example_gcp_task_group"
+  }
+}
+
+// Retrieve all GCP DNS Task Groups
+data "nios_cloud_gcp_dns_task_group" "get_all_gcp_dns_task_groups" {}
@@ -0,0 +1,9 @@
+// Retrieve a specific GCP User by filters
+data "nios_cloud_gcp_user" "get_gcp_user_by_filters" {
+  filters = {
+    user_name = "gcp-user"
+  }
+}
+
+// Retrieve all GCP Users
+data "nios_cloud_gcp_user" "get_all_gcp_users" {}
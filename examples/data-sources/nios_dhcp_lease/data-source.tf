@@ -0,0 +1,17 @@
+// Retrieve the DHCP leases of a specific network
+data "nios_dhcp_lease" "get_leases_in_network" {
+  filters = {
+    network      = "10.0.0.0/24"
+    network_view = "default"
+  }
+}
+
+// Retrieve a specific DHCP lease by address
+data "nios_dhcp_lease" "get_lease_with_filter" {
+  filters = {
+    address = "10.0.0.25"
+  }
+}
+
+// Retrieve all DHCP leases
+data "nios_dhcp_lease" "get_all_leases" {}
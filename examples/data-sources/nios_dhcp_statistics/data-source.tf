@@ -0,0 +1,10 @@
+// Retrieve the DHCP utilization statistics of a network
+resource "nios_ipam_network" "example_network" {
+  network = "10.0.0.0/24"
+}
+
+data "nios_dhcp_statistics" "get_network_dhcp_statistics" {
+  filters = {
+    statistics_object = nios_ipam_network.example_network.ref
+  }
+}
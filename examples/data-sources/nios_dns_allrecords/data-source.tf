@@ -0,0 +1,34 @@
+// Retrieve all the records of a specific zone
+data "nios_dns_allrecords" "get_records_of_zone" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve the A records of a specific zone
+data "nios_dns_allrecords" "get_a_records_of_zone" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+    type = "record:a"
+  }
+}
+
+// Bring all the A records of the zone under Terraform management
+locals {
+  a_records = { for r in data.nios_dns_allrecords.get_a_records_of_zone.result : r.name => r }
+}
+
+import {
+  for_each = local.a_records
+  to       = nios_dns_record_a.imported[each.key]
+  id       = each.value.record
+}
+
+resource "nios_dns_record_a" "imported" {
+  for_each = local.a_records
+  name     = join(".", compact([each.key, "example.com"]))
+  ipv4addr = each.value.address
+  view     = "default"
+}
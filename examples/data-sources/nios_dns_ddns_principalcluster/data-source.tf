@@ -0,0 +1,9 @@
+// Retrieve a specific DDNS Principal Cluster by filters
+data "nios_dns_ddns_principalcluster" "get_ddns_principalcluster_using_filters" {
+  filters = {
+    name = "example-principal-cluster"
+  }
+}
+
+// Retrieve all DDNS Principal Clusters
+data "nios_dns_ddns_principalcluster" "get_all_ddns_principalclusters" {}
@@ -0,0 +1,16 @@
+// Retrieve a specific DNS64 Synthesis Group by filters
+data "nios_dns_dns64group" "get_dns64group_using_filters" {
+  filters = {
+    name = "example-dns64-group"
+  }
+}
+
+// Retrieve specific DNS64 Synthesis Groups using Extensible Attributes
+data "nios_dns_dns64group" "get_dns64groups_using_extensible_attributes" {
+  extattrfilters = {
+    Site = "location-1"
+  }
+}
+
+// Retrieve all DNS64 Synthesis Groups
+data "nios_dns_dns64group" "get_all_dns64groups" {}
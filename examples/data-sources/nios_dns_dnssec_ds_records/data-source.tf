@@ -0,0 +1,20 @@
+// Retrieve the DNSKEY set and the SHA-256 DS records of a signed zone
+data "nios_dns_dnssec_ds_records" "get_ds_records_using_filters" {
+  filters = {
+    view = "default"
+    fqdn = "example.com"
+  }
+}
+
+// Retrieve the DS records with SHA-256 and SHA-384 digests
+data "nios_dns_dnssec_ds_records" "get_ds_records_with_digest_types" {
+  filters = {
+    fqdn = "example.com"
+  }
+  digest_types = [2, 4]
+}
+
+// Output the DS records to publish at the parent zone
+output "ds_records" {
+  value = data.nios_dns_dnssec_ds_records.get_ds_records_using_filters.result[0].ds_records[*].record
+}
@@ -0,0 +1,10 @@
+// Retrieve the DHCID records of a specific zone and view
+data "nios_dns_record_dhcid" "get_records_using_filters" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve all DHCID records
+data "nios_dns_record_dhcid" "get_all_dhcid_records" {}
@@ -0,0 +1,10 @@
+// Retrieve the DNSKEY records of a specific zone and view
+data "nios_dns_record_dnskey" "get_records_using_filters" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve all DNSKEY records
+data "nios_dns_record_dnskey" "get_all_dnskey_records" {}
@@ -0,0 +1,10 @@
+// Retrieve the DS records of a specific zone and view
+data "nios_dns_record_ds" "get_records_using_filters" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve all DS records
+data "nios_dns_record_ds" "get_all_ds_records" {}
@@ -0,0 +1,10 @@
+// Retrieve the NSEC records of a specific zone and view
+data "nios_dns_record_nsec" "get_records_using_filters" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve all NSEC records
+data "nios_dns_record_nsec" "get_all_nsec_records" {}
@@ -0,0 +1,10 @@
+// Retrieve the NSEC3 records of a specific zone and view
+data "nios_dns_record_nsec3" "get_records_using_filters" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve all NSEC3 records
+data "nios_dns_record_nsec3" "get_all_nsec3_records" {}
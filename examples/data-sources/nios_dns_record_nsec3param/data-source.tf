@@ -0,0 +1,10 @@
+// Retrieve the NSEC3PARAM records of a specific zone and view
+data "nios_dns_record_nsec3param" "get_records_using_filters" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve all NSEC3PARAM records
+data "nios_dns_record_nsec3param" "get_all_nsec3param_records" {}
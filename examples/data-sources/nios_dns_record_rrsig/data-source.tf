@@ -0,0 +1,10 @@
+// Retrieve the RRSIG records of a specific zone and view
+data "nios_dns_record_rrsig" "get_records_using_filters" {
+  filters = {
+    zone = "example.com"
+    view = "default"
+  }
+}
+
+// Retrieve all RRSIG records
+data "nios_dns_record_rrsig" "get_all_rrsig_records" {}
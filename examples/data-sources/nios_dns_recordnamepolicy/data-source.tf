@@ -0,0 +1,9 @@
+// Retrieve a specific Record Name Policy by filters
+data "nios_dns_recordnamepolicy" "get_recordnamepolicy_using_filters" {
+  filters = {
+    name = "example-record-name-policy"
+  }
+}
+
+// Retrieve all Record Name Policies
+data "nios_dns_recordnamepolicy" "get_all_recordnamepolicies" {}
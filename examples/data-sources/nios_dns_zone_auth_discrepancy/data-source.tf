@@ -0,0 +1,20 @@
+// Retrieve the discrepancies of a specific authoritative zone
+data "nios_dns_zone_auth" "get_zone" {
+  filters = {
+    fqdn = "example.com"
+    view = "default"
+  }
+}
+
+data "nios_dns_zone_auth_discrepancy" "get_discrepancies_using_filters" {
+  filters = {
+    zone = data.nios_dns_zone_auth.get_zone.result[0].ref
+  }
+}
+
+// Retrieve all discrepancies with a severity of SEVERE
+data "nios_dns_zone_auth_discrepancy" "get_severe_discrepancies" {
+  filters = {
+    severity = "SEVERE"
+  }
+}
@@ -0,0 +1,9 @@
+// Retrieve all DTC records of a specific DTC Server by filters
+data "nios_dtc_allrecords" "get_dtc_records_using_filters" {
+  filters = {
+    dtc_server = "example-server"
+  }
+}
+
+// Retrieve all DTC records
+data "nios_dtc_allrecords" "get_all_dtc_records" {}
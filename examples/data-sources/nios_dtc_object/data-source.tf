@@ -0,0 +1,27 @@
+// Retrieve the health of a specific DTC Pool by filters
+data "nios_dtc_object" "get_dtc_pool_health_using_filters" {
+  filters = {
+    name          = "example-pool"
+    abstract_type = "POOL"
+  }
+}
+
+// Assert that the DTC Pool is healthy
+resource "terraform_data" "assert_dtc_pool_green" {
+  lifecycle {
+    precondition {
+      condition     = data.nios_dtc_object.get_dtc_pool_health_using_filters.result[0].status == "GREEN"
+      error_message = "DTC Pool example-pool is not healthy."
+    }
+  }
+}
+
+// Retrieve specific DTC objects using Extensible Attributes
+data "nios_dtc_object" "get_dtc_objects_using_extensible_attributes" {
+  extattrfilters = {
+    Site = "location-1"
+  }
+}
+
+// Retrieve all DTC objects
+data "nios_dtc_object" "get_all_dtc_objects" {}
@@ -0,0 +1,9 @@
+// Retrieve specific DTC Topology labels by filters
+data "nios_dtc_topology_label" "get_topology_labels_using_filters" {
+  filters = {
+    label = "Africa"
+  }
+}
+
+// Retrieve all DTC Topology labels
+data "nios_dtc_topology_label" "get_all_topology_labels" {}
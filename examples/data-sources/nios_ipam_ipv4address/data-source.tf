@@ -0,0 +1,25 @@
+// Retrieve a specific IPv4 address by filters
+data "nios_ipam_ipv4address" "get_ipv4address_with_filter" {
+  filters = {
+    ip_address   = "10.0.0.10"
+    network_view = "default"
+  }
+}
+
+// Retrieve the unused IPv4 addresses of a network
+data "nios_ipam_ipv4address" "get_unused_ipv4addresses" {
+  filters = {
+    network = "10.0.0.0/24"
+    status  = "UNUSED"
+  }
+}
+
+// Retrieve specific IPv4 addresses using Extensible Attributes
+data "nios_ipam_ipv4address" "get_ipv4addresses_with_extattr_filter" {
+  filters = {
+    network = "10.0.0.0/24"
+  }
+  extattrfilters = {
+    Site = "location-1"
+  }
+}
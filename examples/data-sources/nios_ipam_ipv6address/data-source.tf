@@ -0,0 +1,15 @@
+// Retrieve a specific IPv6 address by filters
+data "nios_ipam_ipv6address" "get_ipv6address_with_filter" {
+  filters = {
+    ip_address   = "2001:db8:abcd:12::10"
+    network_view = "default"
+  }
+}
+
+// Retrieve the used IPv6 addresses of a network
+data "nios_ipam_ipv6address" "get_used_ipv6addresses" {
+  filters = {
+    network = "2001:db8:abcd:12::/64"
+    status  = "USED"
+  }
+}
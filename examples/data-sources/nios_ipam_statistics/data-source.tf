@@ -0,0 +1,17 @@
+// Retrieve the IPAM utilization statistics of a network
+data "nios_ipam_statistics" "get_network_statistics" {
+  filters = {
+    network      = "10.0.0.0/24"
+    network_view = "default"
+  }
+}
+
+// Stop a deployment when the network is more than 90% utilized
+resource "terraform_data" "capacity_check" {
+  lifecycle {
+    precondition {
+      condition     = data.nios_ipam_statistics.get_network_statistics.result[0].utilization <= 90
+      error_message = "Network 10.0.0.0/24 is more than 90% utilized."
+    }
+  }
+}
@@ -0,0 +1,9 @@
+// Retrieve a specific Microsoft Server AD Sites Domain by filters
+data "nios_microsoft_msserver_adsites_domain" "get_msserver_adsites_domain_using_filters" {
+  filters = {
+    name = "example.local"
+  }
+}
+
+// Retrieve all Microsoft Server AD Sites Domains
+data "nios_microsoft_msserver_adsites_domain" "get_all_msserver_adsites_domains" {}
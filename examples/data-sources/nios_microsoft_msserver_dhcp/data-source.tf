@@ -0,0 +1,17 @@
+// Retrieve a specific Microsoft Server DHCP Service by filters
+data "nios_microsoft_msserver_dhcp" "get_msserver_dhcp_using_filters" {
+  filters = {
+    address = "10.10.0.10"
+  }
+}
+
+// Report the synchronization status and last synchronization time
+output "msserver_dhcp_sync" {
+  value = {
+    status       = data.nios_microsoft_msserver_dhcp.get_msserver_dhcp_using_filters.result[0].status
+    last_sync_ts = data.nios_microsoft_msserver_dhcp.get_msserver_dhcp_using_filters.result[0].last_sync_ts
+  }
+}
+
+// Retrieve all Microsoft Server DHCP Services
+data "nios_microsoft_msserver_dhcp" "get_all_msserver_dhcp" {}
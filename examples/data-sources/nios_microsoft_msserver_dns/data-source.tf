@@ -0,0 +1,9 @@
+// Retrieve a specific Microsoft Server DNS Service by filters
+data "nios_microsoft_msserver_dns" "get_msserver_dns_using_filters" {
+  filters = {
+    address = "10.10.0.11"
+  }
+}
+
+// Retrieve all Microsoft Server DNS Services
+data "nios_microsoft_msserver_dns" "get_all_msserver_dns" {}
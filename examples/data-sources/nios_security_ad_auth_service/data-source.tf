@@ -0,0 +1,9 @@
+// Retrieve a specific AD Authservice by filters
+data "nios_security_ad_auth_service" "get_ad_auth_service_using_filters" {
+  filters = {
+    name = "ad_auth_service1"
+  }
+}
+
+// Retrieve all AD Authservices
+data "nios_security_ad_auth_service" "get_all_ad_auth_services" {}
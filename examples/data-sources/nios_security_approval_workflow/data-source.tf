@@ -0,0 +1,16 @@
+// Retrieve a specific Approval Workflow by filters
+data "nios_security_approval_workflow" "get_approval_workflow_using_filters" {
+  filters = {
+    submitter_group = "submitters"
+  }
+}
+
+// Retrieve specific Approval Workflows using Extensible Attributes
+data "nios_security_approval_workflow" "get_approval_workflow_using_extattr_filter" {
+  extattrfilters = {
+    Site = "location-1"
+  }
+}
+
+// Retrieve all Approval Workflows
+data "nios_security_approval_workflow" "get_all_approval_workflows" {}
@@ -0,0 +1,9 @@
+// Retrieve a specific Entrust nShield HSM Group by filters
+data "nios_security_hsm_entrustnshieldgroup" "get_hsm_entrustnshieldgroup_using_filters" {
+  filters = {
+    name = "entrust_nshield_group1"
+  }
+}
+
+// Retrieve all Entrust nShield HSM Groups
+data "nios_security_hsm_entrustnshieldgroup" "get_all_hsm_entrustnshieldgroups" {}
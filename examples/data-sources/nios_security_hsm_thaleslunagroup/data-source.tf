@@ -0,0 +1,9 @@
+// Retrieve a specific Thales Luna HSM Group by filters
+data "nios_security_hsm_thaleslunagroup" "get_hsm_thaleslunagroup_using_filters" {
+  filters = {
+    name = "thales_luna_group1"
+  }
+}
+
+// Retrieve all Thales Luna HSM Groups
+data "nios_security_hsm_thaleslunagroup" "get_all_hsm_thaleslunagroups" {}
@@ -0,0 +1,2 @@
+// Retrieve the Local User Authservice
+data "nios_security_localuser_authservice" "get_localuser_authservice" {}
@@ -0,0 +1,9 @@
+// Retrieve a specific Network User by filters
+data "nios_security_network_user" "get_network_user_using_filters" {
+  filters = {
+    name = "jdoe"
+  }
+}
+
+// Retrieve all Network Users
+data "nios_security_network_user" "get_all_network_users" {}
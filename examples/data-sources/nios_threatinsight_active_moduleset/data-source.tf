@@ -0,0 +1,6 @@
+// Retrieve the Threat Insight Module Set currently installed on the Grid
+data "nios_threatinsight_active_moduleset" "active" {}
+
+output "installed_moduleset_version" {
+  value = data.nios_threatinsight_active_moduleset.active.version
+}
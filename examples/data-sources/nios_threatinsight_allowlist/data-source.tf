@@ -0,0 +1,9 @@
+// Retrieve a specific Threat Insight Allowlist entry by filters
+data "nios_threatinsight_allowlist" "get_allowlist_using_filters" {
+  filters = {
+    fqdn = "tunnel.example.com"
+  }
+}
+
+// Retrieve all Threat Insight Allowlist entries
+data "nios_threatinsight_allowlist" "get_all_allowlists" {}
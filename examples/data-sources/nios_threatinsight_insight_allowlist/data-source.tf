@@ -0,0 +1,2 @@
+// Retrieve all Threat Insight Allowlists
+data "nios_threatinsight_insight_allowlist" "get_all_insight_allowlists" {}
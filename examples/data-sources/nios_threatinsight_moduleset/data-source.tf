@@ -0,0 +1,2 @@
+// Retrieve all Threat Insight Module Sets
+data "nios_threatinsight_moduleset" "get_all_modulesets" {}
@@ -0,0 +1,2 @@
+// Retrieve the Threat Protection Ruleset currently used by the Grid
+data "nios_threatprotection_active_ruleset" "active" {}
@@ -0,0 +1,9 @@
+// Retrieve the Threat Protection Rule Categories of a ruleset by filters
+data "nios_threatprotection_rulecategory" "get_rulecategories_using_filters" {
+  filters = {
+    ruleset = "20240604-1"
+  }
+}
+
+// Retrieve all Threat Protection Rule Categories
+data "nios_threatprotection_rulecategory" "get_all_rulecategories" {}
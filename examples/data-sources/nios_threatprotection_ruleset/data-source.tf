@@ -0,0 +1,9 @@
+// Retrieve a specific Threat Protection Ruleset by filters
+data "nios_threatprotection_ruleset" "get_ruleset_using_filters" {
+  filters = {
+    version = "20240604-1"
+  }
+}
+
+// Retrieve all Threat Protection Rulesets
+data "nios_threatprotection_ruleset" "get_all_rulesets" {}
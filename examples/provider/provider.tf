@@ -12,4 +12,15 @@ provider "nios" {
   nios_username = "<NIOS_USERNAME>"
   nios_password = "<NIOS_PASSWORD>"
   retry_timeout = "<RETRY_TIMEOUT_IN_SECONDS>"
+  ssl_verify    = true
+  ca_cert_file  = "<PATH_TO_CA_BUNDLE>"
+
+  # Additional Grid connection, selected by resources and data sources with grid = "lab"
+  grid {
+    name          = "lab"
+    nios_host_url = "<LAB_NIOS_HOST_URL>"
+    nios_username = "<LAB_NIOS_USERNAME>"
+    nios_password = "<LAB_NIOS_PASSWORD>"
+    proxy_search  = "GM"
+  }
 }
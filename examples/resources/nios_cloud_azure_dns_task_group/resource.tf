@@ -0,0 +1,37 @@
+// Create an Azure User (Required as Parent)
+resource "nios_cloud_azure_user" "azure_user_basic_fields" {
+  client_id         = "9e5c4b6a-0f0a-4c3b-8f1f-6d2e4a7b9c10"
+  client_secret_key = "Xk8Q~exampleSecretValue0123456789abcdef"
+  name              = "azure-user"
+  tenant_id         = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+}
+
+// Create Azure DNS Task Group with Basic Fields
+resource "nios_cloud_azure_dns_task_group" "azure_dns_task_group_basic_fields" {
+  name                        = "example_azure_task_group"
+  grid_member                 = "infoblox.localdomain"
+  disabled                    = false
+  network_view_mapping_policy = "AUTO_CREATE"
+}
+
+// Create Azure DNS Task Group with Additional Fields
+resource "nios_cloud_azure_dns_task_group" "azure_dns_task_group_additional_fields" {
+  name                               = "example_azure_task_group_2"
+  grid_member                        = "infoblox.localdomain"
+  comment                            = "Azure DNS task group"
+  disabled                           = false
+  network_view_mapping_policy        = "DIRECT"
+  network_view                       = "default"
+  multiple_subscriptions_sync_policy = "UPLOAD_CHILDREN"
+  consolidate_zones                  = true
+  consolidated_view                  = "default"
+  azure_subscription_ids_file_path   = "<relative_path_to_file>/azure_subscription_ids.csv"
+  task_list = [
+    {
+      name              = "example-task1"
+      schedule_interval = "10"
+      schedule_units    = "MINS"
+      azure_user        = nios_cloud_azure_user.azure_user_basic_fields.ref
+    }
+  ]
+}
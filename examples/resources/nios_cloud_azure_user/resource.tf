@@ -0,0 +1,7 @@
+// Create Azure User with Basic Fields
+resource "nios_cloud_azure_user" "azure_user_basic_fields" {
+  client_id         = "9e5c4b6a-0f0a-4c3b-8f1f-6d2e4a7b9c10"
+  client_secret_key = "Xk8Q~exampleSecretValue0123456789abcdef"
+  name              = "azure-user"
+  tenant_id         = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+}
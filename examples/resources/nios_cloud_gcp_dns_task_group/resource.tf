@@ -0,0 +1,41 @@
+// Create a GCP User (Required as Parent)
+resource "nios_cloud_gcp_user" "gcp_user_basic_fields" {
+  user_name      = "gcp-user"
+  client_email   = "terraform@example-project.iam.gserviceaccount.com"
+  client_id      = "112233445566778899001"
+  private_key_id = "0123456789abcdef0123456789abcdef01234567"
+  private_key    = file("<relative_path_to_file>/gcp_private_key.pem")
+  project_id     = "example-project"
+}
+
+// Create GCP DNS Task Group with Basic Fields
+resource "nios_cloud_gcp_dns_task_group" "gcp_dns_task_group_basic_fields" {
+  name                        = "example_gcp_task_group"
+  grid_member                 = "infoblox.localdomain"
+  disabled                    = false
+  sync_child_projects         = false
+  network_view_mapping_policy = "AUTO_CREATE"
+}
+
+// Create GCP DNS Task Group with Additional Fields
+resource "nios_cloud_gcp_dns_task_group" "gcp_dns_task_group_additional_fields" {
+  name                          = "example_gcp_task_group_2"
+  grid_member                   = "infoblox.localdomain"
+  comment                       = "GCP DNS task group"
+  disabled                      = false
+  sync_child_projects           = true
+  network_view_mapping_policy   = "DIRECT"
+  network_view                  = "default"
+  multiple_projects_sync_policy = "UPLOAD_CHILDREN"
+  consolidate_zones             = true
+  consolidated_view             = "default"
+  gcp_project_ids_file_path     = "<relative_path_to_file>/gcp_project_ids.csv"
+  task_list = [
+    {
+      name              = "example-task1"
+      schedule_interval = "10"
+      schedule_units    = "MINS"
+      gcp_user          = nios_cloud_gcp_user.gcp_user_basic_fields.ref
+    }
+  ]
+}
@@ -0,0 +1,21 @@
+// Create GCP User with Basic Fields
+resource "nios_cloud_gcp_user" "gcp_user_basic_fields" {
+  user_name      = "gcp-user"
+  client_email   = "terraform@example-project.iam.gserviceaccount.com"
+  client_id      = "112233445566778899001"
+  private_key_id = "0123456789abcdef0123456789abcdef01234567"
+  private_key    = file("<relative_path_to_file>/gcp_private_key.pem")
+  project_id     = "example-project"
+}
+
+// Create GCP User with Additional Fields
+resource "nios_cloud_gcp_user" "gcp_user_additional_fields" {
+  user_name            = "gcp-user-2"
+  client_email         = "terraform-2@example-project.iam.gserviceaccount.com"
+  client_id            = "112233445566778899002"
+  private_key_id       = "89abcdef0123456789abcdef0123456789abcdef"
+  private_key          = file("<relative_path_to_file>/gcp_private_key_2.pem")
+  project_id           = "example-project"
+  client_x509_cert_url = "https://www.googleapis.com/robot/v1/metadata/x509/terraform-2%40example-project.iam.gserviceaccount.com"
+  file_name            = "example-project-key.json"
+}
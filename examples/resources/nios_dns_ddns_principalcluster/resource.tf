@@ -0,0 +1,23 @@
+// Create a DDNS Principal Cluster Group (Required as Parent)
+resource "nios_dns_ddns_principalcluster_group" "parent_group" {
+  name = "example-principal-cluster-group"
+}
+
+// Create a DDNS Principal Cluster with Basic Fields
+resource "nios_dns_ddns_principalcluster" "ddns_principalcluster_with_basic_fields" {
+  name       = "example-principal-cluster"
+  principals = ["host/dhcp1.example.com@EXAMPLE.COM"]
+}
+
+// Create a DDNS Principal Cluster with Additional Fields
+resource "nios_dns_ddns_principalcluster" "ddns_principalcluster_with_additional_fields" {
+  name = "example-principal-cluster-2"
+  principals = [
+    "host/dhcp2.example.com@EXAMPLE.COM",
+    "host/dhcp3.example.com@EXAMPLE.COM",
+  ]
+
+  // Additional Fields
+  group   = nios_dns_ddns_principalcluster_group.parent_group.name
+  comment = "DDNS Principal Cluster created by Terraform"
+}
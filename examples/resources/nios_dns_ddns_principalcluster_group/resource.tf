@@ -0,0 +1,20 @@
+// Create a DDNS Principal Cluster Group with Basic Fields
+resource "nios_dns_ddns_principalcluster_group" "ddns_principalcluster_group_with_basic_fields" {
+  name = "example-principal-cluster-group"
+}
+
+// Create a DDNS Principal Cluster Group with Additional Fields
+resource "nios_dns_ddns_principalcluster_group" "ddns_principalcluster_group_with_additional_fields" {
+  name = "example-principal-cluster-group-2"
+
+  // Additional Fields
+  comment = "DDNS Principal Cluster Group created by Terraform"
+}
+
+// Use the DDNS Principal Cluster Group in a DNS View
+resource "nios_dns_view" "view_with_ddns_principal_group" {
+  name                        = "example-ddns-principal-view"
+  use_ddns_principal_security = true
+  ddns_principal_tracking     = true
+  ddns_principal_group        = nios_dns_ddns_principalcluster_group.ddns_principalcluster_group_with_additional_fields.name
+}
@@ -0,0 +1,44 @@
+// Create a DNS64 Synthesis Group with Basic Fields
+resource "nios_dns_dns64group" "dns64group_with_basic_fields" {
+  name = "example-dns64-group"
+}
+
+// Create a DNS64 Synthesis Group with Additional Fields
+resource "nios_dns_dns64group" "dns64group_with_additional_fields" {
+  name = "example-dns64-group-2"
+
+  // Additional Fields
+  prefix              = "64:ff9b::/96"
+  enable_dnssec_dns64 = true
+  disable             = false
+  clients = [
+    {
+      address    = "10.0.0.0/8"
+      permission = "ALLOW"
+    }
+  ]
+  mapped = [
+    {
+      address    = "Any"
+      permission = "ALLOW"
+    }
+  ]
+  exclude = [
+    {
+      address    = "::ffff:0:0/96"
+      permission = "ALLOW"
+    }
+  ]
+  extattrs = {
+    Site = "location-1"
+  }
+  comment = "DNS64 Synthesis Group created by Terraform"
+}
+
+// Associate the DNS64 Synthesis Group with a DNS View
+resource "nios_dns_view" "view_with_dns64group" {
+  name          = "example-dns64-view"
+  use_dns64     = true
+  dns64_enabled = true
+  dns64_groups  = [nios_dns_dns64group.dns64group_with_additional_fields.name]
+}
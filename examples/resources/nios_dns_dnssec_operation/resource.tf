@@ -0,0 +1,27 @@
+// Create an Auth Zone (Required as Parent)
+resource "nios_dns_zone_auth" "parent_zone" {
+  fqdn = "example.com"
+  view = "default"
+  grid_primary = [
+    {
+      name    = "infoblox.10_0_0_1"
+      stealth = false
+    }
+  ]
+}
+
+// Sign the zone
+resource "nios_dns_dnssec_operation" "sign" {
+  zone      = nios_dns_zone_auth.parent_zone.ref
+  operation = "SIGN"
+}
+
+// Roll the key signing key over, again whenever the trigger value changes
+resource "nios_dns_dnssec_operation" "ksk_rollover" {
+  zone      = nios_dns_zone_auth.parent_zone.ref
+  operation = "ROLLOVER_KSK"
+  triggers = {
+    rollover = "2026-10"
+  }
+  depends_on = [nios_dns_dnssec_operation.sign]
+}
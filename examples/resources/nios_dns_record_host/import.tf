@@ -1,9 +1,9 @@
 import {
-  to = nios_ip_allocation.allocation1
+  to = nios_dns_record_host.record_host
   id = "record:host/ZG5zLmhvc3QkLl9kZWZhdWx0LmNvbS5leGFtcGxlLnNhbXBsZV9yZWNvcmQ:sample_record.example.com/default"
 }
 
-resource "nios_ip_allocation" "allocation1" {
+resource "nios_dns_record_host" "record_host" {
   name = "sample_record.example.com"
   view = "default"
   ipv4addrs = [
@@ -12,12 +12,3 @@ resource "nios_ip_allocation" "allocation1" {
     }
   ]
 }
-
-import {
-  to = nios_ip_association.association1
-  id = "record:host/ZG5zLmhvc3QkLl9kZWZhdWx0LmNvbS5leGFtcGxlLnNhbXBsZV9yZWNvcmQ:sample_record.example.com/default"
-}
-
-resource "nios_ip_association" "association1" {
-  ref = nios_ip_allocation.allocation1.ref
-}
@@ -0,0 +1,69 @@
+# Create a Host Record with a static IPv4 address and aliases
+resource "nios_dns_record_host" "record_host_with_aliases" {
+  name = "host1.example.com"
+  view = "default"
+  ipv4addrs = [
+    {
+      ipv4addr = "10.101.1.110"
+    }
+  ]
+  aliases = ["alias1.example.com", "alias2.example.com"]
+  extattrs = {
+    Site = "location-1"
+  }
+}
+
+# Create a Host Record with multiple IPv4 addresses and DHCP enabled on one of them
+resource "nios_dns_record_host" "record_host_with_dhcp" {
+  name = "host2.example.com"
+  view = "default"
+  ipv4addrs = [
+    {
+      ipv4addr           = "10.101.1.111"
+      mac                = "12:00:43:fe:9a:8c"
+      configure_for_dhcp = true
+      options = [
+        {
+          name  = "domain-name"
+          num   = 15
+          value = "example.com"
+        }
+      ]
+      use_options = true
+    },
+    {
+      ipv4addr = "10.101.1.112"
+    }
+  ]
+  ipv6addrs = [
+    {
+      ipv6addr           = "2002:1f93::12:2"
+      duid               = "00:01:5f:3a:1b:2c:12:34:56:78:9a:bc"
+      match_client       = "DUID"
+      configure_for_dhcp = true
+    }
+  ]
+}
+
+# Create a Host Record with the next available IPv4 address of a network
+resource "nios_dns_record_host" "record_host_next_available" {
+  name = "host3.example.com"
+  view = "default"
+  ipv4addrs = [
+    {
+      func_call = {
+        attribute_name  = "ipv4addr"
+        object_function = "next_available_ip"
+        result_field    = "ips"
+        object          = "network"
+        object_parameters = {
+          network      = "10.10.0.0/16"
+          network_view = "default"
+        }
+        parameters = {
+          exclude = jsonencode(["10.10.0.1", "10.10.0.2"]),
+        }
+      }
+    }
+  ]
+}
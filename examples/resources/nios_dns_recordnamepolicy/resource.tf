@@ -0,0 +1,21 @@
+// Create a Record Name Policy with Basic Fields
+resource "nios_dns_recordnamepolicy" "recordnamepolicy_with_basic_fields" {
+  name  = "example-record-name-policy"
+  regex = "^[a-z0-9-]+$"
+}
+
+// Create a Record Name Policy with Additional Fields
+resource "nios_dns_recordnamepolicy" "recordnamepolicy_with_additional_fields" {
+  name  = "example-record-name-policy-2"
+  regex = "^[a-zA-Z0-9_-]+$"
+
+  // Additional Fields
+  is_default = false
+}
+
+// Apply the Record Name Policy to an Auth Zone
+resource "nios_dns_zone_auth" "zone_with_recordnamepolicy" {
+  fqdn                   = "example_record_name_policy.com"
+  record_name_policy     = nios_dns_recordnamepolicy.recordnamepolicy_with_basic_fields.name
+  use_record_name_policy = true
+}
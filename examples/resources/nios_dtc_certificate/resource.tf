@@ -0,0 +1,11 @@
+// Upload a DTC Certificate with Basic Fields
+resource "nios_dtc_certificate" "dtc_certificate_basic" {
+  certificate_file_path = "${path.module}/../../../internal/testdata/nios_dtc_certificate/client.pem"
+}
+
+// Use the uploaded DTC Certificate as the client certificate of an HTTPS health monitor
+resource "nios_dtc_monitor_http" "dtc_monitor_http_with_client_cert" {
+  name        = "example_https_monitor"
+  secure      = true
+  client_cert = nios_dtc_certificate.dtc_certificate_basic.ref
+}
@@ -0,0 +1,32 @@
+// Create an DTC Server (Required as Parent)
+resource "nios_dtc_server" "create_dtc_server" {
+  name = "example-server"
+  host = "2.3.3.4"
+}
+
+// Create a DTC Topology Rule with Basic Fields
+resource "nios_dtc_topology_rule" "dtc_topology_rule_basic" {
+  dest_type        = "SERVER"
+  destination_link = nios_dtc_server.create_dtc_server.ref
+  sources = [
+    {
+      source_op    = "IS"
+      source_type  = "CONTINENT"
+      source_value = "Africa"
+    }
+  ]
+}
+
+// Create a DTC Topology Rule with Additional Fields
+resource "nios_dtc_topology_rule" "dtc_topology_rule_with_additional_fields" {
+  dest_type        = "SERVER"
+  destination_link = nios_dtc_server.create_dtc_server.ref
+  return_type      = "NOERR"
+  sources = [
+    {
+      source_op    = "IS_NOT"
+      source_type  = "COUNTRY"
+      source_value = "India"
+    }
+  ]
+}
@@ -0,0 +1,33 @@
+// Update the Grid DHCP properties with Basic Fields
+resource "nios_grid_dhcp_properties" "grid_dhcp_basic" {
+  authority = true
+}
+
+// Update the Grid DHCP properties with Additional Fields
+resource "nios_grid_dhcp_properties" "grid_dhcp_with_additional_fields" {
+  authority        = true
+  ping_count       = 2
+  ping_timeout     = 1000
+  enable_ddns      = true
+  ddns_domainname  = "example.com"
+  dns_update_style = "INTERIM"
+  options = [
+    {
+      name       = "domain-name"
+      value      = "example.com"
+      use_option = true
+    },
+    {
+      name  = "time-offset"
+      value = "50"
+    }
+  ]
+  ipv6_options = [
+    {
+      name         = "dhcp6.fqdn"
+      num          = 39
+      value        = "example.com"
+      vendor_class = "DHCPv6"
+    }
+  ]
+}
@@ -0,0 +1,22 @@
+// Update the Grid DNS properties with Basic Fields
+resource "nios_grid_dns_properties" "grid_dns_basic" {
+  allow_recursive_query = false
+}
+
+// Update the Grid DNS properties with Additional Fields
+resource "nios_grid_dns_properties" "grid_dns_with_additional_fields" {
+  allow_recursive_query = true
+  forwarders            = ["10.0.0.1", "10.0.0.2"]
+  forward_only          = false
+  notify_delay          = 10
+  allow_query = [
+    {
+      address    = "10.0.0.0/8"
+      permission = "ALLOW"
+    }
+  ]
+  logging_categories = {
+    log_general = true
+    log_queries = true
+  }
+}
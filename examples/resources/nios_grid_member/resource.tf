@@ -75,4 +75,10 @@ resource "nios_grid_member" "example_member_with_additional_fields" {
   mgmt_port_setting = {
     enabled = true
   }
+
+  // Member updates can take much longer than the provider wide retry_timeout
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
 }
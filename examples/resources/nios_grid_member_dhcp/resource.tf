@@ -0,0 +1,21 @@
+// Update the DHCP properties of a Grid member with Basic Fields
+resource "nios_grid_member_dhcp" "member_dhcp_basic" {
+  host_name = "infoblox.localdomain"
+}
+
+// Update the DHCP properties of a Grid member with Additional Fields
+resource "nios_grid_member_dhcp" "member_dhcp_with_additional_fields" {
+  host_name      = "infoblox.member1"
+  authority      = true
+  use_authority  = true
+  ping_count     = 2
+  use_ping_count = true
+  options = [
+    {
+      name       = "domain-name"
+      value      = "example.com"
+      use_option = true
+    }
+  ]
+  use_options = true
+}
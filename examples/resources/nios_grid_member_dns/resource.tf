@@ -0,0 +1,15 @@
+// Update the DNS properties of a Grid member with Basic Fields
+resource "nios_grid_member_dns" "member_dns_basic" {
+  host_name = "infoblox.localdomain"
+}
+
+// Update the DNS properties of a Grid member with Additional Fields
+resource "nios_grid_member_dns" "member_dns_with_additional_fields" {
+  host_name                   = "infoblox.member1"
+  allow_recursive_query       = true
+  use_recursive_query_setting = true
+  forwarders                  = ["10.0.0.1", "10.0.0.2"]
+  use_forwarders              = true
+  notify_delay                = 10
+  use_notify_delay            = true
+}
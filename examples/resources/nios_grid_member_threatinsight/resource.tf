@@ -0,0 +1,10 @@
+// Update the Threat Insight settings of a Grid member with Basic Fields
+resource "nios_grid_member_threatinsight" "member_threatinsight_basic" {
+  host_name = "infoblox.localdomain"
+}
+
+// Update the Threat Insight settings of a Grid member with Additional Fields
+resource "nios_grid_member_threatinsight" "member_threatinsight_with_additional_fields" {
+  host_name      = "infoblox.member1"
+  enable_service = true
+}
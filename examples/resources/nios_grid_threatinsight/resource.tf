@@ -0,0 +1,20 @@
+// Update the Grid Threat Insight settings with Basic Fields
+resource "nios_grid_threatinsight" "grid_threatinsight_basic" {
+  enable_auto_download = true
+}
+
+// Update the Grid Threat Insight settings with Additional Fields
+resource "nios_grid_threatinsight" "grid_threatinsight_with_additional_fields" {
+  enable_auto_download      = true
+  module_update_policy      = "AUTOMATIC"
+  enable_scheduled_download = true
+  scheduled_download = {
+    frequency         = "WEEKLY"
+    weekdays          = ["SUNDAY"]
+    hour_of_day       = 2
+    minutes_past_hour = 0
+    repeat            = "RECUR"
+  }
+  allowlist_update_policy        = "AUTOMATIC"
+  enable_allowlist_auto_download = true
+}
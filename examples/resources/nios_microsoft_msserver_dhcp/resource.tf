@@ -0,0 +1,22 @@
+// Create Microsoft Server (Required as Parent)
+resource "nios_microsoft_msserver" "msserver_parent" {
+  address    = "10.10.0.10"
+  login_name = "example_login"
+}
+
+// Manage Microsoft Server DHCP Service with Basic Fields
+resource "nios_microsoft_msserver_dhcp" "msserver_dhcp_basic" {
+  address = nios_microsoft_msserver.msserver_parent.address
+}
+
+// Manage Microsoft Server DHCP Service with Additional Fields
+resource "nios_microsoft_msserver_dhcp" "msserver_dhcp_with_additional_fields" {
+  address = nios_microsoft_msserver.msserver_parent.address
+
+  // Additional Fields
+  synchronization_interval     = 10
+  use_synchronization_interval = true
+  login_name                   = "dhcp_admin"
+  login_password               = "Example-Passw0rd"
+  use_login                    = true
+}
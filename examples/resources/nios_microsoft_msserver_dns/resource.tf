@@ -0,0 +1,24 @@
+// Create Microsoft Server (Required as Parent)
+resource "nios_microsoft_msserver" "msserver_parent" {
+  address    = "10.10.0.11"
+  login_name = "example_login"
+}
+
+// Manage Microsoft Server DNS Service with Basic Fields
+resource "nios_microsoft_msserver_dns" "msserver_dns_basic" {
+  address = nios_microsoft_msserver.msserver_parent.address
+}
+
+// Manage Microsoft Server DNS Service with Additional Fields
+resource "nios_microsoft_msserver_dns" "msserver_dns_with_additional_fields" {
+  address = nios_microsoft_msserver.msserver_parent.address
+
+  // Additional Fields
+  enable_dns_reports_sync      = true
+  use_enable_dns_reports_sync  = true
+  synchronization_interval     = 10
+  use_synchronization_interval = true
+  login_name                   = "dns_admin"
+  login_password               = "Example-Passw0rd"
+  use_login                    = true
+}
@@ -0,0 +1,33 @@
+// Create AD Authservice with Basic Fields
+resource "nios_security_ad_auth_service" "ad_auth_service_with_basic_fields" {
+  name      = "ad_auth_service1"
+  ad_domain = "example.com"
+  domain_controllers = [
+    {
+      fqdn_or_ip = "2.2.4.1"
+    }
+  ]
+}
+
+// Create AD Authservice with Additional Fields
+resource "nios_security_ad_auth_service" "ad_auth_service_with_additional_fields" {
+  name      = "ad_auth_service2"
+  ad_domain = "corp.example.com"
+  domain_controllers = [
+    {
+      fqdn_or_ip = "dc1.corp.example.com"
+      auth_port  = 636
+      encryption = "SSL"
+      comment    = "Primary domain controller"
+    },
+    {
+      fqdn_or_ip = "2.2.4.2"
+      auth_port  = 389
+      encryption = "NONE"
+    }
+  ]
+  comment               = "Active Directory authentication for admins"
+  disabled              = false
+  nested_group_querying = true
+  timeout               = 10
+}
@@ -0,0 +1,35 @@
+// Create Admin Groups (Required as Parent)
+resource "nios_security_admin_group" "submitter_group" {
+  name = "submitters"
+}
+
+resource "nios_security_admin_group" "approval_group" {
+  name = "approvers"
+}
+
+// Create Approval Workflow with Basic Fields
+resource "nios_security_approval_workflow" "approval_workflow_with_basic_fields" {
+  submitter_group = nios_security_admin_group.submitter_group.name
+  approval_group  = nios_security_admin_group.approval_group.name
+}
+
+// Create Admin Group (Required as Parent)
+resource "nios_security_admin_group" "submitter_group2" {
+  name = "submitters2"
+}
+
+// Create Approval Workflow with Additional Fields
+resource "nios_security_approval_workflow" "approval_workflow_with_additional_fields" {
+  submitter_group = nios_security_admin_group.submitter_group2.name
+  approval_group  = nios_security_admin_group.approval_group.name
+
+  // Additional Fields
+  approver_comment       = "REQUIRED"
+  submitter_comment      = "REQUIRED"
+  ticket_number          = "OPTIONAL"
+  enable_approval_notify = true
+  enable_failed_notify   = true
+  extattrs = {
+    Site = "location-1"
+  }
+}
@@ -0,0 +1,29 @@
+// Retrieve the Local User Authservice (Required as Auth Service)
+data "nios_security_localuser_authservice" "local" {}
+
+// Create AD Authservice (Required as Auth Service)
+resource "nios_security_ad_auth_service" "ad_auth_service" {
+  name      = "ad_auth_service"
+  ad_domain = "example.com"
+  domain_controllers = [
+    {
+      fqdn_or_ip = "2.2.4.1"
+    }
+  ]
+}
+
+// Create Admin Group (Required as Default Group)
+resource "nios_security_admin_group" "default_group" {
+  name = "remote_admins"
+}
+
+// Update the Grid Authentication Policy, services are tried in the order of the list
+resource "nios_security_auth_policy" "auth_policy" {
+  auth_services = [
+    nios_security_ad_auth_service.ad_auth_service.ref,
+    data.nios_security_localuser_authservice.local.result[0].ref,
+  ]
+  admin_groups  = [nios_security_admin_group.default_group.name]
+  default_group = nios_security_admin_group.default_group.name
+  usage_type    = "FULL"
+}
@@ -0,0 +1,25 @@
+// Create Entrust nShield HSM Group (Required as HSM Group)
+resource "nios_security_hsm_entrustnshieldgroup" "hsm_group" {
+  name          = "entrust_nshield_group"
+  key_server_ip = "10.130.0.1"
+  protection    = "MODULE"
+  entrustnshield_hsm = [
+    {
+      remote_ip  = "10.130.0.10"
+      remote_esn = "1234-5678-90AB"
+      keyhash    = "0123456789abcdef0123456789abcdef01234567"
+    }
+  ]
+}
+
+// Update the list of HSM Groups used by the Grid for DNSSEC key generation and zone signing
+resource "nios_security_hsm_allgroups" "hsm_allgroups" {
+  groups = [nios_security_hsm_entrustnshieldgroup.hsm_group.ref]
+}
+
+// Enable HSM signing for DNSSEC at the Grid level
+resource "nios_grid_dns_properties" "grid_dns_properties" {
+  enable_hsm_signing = true
+
+  depends_on = [nios_security_hsm_allgroups.hsm_allgroups]
+}
@@ -0,0 +1,35 @@
+// Create Entrust nShield HSM Group with Basic Fields
+resource "nios_security_hsm_entrustnshieldgroup" "hsm_entrustnshieldgroup_with_basic_fields" {
+  name          = "entrust_nshield_group1"
+  key_server_ip = "10.130.0.1"
+  protection    = "MODULE"
+  entrustnshield_hsm = [
+    {
+      remote_ip  = "10.130.0.10"
+      remote_esn = "1234-5678-90AB"
+      keyhash    = "0123456789abcdef0123456789abcdef01234567"
+    }
+  ]
+}
+
+// Create Entrust nShield HSM Group with Additional Fields
+resource "nios_security_hsm_entrustnshieldgroup" "hsm_entrustnshieldgroup_with_additional_fields" {
+  name          = "entrust_nshield_group2"
+  key_server_ip = "10.130.0.1"
+  protection    = "SOFTCARD"
+  entrustnshield_hsm = [
+    {
+      remote_ip   = "10.130.0.11"
+      remote_port = 9004
+      remote_esn  = "1234-5678-90AC"
+      keyhash     = "0123456789abcdef0123456789abcdef01234568"
+      disable     = false
+    }
+  ]
+
+  // Additional Fields
+  key_server_port = 9004
+  card_name       = "softcard1"
+  pass_phrase     = "example-pass-phrase"
+  comment         = "Entrust nShield HSM group for DNSSEC signing"
+}
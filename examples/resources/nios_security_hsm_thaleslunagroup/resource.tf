@@ -0,0 +1,36 @@
+// Create Thales Luna HSM Group with Basic Fields
+resource "nios_security_hsm_thaleslunagroup" "hsm_thaleslunagroup_with_basic_fields" {
+  name        = "thales_luna_group1"
+  hsm_version = "Luna_7"
+  pass_phrase = "example-pass-phrase"
+  thalesluna = [
+    {
+      name                    = "10.120.0.10"
+      partition_serial_number = "1234567"
+      server_cert_file_path   = "<path-to-the-server-certificate-file>"
+    }
+  ]
+}
+
+// Create Thales Luna HSM Group with Additional Fields
+resource "nios_security_hsm_thaleslunagroup" "hsm_thaleslunagroup_with_additional_fields" {
+  name        = "thales_luna_group2"
+  hsm_version = "Luna_7"
+  pass_phrase = "example-pass-phrase"
+  thalesluna = [
+    {
+      name                    = "luna1.example.com"
+      partition_serial_number = "1234567"
+      server_cert_file_path   = "<path-to-the-server-certificate-file>"
+    },
+    {
+      name                    = "10.120.0.11"
+      partition_serial_number = "7654321"
+      server_cert_file_path   = "<path-to-the-server-certificate-file>"
+      disable                 = true
+    }
+  ]
+
+  // Additional Fields
+  comment = "Thales Luna HSM group for DNSSEC signing"
+}
@@ -0,0 +1,16 @@
+// Create Network User with Basic Fields
+resource "nios_security_network_user" "network_user_with_basic_fields" {
+  name       = "jdoe"
+  address    = "10.20.0.1"
+  domainname = "example.com"
+}
+
+// Create Network User with Additional Fields
+resource "nios_security_network_user" "network_user_with_additional_fields" {
+  name         = "asmith"
+  address      = "10.20.0.2"
+  domainname   = "corp.example.com"
+  network_view = "default"
+  guid         = "S-1-5-21-1004"
+  logon_id     = "asmith-logon"
+}
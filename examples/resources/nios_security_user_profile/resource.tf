@@ -0,0 +1,13 @@
+// Update the User Profile of the current admin with Basic Fields
+resource "nios_security_user_profile" "user_profile_basic" {
+  email = "admin@example.com"
+}
+
+// Update the User Profile of the current admin with Additional Fields
+resource "nios_security_user_profile" "user_profile_with_additional_fields" {
+  email               = "admin@example.com"
+  table_size          = 50
+  global_search_on_ea = true
+  time_zone           = "(UTC) Coordinated Universal Time"
+  use_time_zone       = true
+}
@@ -0,0 +1,21 @@
+// Create a Threat Insight Allowlist entry with Basic Fields
+resource "nios_threatinsight_allowlist" "allowlist_basic" {
+  fqdn = "tunnel.example.com"
+}
+
+// Create a Threat Insight Allowlist entry with Additional Fields
+resource "nios_threatinsight_allowlist" "allowlist_with_additional_fields" {
+  fqdn    = "vpn.example.com"
+  comment = "Trusted VPN endpoint using DNS tunnelling"
+  disable = false
+}
+
+// Create Threat Insight Allowlist entries from a list of FQDNs kept in version control
+locals {
+  allowlisted_fqdns = ["cdn.example.com", "telemetry.example.com"]
+}
+
+resource "nios_threatinsight_allowlist" "allowlist_from_list" {
+  for_each = toset(local.allowlisted_fqdns)
+  fqdn     = each.value
+}
@@ -0,0 +1,11 @@
+// Update the Threat Insight Cloud Client settings with Basic Fields
+resource "nios_threatinsight_cloudclient" "cloudclient_basic" {
+  enable = true
+}
+
+// Update the Threat Insight Cloud Client settings with Additional Fields
+resource "nios_threatinsight_cloudclient" "cloudclient_with_additional_fields" {
+  enable             = true
+  interval           = 600
+  blacklist_rpz_list = ["example-rpz.com"]
+}
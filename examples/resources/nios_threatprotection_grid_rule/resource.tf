@@ -0,0 +1,26 @@
+// Retrieve the ruleset currently used by the Grid
+data "nios_threatprotection_active_ruleset" "active" {}
+
+// Create a Threat Protection Grid Rule with Basic Fields
+resource "nios_threatprotection_grid_rule" "grid_rule_basic_fields" {
+  ruleset  = data.nios_threatprotection_active_ruleset.active.version
+  template = "threatprotection:ruletemplate/ZG5zLnRocmVhdF9wcm90ZWN0aW9uX3J1bGVfdGVtcGxhdGUkMTMwOTAwMjAw:Blacklist%20TCP%20FQDN%20lookup%20for%20DNS%20Message%20Type"
+}
+
+// Create a Threat Protection Grid Rule with Additional Fields
+resource "nios_threatprotection_grid_rule" "grid_rule_additional_fields" {
+  ruleset  = data.nios_threatprotection_active_ruleset.active.version
+  template = "threatprotection:ruletemplate/ZG5zLnRocmVhdF9wcm90ZWN0aW9uX3J1bGVfdGVtcGxhdGUkMTMwOTAwMjAw:Blacklist%20TCP%20FQDN%20lookup%20for%20DNS%20Message%20Type"
+  comment  = "Example Threat Protection Grid Rule with additional fields"
+  disabled = true
+  config = {
+    action       = "DROP"
+    log_severity = "CRITICAL"
+    params = [
+      {
+        name  = "FQDN"
+        value = "example.com"
+      }
+    ]
+  }
+}
@@ -0,0 +1,21 @@
+// Create a Threat Protection Profile with Basic Fields
+resource "nios_threatprotection_profile" "profile_basic_fields" {
+  name = "example_threat_protection_profile"
+}
+
+// Create a Threat Protection Profile with Additional Fields
+resource "nios_threatprotection_profile" "profile_additional_fields" {
+  name                           = "example_threat_protection_profile2"
+  comment                        = "Example Threat Protection Profile with additional fields"
+  events_per_second_per_rule     = 5
+  use_events_per_second_per_rule = true
+  extattrs = {
+    Site = "location-1"
+  }
+}
+
+// Create a Threat Protection Profile by cloning an existing profile
+resource "nios_threatprotection_profile" "profile_cloned" {
+  name           = "example_threat_protection_profile3"
+  source_profile = nios_threatprotection_profile.profile_additional_fields.name
+}
@@ -0,0 +1,22 @@
+// Disable a rule in a Threat Protection Profile
+resource "nios_threatprotection_profile_rule" "profile_rule_disable" {
+  profile     = nios_threatprotection_profile.profile_basic_fields.name
+  sid         = 130000100
+  disable     = true
+  use_disable = true
+}
+
+// Override the action and log severity of a rule in a Threat Protection Profile
+resource "nios_threatprotection_profile_rule" "profile_rule_config" {
+  profile = nios_threatprotection_profile.profile_basic_fields.name
+  sid     = 130000200
+  config = {
+    action       = "DROP"
+    log_severity = "MAJOR"
+  }
+  use_config = true
+}
+
+resource "nios_threatprotection_profile" "profile_basic_fields" {
+  name = "example_threat_protection_profile"
+}
@@ -1,16 +1,14 @@
 package config
 
-var proxySearch string
+import "context"
 
-// SetProxySearch sets the ProxySearch value
-func SetProxySearch(ps string) {
-	proxySearch = ps
-	if proxySearch == "" {
-		proxySearch = "LOCAL"
-	}
-}
+// defaultProxySearch is used when the Grid connection does not set proxy_search
+const defaultProxySearch = "LOCAL"
 
-// GetProxySearch gets the ProxySearch value
-func GetProxySearch() string {
-	return proxySearch
+// GetProxySearch gets the ProxySearch value of the Grid connection handling the call
+func GetProxySearch(ctx context.Context) string {
+	if grid, ok := GridFromContext(ctx); ok && grid.ProxySearch != "" {
+		return grid.ProxySearch
+	}
+	return defaultProxySearch
 }
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+// DefaultGrid is the name of the Grid connection configured by the top level provider attributes
+const DefaultGrid = "default"
+
+// Grid holds the API client and the settings of a Grid connection configured in the provider
+type Grid struct {
+	Name         string
+	Client       *niosclient.APIClient
+	ProxySearch  string
+	RetryTimeout time.Duration
+}
+
+// Grids holds the Grid connections configured in the provider, keyed by name.
+// It is passed as provider data to resources, data sources and list resources.
+type Grids struct {
+	grids map[string]*Grid
+}
+
+// NewGrids returns an empty set of Grid connections
+func NewGrids() *Grids {
+	return &Grids{grids: make(map[string]*Grid)}
+}
+
+// Add registers a Grid connection, names must be unique
+func (g *Grids) Add(grid *Grid) error {
+	if _, ok := g.grids[grid.Name]; ok {
+		return fmt.Errorf("grid connection %q is defined more than once", grid.Name)
+	}
+	g.grids[grid.Name] = grid
+	return nil
+}
+
+// Get returns the Grid connection with the given name, or the default connection if name is empty
+func (g *Grids) Get(name string) (*Grid, error) {
+	if name == "" {
+		name = DefaultGrid
+	}
+	if grid, ok := g.grids[name]; ok {
+		return grid, nil
+	}
+	if name == DefaultGrid {
+		return nil, fmt.Errorf("no default grid connection is configured, set the grid attribute to one of: %s", strings.Join(g.Names(), ", "))
+	}
+	return nil, fmt.Errorf("grid connection %q is not configured in the provider, expected one of: %s", name, strings.Join(g.Names(), ", "))
+}
+
+// Names returns the sorted names of the configured Grid connections
+func (g *Grids) Names() []string {
+	names := make([]string, 0, len(g.grids))
+	for name := range g.grids {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// gridKey is the context key of the Grid connection handling a call
+type gridKey struct{}
+
+// WithGrid returns a copy of ctx carrying the Grid connection handling the call and its retry timeout
+func WithGrid(ctx context.Context, grid *Grid) context.Context {
+	ctx = context.WithValue(ctx, gridKey{}, grid)
+	return retry.WithTimeout(ctx, grid.RetryTimeout)
+}
+
+// GridFromContext returns the Grid connection carried by ctx
+func GridFromContext(ctx context.Context) (*Grid, bool) {
+	grid, ok := ctx.Value(gridKey{}).(*Grid)
+	return grid, ok && grid != nil
+}
@@ -0,0 +1,829 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+)
+
+// gridAttribute is the attribute selecting the Grid connection of a resource, data source or list resource
+const gridAttribute = "grid"
+
+// gridImportSeparator separates the Grid connection name from the ID in import IDs, e.g. lab,record:a/ZG5z...
+const gridImportSeparator = ","
+
+var gridNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// The resources, data sources and list resources of the services are written against a single
+// *niosclient.APIClient. The wrappers below add the grid attribute to their schemas, remove it from
+// the data handed to them, and configure them with the client of the selected Grid connection
+// before every call, so the service packages do not need to know about multiple connections.
+
+// wrapResources wraps the resource factories with the Grid connection selection
+func wrapResources(factories []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(factories))
+	for _, factory := range factories {
+		wrapped = append(wrapped, func() resource.Resource {
+			r := &gridResource{resource: factory()}
+			if _, ok := r.resource.(resource.ResourceWithIdentity); ok {
+				return &gridResourceWithIdentity{r}
+			}
+			return r
+		})
+	}
+	return wrapped
+}
+
+// wrapDataSources wraps the data source factories with the Grid connection selection
+func wrapDataSources(factories []func() datasource.DataSource) []func() datasource.DataSource {
+	wrapped := make([]func() datasource.DataSource, 0, len(factories))
+	for _, factory := range factories {
+		wrapped = append(wrapped, func() datasource.DataSource {
+			return &gridDataSource{dataSource: factory()}
+		})
+	}
+	return wrapped
+}
+
+// wrapListResources wraps the list resource factories with the Grid connection selection
+func wrapListResources(factories []func() list.ListResource) []func() list.ListResource {
+	wrapped := make([]func() list.ListResource, 0, len(factories))
+	for _, factory := range factories {
+		wrapped = append(wrapped, func() list.ListResource {
+			return &gridListResource{listResource: factory()}
+		})
+	}
+	return wrapped
+}
+
+// gridTypes converts object values between the schema of a wrapped type and the schema extended with the grid attribute
+type gridTypes struct {
+	inner tftypes.Object
+	outer tftypes.Object
+}
+
+func newGridTypes(ctx context.Context, innerType attr.Type) (gridTypes, error) {
+	inner, ok := innerType.TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return gridTypes{}, fmt.Errorf("expected object schema type, got %s", innerType.TerraformType(ctx))
+	}
+	attributeTypes := make(map[string]tftypes.Type, len(inner.AttributeTypes)+1)
+	for name, attributeType := range inner.AttributeTypes {
+		attributeTypes[name] = attributeType
+	}
+	attributeTypes[gridAttribute] = tftypes.String
+	return gridTypes{inner: inner, outer: tftypes.Object{AttributeTypes: attributeTypes}}, nil
+}
+
+// strip returns the value without the grid attribute
+func (t gridTypes) strip(raw tftypes.Value) (tftypes.Value, error) {
+	if raw.IsNull() {
+		return tftypes.NewValue(t.inner, nil), nil
+	}
+	if !raw.IsKnown() {
+		return tftypes.NewValue(t.inner, tftypes.UnknownValue), nil
+	}
+	attributes, err := objectAttributes(raw)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	delete(attributes, gridAttribute)
+	return tftypes.NewValue(t.inner, attributes), nil
+}
+
+// extend returns the value with the grid attribute set to grid
+func (t gridTypes) extend(raw tftypes.Value, grid tftypes.Value) (tftypes.Value, error) {
+	if raw.IsNull() {
+		return tftypes.NewValue(t.outer, nil), nil
+	}
+	if !raw.IsKnown() {
+		return tftypes.NewValue(t.outer, tftypes.UnknownValue), nil
+	}
+	attributes, err := objectAttributes(raw)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	attributes[gridAttribute] = grid
+	return tftypes.NewValue(t.outer, attributes), nil
+}
+
+// objectAttributes returns a copy of the attributes of an object value, the value itself must not be modified
+func objectAttributes(raw tftypes.Value) (map[string]tftypes.Value, error) {
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return nil, err
+	}
+	copied := make(map[string]tftypes.Value, len(attributes)+1)
+	for name, value := range attributes {
+		copied[name] = value
+	}
+	return copied, nil
+}
+
+// gridValue returns the grid attribute of an object value, null if the object is null or unknown
+func gridValue(raw tftypes.Value) tftypes.Value {
+	if raw.IsNull() || !raw.IsKnown() {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+	if grid, ok := attributes[gridAttribute]; ok {
+		return grid
+	}
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// gridName returns the connection name held by a grid attribute value, empty for the default connection
+func gridName(grid tftypes.Value) (string, bool) {
+	if !grid.IsKnown() {
+		return "", false
+	}
+	var name *string
+	if err := grid.As(&name); err != nil || name == nil {
+		return "", true
+	}
+	return *name, true
+}
+
+// selectGrid looks up the Grid connection selected by the grid attribute value
+func selectGrid(grids *config.Grids, grid tftypes.Value, diags *diag.Diagnostics) (*config.Grid, bool) {
+	if grids == nil {
+		diags.AddError("Provider Not Configured", "The provider has not been configured with any Grid connection.")
+		return nil, false
+	}
+	name, known := gridName(grid)
+	if !known {
+		diags.AddAttributeError(path.Root(gridAttribute), "Unknown Grid Connection", "The grid attribute must be known to select the Grid connection.")
+		return nil, false
+	}
+	conn, err := grids.Get(name)
+	if err != nil {
+		diags.AddAttributeError(path.Root(gridAttribute), "Invalid Grid Connection", err.Error())
+		return nil, false
+	}
+	return conn, true
+}
+
+// sameGrid reports whether two grid attribute values select the same Grid connection
+func sameGrid(a, b tftypes.Value) bool {
+	aName, aKnown := gridName(a)
+	bName, bKnown := gridName(b)
+	if !aKnown || !bKnown {
+		return false
+	}
+	if aName == "" {
+		aName = config.DefaultGrid
+	}
+	if bName == "" {
+		bName = config.DefaultGrid
+	}
+	return aName == bName
+}
+
+// splitImportID splits an import ID of the form <grid>,<id> into the grid attribute value and the ID
+// of the wrapped resource. IDs without a Grid connection prefix select the default connection.
+func splitImportID(id string) (tftypes.Value, string) {
+	prefix, rest, found := strings.Cut(id, gridImportSeparator)
+	if !found || !gridNameRegex.MatchString(prefix) {
+		return tftypes.NewValue(tftypes.String, nil), id
+	}
+	return tftypes.NewValue(tftypes.String, prefix), rest
+}
+
+func gridValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(gridNameRegex, "must only contain letters, digits, underscores and dashes"),
+	}
+}
+
+// gridResource wraps a resource with the Grid connection selection
+type gridResource struct {
+	resource resource.Resource
+	grids    *config.Grids
+}
+
+var _ resource.Resource = &gridResource{}
+var _ resource.ResourceWithConfigure = &gridResource{}
+var _ resource.ResourceWithImportState = &gridResource{}
+var _ resource.ResourceWithModifyPlan = &gridResource{}
+var _ resource.ResourceWithValidateConfig = &gridResource{}
+
+// gridResourceWithIdentity wraps a resource supporting resource identity with the Grid connection selection
+type gridResourceWithIdentity struct {
+	*gridResource
+}
+
+var _ resource.ResourceWithIdentity = &gridResourceWithIdentity{}
+
+func (r *gridResourceWithIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	r.resource.(resource.ResourceWithIdentity).IdentitySchema(ctx, req, resp)
+}
+
+func (r *gridResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.resource.Metadata(ctx, req, resp)
+}
+
+func (r *gridResource) innerSchema(ctx context.Context) resourceschema.Schema {
+	var resp resource.SchemaResponse
+	r.resource.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func (r *gridResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.resource.Schema(ctx, req, resp)
+
+	// The attribute maps are shared with the data sources, extend a copy
+	attributes := make(map[string]resourceschema.Attribute, len(resp.Schema.Attributes)+1)
+	for name, attribute := range resp.Schema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes[gridAttribute] = resourceschema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Name of the provider `grid` connection that manages the resource. The default connection configured by the top level provider attributes is used when not set. Changing the connection forces a new resource to be created.",
+		Validators:          gridValidators(),
+	}
+	resp.Schema.Attributes = attributes
+}
+
+func (r *gridResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	grids, ok := req.ProviderData.(*config.Grids)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *config.Grids, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.grids = grids
+
+	// Configure the wrapped resource with the default connection, the connection is selected again for each operation
+	if conn, err := grids.Get(config.DefaultGrid); err == nil {
+		r.configure(ctx, conn, &resp.Diagnostics)
+	}
+}
+
+// configure hands the client of the Grid connection to the wrapped resource
+func (r *gridResource) configure(ctx context.Context, conn *config.Grid, diags *diag.Diagnostics) {
+	if configurable, ok := r.resource.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: conn.Client}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+}
+
+// useGrid configures the wrapped resource with the Grid connection selected by grid and returns a context carrying it
+func (r *gridResource) useGrid(ctx context.Context, grid tftypes.Value, diags *diag.Diagnostics) (context.Context, bool) {
+	conn, ok := selectGrid(r.grids, grid, diags)
+	if !ok {
+		return ctx, false
+	}
+	r.configure(ctx, conn, diags)
+	return config.WithGrid(ctx, conn), !diags.HasError()
+}
+
+// types returns the converters between the wrapped and the extended schema
+func (r *gridResource) types(ctx context.Context, diags *diag.Diagnostics) (resourceschema.Schema, gridTypes, bool) {
+	schema := r.innerSchema(ctx)
+	t, err := newGridTypes(ctx, schema.Type())
+	if err != nil {
+		diags.AddError("Unable to Convert Resource Data", err.Error())
+		return schema, t, false
+	}
+	return schema, t, true
+}
+
+// stripAll removes the grid attribute from each value, reporting conversion errors
+func stripAll(t gridTypes, diags *diag.Diagnostics, raws ...*tftypes.Value) bool {
+	for _, raw := range raws {
+		stripped, err := t.strip(*raw)
+		if err != nil {
+			diags.AddError("Unable to Convert Resource Data", err.Error())
+			return false
+		}
+		*raw = stripped
+	}
+	return true
+}
+
+// extendInto adds the grid attribute to raw and stores the result in target
+func extendInto(t gridTypes, raw tftypes.Value, grid tftypes.Value, target *tftypes.Value, diags *diag.Diagnostics) {
+	extended, err := t.extend(raw, grid)
+	if err != nil {
+		diags.AddError("Unable to Convert Resource Data", err.Error())
+		return
+	}
+	*target = extended
+}
+
+func (r *gridResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	grid := gridValue(req.Plan.Raw)
+	ctx, ok := r.useGrid(ctx, grid, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	schema, t, ok := r.types(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	cfg, plan, state := req.Config.Raw, req.Plan.Raw, resp.State.Raw
+	if !stripAll(t, &resp.Diagnostics, &cfg, &plan, &state) {
+		return
+	}
+
+	innerResp := resource.CreateResponse{
+		State:    tfsdk.State{Schema: schema, Raw: state},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	r.resource.Create(ctx, resource.CreateRequest{
+		Config:       tfsdk.Config{Schema: schema, Raw: cfg},
+		Plan:         tfsdk.Plan{Schema: schema, Raw: plan},
+		Identity:     req.Identity,
+		ProviderMeta: req.ProviderMeta,
+	}, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	extendInto(t, innerResp.State.Raw, grid, &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *gridResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	grid := gridValue(req.State.Raw)
+	ctx, ok := r.useGrid(ctx, grid, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	schema, t, ok := r.types(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	reqState, state := req.State.Raw, resp.State.Raw
+	if !stripAll(t, &resp.Diagnostics, &reqState, &state) {
+		return
+	}
+
+	innerResp := resource.ReadResponse{
+		State:    tfsdk.State{Schema: schema, Raw: state},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+		Deferred: resp.Deferred,
+	}
+	r.resource.Read(ctx, resource.ReadRequest{
+		State:              tfsdk.State{Schema: schema, Raw: reqState},
+		Identity:           req.Identity,
+		Private:            req.Private,
+		ProviderMeta:       req.ProviderMeta,
+		ClientCapabilities: req.ClientCapabilities,
+	}, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	extendInto(t, innerResp.State.Raw, grid, &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *gridResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	grid := gridValue(req.Plan.Raw)
+	ctx, ok := r.useGrid(ctx, grid, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	schema, t, ok := r.types(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	cfg, plan, reqState, state := req.Config.Raw, req.Plan.Raw, req.State.Raw, resp.State.Raw
+	if !stripAll(t, &resp.Diagnostics, &cfg, &plan, &reqState, &state) {
+		return
+	}
+
+	innerResp := resource.UpdateResponse{
+		State:    tfsdk.State{Schema: schema, Raw: state},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	r.resource.Update(ctx, resource.UpdateRequest{
+		Config:       tfsdk.Config{Schema: schema, Raw: cfg},
+		Plan:         tfsdk.Plan{Schema: schema, Raw: plan},
+		State:        tfsdk.State{Schema: schema, Raw: reqState},
+		Identity:     req.Identity,
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	extendInto(t, innerResp.State.Raw, grid, &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *gridResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	grid := gridValue(req.State.Raw)
+	ctx, ok := r.useGrid(ctx, grid, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	schema, t, ok := r.types(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	reqState, state := req.State.Raw, resp.State.Raw
+	if !stripAll(t, &resp.Diagnostics, &reqState, &state) {
+		return
+	}
+
+	innerResp := resource.DeleteResponse{
+		State:    tfsdk.State{Schema: schema, Raw: state},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+	r.resource.Delete(ctx, resource.DeleteRequest{
+		State:        tfsdk.State{Schema: schema, Raw: reqState},
+		Identity:     req.Identity,
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	extendInto(t, innerResp.State.Raw, grid, &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *gridResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importer, ok := r.resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	grid, id := splitImportID(req.ID)
+	ctx, ok = r.useGrid(ctx, grid, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	schema, t, ok := r.types(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	state := resp.State.Raw
+	if !stripAll(t, &resp.Diagnostics, &state) {
+		return
+	}
+
+	innerResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: schema, Raw: state},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+		Deferred: resp.Deferred,
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{
+		ID:                 id,
+		Identity:           req.Identity,
+		ClientCapabilities: req.ClientCapabilities,
+	}, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	extendInto(t, innerResp.State.Raw, grid, &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *gridResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planGrid := gridValue(req.Plan.Raw)
+	stateGrid := gridValue(req.State.Raw)
+
+	// Moving a resource to another Grid connection means creating it there
+	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() && !sameGrid(stateGrid, planGrid) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(gridAttribute))
+	}
+
+	modifier, ok := r.resource.(resource.ResourceWithModifyPlan)
+	if !ok || r.grids == nil {
+		return
+	}
+
+	// Destroy plans only hold the prior state
+	grid := planGrid
+	if req.Plan.Raw.IsNull() {
+		grid = stateGrid
+	}
+	if _, known := gridName(grid); !known {
+		return
+	}
+	ctx, ok = r.useGrid(ctx, grid, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	schema, t, ok := r.types(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	cfg, plan, state, respPlan := req.Config.Raw, req.Plan.Raw, req.State.Raw, resp.Plan.Raw
+	if !stripAll(t, &resp.Diagnostics, &cfg, &plan, &state, &respPlan) {
+		return
+	}
+
+	innerResp := resource.ModifyPlanResponse{
+		Plan:            tfsdk.Plan{Schema: schema, Raw: respPlan},
+		Identity:        resp.Identity,
+		RequiresReplace: resp.RequiresReplace,
+		Private:         resp.Private,
+		Deferred:        resp.Deferred,
+	}
+	modifier.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config:             tfsdk.Config{Schema: schema, Raw: cfg},
+		State:              tfsdk.State{Schema: schema, Raw: state},
+		Identity:           req.Identity,
+		Plan:               tfsdk.Plan{Schema: schema, Raw: plan},
+		ProviderMeta:       req.ProviderMeta,
+		Private:            req.Private,
+		ClientCapabilities: req.ClientCapabilities,
+	}, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.RequiresReplace = innerResp.RequiresReplace
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	extendInto(t, innerResp.Plan.Raw, planGrid, &resp.Plan.Raw, &resp.Diagnostics)
+}
+
+func (r *gridResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validatable, ok := r.resource.(resource.ResourceWithValidateConfig)
+	if !ok {
+		return
+	}
+	schema, t, ok := r.types(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	cfg := req.Config.Raw
+	if !stripAll(t, &resp.Diagnostics, &cfg) {
+		return
+	}
+
+	validatable.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config:             tfsdk.Config{Schema: schema, Raw: cfg},
+		ClientCapabilities: req.ClientCapabilities,
+	}, resp)
+}
+
+// gridDataSource wraps a data source with the Grid connection selection
+type gridDataSource struct {
+	dataSource datasource.DataSource
+	grids      *config.Grids
+}
+
+var _ datasource.DataSource = &gridDataSource{}
+var _ datasource.DataSourceWithConfigure = &gridDataSource{}
+
+func (d *gridDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	d.dataSource.Metadata(ctx, req, resp)
+}
+
+func (d *gridDataSource) innerSchema(ctx context.Context) datasourceschema.Schema {
+	var resp datasource.SchemaResponse
+	d.dataSource.Schema(ctx, datasource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func (d *gridDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	d.dataSource.Schema(ctx, req, resp)
+
+	attributes := make(map[string]datasourceschema.Attribute, len(resp.Schema.Attributes)+1)
+	for name, attribute := range resp.Schema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes[gridAttribute] = datasourceschema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Name of the provider `grid` connection to read from. The default connection configured by the top level provider attributes is used when not set.",
+		Validators:          gridValidators(),
+	}
+	resp.Schema.Attributes = attributes
+}
+
+func (d *gridDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	grids, ok := req.ProviderData.(*config.Grids)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *config.Grids, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.grids = grids
+}
+
+func (d *gridDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	grid := gridValue(req.Config.Raw)
+	conn, ok := selectGrid(d.grids, grid, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if configurable, ok := d.dataSource.(datasource.DataSourceWithConfigure); ok {
+		var configureResp datasource.ConfigureResponse
+		configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: conn.Client}, &configureResp)
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	ctx = config.WithGrid(ctx, conn)
+
+	schema := d.innerSchema(ctx)
+	t, err := newGridTypes(ctx, schema.Type())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Convert Data Source Data", err.Error())
+		return
+	}
+
+	cfg, state := req.Config.Raw, resp.State.Raw
+	if !stripAll(t, &resp.Diagnostics, &cfg, &state) {
+		return
+	}
+
+	innerResp := datasource.ReadResponse{
+		State:    tfsdk.State{Schema: schema, Raw: state},
+		Deferred: resp.Deferred,
+	}
+	d.dataSource.Read(ctx, datasource.ReadRequest{
+		Config:             tfsdk.Config{Schema: schema, Raw: cfg},
+		ProviderMeta:       req.ProviderMeta,
+		ClientCapabilities: req.ClientCapabilities,
+	}, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Deferred = innerResp.Deferred
+	extendInto(t, innerResp.State.Raw, grid, &resp.State.Raw, &resp.Diagnostics)
+}
+
+// gridListResource wraps a list resource with the Grid connection selection
+type gridListResource struct {
+	listResource list.ListResource
+	grids        *config.Grids
+}
+
+var _ list.ListResource = &gridListResource{}
+var _ list.ListResourceWithConfigure = &gridListResource{}
+
+func (l *gridListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	l.listResource.Metadata(ctx, req, resp)
+}
+
+func (l *gridListResource) innerConfigSchema(ctx context.Context) listschema.Schema {
+	var resp list.ListResourceSchemaResponse
+	l.listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func (l *gridListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	l.listResource.ListResourceConfigSchema(ctx, req, resp)
+
+	attributes := make(map[string]listschema.Attribute, len(resp.Schema.Attributes)+1)
+	for name, attribute := range resp.Schema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes[gridAttribute] = listschema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Name of the provider `grid` connection to query. The default connection configured by the top level provider attributes is used when not set.",
+		Validators:          gridValidators(),
+	}
+	resp.Schema.Attributes = attributes
+}
+
+func (l *gridListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	grids, ok := req.ProviderData.(*config.Grids)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *config.Grids, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	l.grids = grids
+}
+
+func (l *gridListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	grid := gridValue(req.Config.Raw)
+	conn, ok := selectGrid(l.grids, grid, &diags)
+	if !ok {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if configurable, ok := l.listResource.(list.ListResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: conn.Client}, &configureResp)
+		if configureResp.Diagnostics.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(configureResp.Diagnostics)
+			return
+		}
+	}
+	ctx = config.WithGrid(ctx, conn)
+
+	configSchema := l.innerConfigSchema(ctx)
+	configTypes, err := newGridTypes(ctx, configSchema.Type())
+	if err != nil {
+		diags.AddError("Unable to Convert List Resource Data", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	cfg := req.Config.Raw
+	if !stripAll(configTypes, &diags, &cfg) {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	outerResourceSchema, ok := req.ResourceSchema.(resourceschema.Schema)
+	if !ok {
+		diags.AddError("Unable to Convert List Resource Data", fmt.Sprintf("Unexpected resource schema type %T", req.ResourceSchema))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	resourceSchema := outerResourceSchema
+	resourceSchema.Attributes = make(map[string]resourceschema.Attribute, len(outerResourceSchema.Attributes))
+	for name, attribute := range outerResourceSchema.Attributes {
+		if name != gridAttribute {
+			resourceSchema.Attributes[name] = attribute
+		}
+	}
+	resourceTypes, err := newGridTypes(ctx, resourceSchema.Type())
+	if err != nil {
+		diags.AddError("Unable to Convert List Resource Data", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: configSchema, Raw: cfg}
+	innerReq.ResourceSchema = resourceSchema
+
+	var innerStream list.ListResultsStream
+	l.listResource.List(ctx, innerReq, &innerStream)
+	if innerStream.Results == nil {
+		stream.Results = list.NoListResults
+		return
+	}
+
+	// Results hold the resources in the wrapped schema, extend them with the grid attribute
+	stream.Results = func(push func(list.ListResult) bool) {
+		for result := range innerStream.Results {
+			if result.Resource != nil {
+				extended := tftypes.NewValue(resourceTypes.outer, nil)
+				extendInto(resourceTypes, result.Resource.Raw, grid, &extended, &result.Diagnostics)
+				result.Resource = &tfsdk.Resource{Schema: outerResourceSchema, Raw: extended}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
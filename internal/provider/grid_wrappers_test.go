@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+// testResource records the client it was configured with and copies the plan to the state
+type testResource struct {
+	client      *niosclient.APIClient
+	proxySearch string
+}
+
+type testResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "nios_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func (r *testResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.client, _ = req.ProviderData.(*niosclient.APIClient)
+}
+
+func (r *testResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data testResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	r.proxySearch = config.GetProxySearch(ctx)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func testGrids(t *testing.T) (*config.Grids, *config.Grid, *config.Grid) {
+	t.Helper()
+	grids := config.NewGrids()
+	defaultGrid := &config.Grid{Name: config.DefaultGrid, Client: &niosclient.APIClient{}, RetryTimeout: retry.DefaultRetryTimeout}
+	labGrid := &config.Grid{Name: "lab", Client: &niosclient.APIClient{}, ProxySearch: "GM", RetryTimeout: retry.DefaultRetryTimeout}
+	for _, grid := range []*config.Grid{defaultGrid, labGrid} {
+		if err := grids.Add(grid); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+	return grids, defaultGrid, labGrid
+}
+
+// TestGridResource_Create tests that the wrapped resource uses the selected Grid connection and keeps the grid attribute
+func TestGridResource_Create(t *testing.T) {
+	ctx := context.Background()
+	grids, defaultGrid, labGrid := testGrids(t)
+
+	tests := []struct {
+		name                string
+		grid                tftypes.Value
+		expectedClient      *niosclient.APIClient
+		expectedProxySearch string
+	}{
+		{name: "default connection", grid: tftypes.NewValue(tftypes.String, nil), expectedClient: defaultGrid.Client, expectedProxySearch: "LOCAL"},
+		{name: "named connection", grid: tftypes.NewValue(tftypes.String, "lab"), expectedClient: labGrid.Client, expectedProxySearch: "GM"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &testResource{}
+			r := wrapResources([]func() resource.Resource{func() resource.Resource { return inner }})[0]()
+
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: grids}, &resource.ConfigureResponse{})
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			if _, ok := schemaResp.Schema.Attributes[gridAttribute]; !ok {
+				t.Fatal("Expected the grid attribute in the schema")
+			}
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"name":        tftypes.NewValue(tftypes.String, "example"),
+				gridAttribute: tt.grid,
+			})
+			resp := resource.CreateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			r.Create(ctx, resource.CreateRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no error, got: %v", resp.Diagnostics)
+			}
+			if inner.client != tt.expectedClient {
+				t.Error("Expected the client of the selected Grid connection")
+			}
+			if inner.proxySearch != tt.expectedProxySearch {
+				t.Errorf("Expected proxy search %s, got %s", tt.expectedProxySearch, inner.proxySearch)
+			}
+			if !resp.State.Raw.Equal(plan) {
+				t.Errorf("Expected state %s, got %s", plan, resp.State.Raw)
+			}
+		})
+	}
+}
+
+// TestSelectGrid tests that only configured Grid connections can be selected
+func TestSelectGrid(t *testing.T) {
+	grids, _, labGrid := testGrids(t)
+
+	var diags diag.Diagnostics
+	if grid, ok := selectGrid(grids, tftypes.NewValue(tftypes.String, "lab"), &diags); !ok || grid != labGrid {
+		t.Errorf("Expected the lab connection, got: %v", diags)
+	}
+
+	if _, ok := selectGrid(grids, tftypes.NewValue(tftypes.String, "missing"), &diags); ok || !diags.HasError() {
+		t.Error("Expected an error for a connection that is not configured")
+	}
+}
+
+// TestSplitImportID tests the parsing of import IDs prefixed with a Grid connection name
+func TestSplitImportID(t *testing.T) {
+	tests := []struct {
+		id           string
+		expectedGrid tftypes.Value
+		expectedID   string
+	}{
+		{id: "record:a/ZG5z:example.com/default", expectedGrid: tftypes.NewValue(tftypes.String, nil), expectedID: "record:a/ZG5z:example.com/default"},
+		{id: "lab,record:a/ZG5z:example.com/default", expectedGrid: tftypes.NewValue(tftypes.String, "lab"), expectedID: "record:a/ZG5z:example.com/default"},
+		{id: "record:txt/ZG5z:a,b/default", expectedGrid: tftypes.NewValue(tftypes.String, nil), expectedID: "record:txt/ZG5z:a,b/default"},
+	}
+
+	for _, tt := range tests {
+		grid, id := splitImportID(tt.id)
+		if !grid.Equal(tt.expectedGrid) || id != tt.expectedID {
+			t.Errorf("splitImportID(%q): expected (%s, %s), got (%s, %s)", tt.id, tt.expectedGrid, tt.expectedID, grid, id)
+		}
+	}
+}
+
+// TestProviderSchema tests that the provider, resource and data source schemas are valid with the grid attribute
+func TestProviderSchema(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test", "test")())()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("Unexpected schema error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	for name, s := range resp.ResourceSchemas {
+		if !hasAttribute(s, gridAttribute) {
+			t.Errorf("Expected resource %s to have the grid attribute", name)
+		}
+	}
+	for name, s := range resp.DataSourceSchemas {
+		if !hasAttribute(s, gridAttribute) {
+			t.Errorf("Expected data source %s to have the grid attribute", name)
+		}
+	}
+}
+
+func hasAttribute(s *tfprotov6.Schema, name string) bool {
+	for _, attribute := range s.Block.Attributes {
+		if attribute.Name == name {
+			return true
+		}
+	}
+	return false
+}
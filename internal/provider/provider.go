@@ -4,10 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -36,6 +42,10 @@ import (
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/rpz"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/security"
 	"github.com/infobloxopen/terraform-provider-nios/internal/service/smartfolder"
+	"github.com/infobloxopen/terraform-provider-nios/internal/service/threatinsight"
+	"github.com/infobloxopen/terraform-provider-nios/internal/service/threatprotection"
+	"github.com/infobloxopen/terraform-provider-nios/internal/transport"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
 // Ensure NIOSProvider satisfies various provider interfaces.
@@ -52,13 +62,36 @@ type NIOSProvider struct {
 }
 
 // NIOSProviderModel describes the provider data model.
+// The top level attributes configure the default Grid connection, the grid blocks configure additional named connections.
 type NIOSProviderModel struct {
-	NIOSHostURL  types.String `tfsdk:"nios_host_url"`
-	NIOSUsername types.String `tfsdk:"nios_username"`
-	NIOSPassword types.String `tfsdk:"nios_password"`
-	ProxyURL     types.String `tfsdk:"proxy_url"`
-	ProxySearch  types.String `tfsdk:"proxy_search"`
-	RetryTimeout types.Int64  `tfsdk:"retry_timeout"`
+	ConnectionModel
+	Grids []GridConnectionModel `tfsdk:"grid"`
+}
+
+// ConnectionModel describes the settings of a Grid connection.
+type ConnectionModel struct {
+	NIOSHostURL           types.String `tfsdk:"nios_host_url"`
+	NIOSUsername          types.String `tfsdk:"nios_username"`
+	NIOSPassword          types.String `tfsdk:"nios_password"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	ProxySearch           types.String `tfsdk:"proxy_search"`
+	RetryTimeout          types.Int64  `tfsdk:"retry_timeout"`
+	SslVerify             types.Bool   `tfsdk:"ssl_verify"`
+	CACertFile            types.String `tfsdk:"ca_cert_file"`
+	CACertPEM             types.String `tfsdk:"ca_cert_pem"`
+	ClientCert            types.String `tfsdk:"client_cert"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	WAPIVersion           types.String `tfsdk:"wapi_version"`
+	CloudAPIHostURL       types.String `tfsdk:"cloud_api_host_url"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int64  `tfsdk:"requests_per_second"`
+	BatchReads            types.Bool   `tfsdk:"batch_reads"`
+}
+
+// GridConnectionModel describes a named Grid connection.
+type GridConnectionModel struct {
+	Name types.String `tfsdk:"name"`
+	ConnectionModel
 }
 
 func (p *NIOSProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -66,33 +99,121 @@ func (p *NIOSProvider) Metadata(_ context.Context, _ provider.MetadataRequest, r
 	resp.Version = p.version
 }
 
-func (p *NIOSProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "The NIOS provider is used to interact with the resources supported by Infoblox NIOS WAPI.",
-		Attributes: map[string]schema.Attribute{
-			"nios_host_url": schema.StringAttribute{
-				Optional: true,
+// connectionSchemaAttributes returns the attributes describing a Grid connection.
+func connectionSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"nios_host_url": schema.StringAttribute{
+			Optional: true,
+		},
+		"nios_username": schema.StringAttribute{
+			Optional: true,
+		},
+		"nios_password": schema.StringAttribute{
+			Optional: true,
+		},
+		"proxy_url": schema.StringAttribute{
+			Description: "Proxy URL to connect to Infoblox NIOS.",
+			Optional:    true,
+		},
+		"proxy_search": schema.StringAttribute{
+			Optional:    true,
+			Description: "Proxy search mode. Allowed values: LOCAL (default), GM.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("LOCAL", "GM"),
+			},
+		},
+		"retry_timeout": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Specifies the timeout duration (in seconds) for retrying operations that fail due to transient errors. Resources can override it per operation with a `timeouts` block.",
+		},
+		"ssl_verify": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Verify the TLS certificate presented by Infoblox NIOS. Defaults to `false`.",
+		},
+		"ca_cert_file": schema.StringAttribute{
+			Optional:    true,
+			Description: "Path to a PEM encoded CA bundle used to verify the Infoblox NIOS certificate when `ssl_verify` is enabled.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ca_cert_pem")),
 			},
-			"nios_username": schema.StringAttribute{
-				Optional: true,
+		},
+		"ca_cert_pem": schema.StringAttribute{
+			Optional:    true,
+			Description: "PEM encoded CA bundle used to verify the Infoblox NIOS certificate when `ssl_verify` is enabled.",
+		},
+		"client_cert": schema.StringAttribute{
+			Optional:    true,
+			Description: "PEM encoded client certificate used for certificate based authentication.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_key")),
 			},
-			"nios_password": schema.StringAttribute{
-				Optional: true,
+		},
+		"client_key": schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			Description: "PEM encoded private key of the client certificate.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_cert")),
 			},
-			"proxy_url": schema.StringAttribute{
-				Description: "Proxy URL to connect to Infoblox NIOS.",
-				Optional:    true,
+		},
+		"wapi_version": schema.StringAttribute{
+			Optional:    true,
+			Description: "WAPI version to use, for example `2.12.3`. When not set, the version is detected from the Grid: the newest version supported by both the Grid and the provider is used.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^v?[0-9]+\.[0-9]+(\.[0-9]+)?$`), "must be a WAPI version such as 2.13.6"),
 			},
-			"proxy_search": schema.StringAttribute{
-				Optional:    true,
-				Description: "Proxy search mode. Allowed values: LOCAL (default), GM.",
-				Validators: []validator.String{
-					stringvalidator.OneOf("LOCAL", "GM"),
-				},
+		},
+		"cloud_api_host_url": schema.StringAttribute{
+			Optional:    true,
+			Description: "URL of a Cloud Platform member with delegated authority. When set, create, update and delete requests are sent to this member through the Cloud Platform API and the objects are delegated to it, as recorded in their `cloud_info`. Reads and the Grid level objects the provider creates for itself, such as the Terraform Internal ID extensible attribute definition, keep using `nios_host_url`.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(`^https?://`), "must be an http or https URL"),
+			},
+		},
+		"max_concurrent_requests": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Maximum number of WAPI requests sent to the Grid at the same time, regardless of the Terraform parallelism. Unlimited by default.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"requests_per_second": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Maximum number of WAPI requests sent to the Grid per second. Unlimited by default.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
 			},
-			"retry_timeout": schema.Int64Attribute{
-				Optional:    true,
-				Description: "Specifies the timeout duration (in seconds) for retrying operations that fail due to transient errors.",
+		},
+		"batch_reads": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Coalesce the concurrent reads of objects of the same type into WAPI multi-requests, which speeds up the refresh of large configurations. Defaults to `false`.",
+		},
+	}
+}
+
+func (p *NIOSProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	gridAttributes := connectionSchemaAttributes()
+	gridAttributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Name of the Grid connection, referenced by the `grid` attribute of resources and data sources.",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(gridNameRegex, "must only contain letters, digits, underscores and dashes"),
+			stringvalidator.NoneOf(config.DefaultGrid),
+		},
+	}
+	gridAttributes["nios_host_url"] = schema.StringAttribute{
+		Required: true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The NIOS provider is used to interact with the resources supported by Infoblox NIOS WAPI.",
+		Attributes:  connectionSchemaAttributes(),
+		Blocks: map[string]schema.Block{
+			"grid": schema.ListNestedBlock{
+				Description: "Additional named Grid connections. Resources and data sources select one with their `grid` attribute, the top level attributes configure the `default` connection.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: gridAttributes,
+				},
 			},
 		},
 	}
@@ -107,6 +228,48 @@ func (p *NIOSProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	grids := config.NewGrids()
+
+	// The default connection can also be configured through the environment, it is optional once named connections exist
+	_, hostFromEnv := os.LookupEnv("NIOS_HOST_URL")
+	if len(data.Grids) == 0 || data.NIOSHostURL.ValueString() != "" || hostFromEnv {
+		if grid := p.configureGrid(ctx, config.DefaultGrid, data.ConnectionModel, &resp.Diagnostics); grid != nil {
+			resp.Diagnostics.Append(addGrid(grids, grid)...)
+		}
+	}
+	for _, gridData := range data.Grids {
+		if grid := p.configureGrid(ctx, gridData.Name.ValueString(), gridData.ConnectionModel, &resp.Diagnostics); grid != nil {
+			resp.Diagnostics.Append(addGrid(grids, grid)...)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.DataSourceData = grids
+	resp.ResourceData = grids
+	resp.ListResourceData = grids
+}
+
+func addGrid(grids *config.Grids, grid *config.Grid) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := grids.Add(grid); err != nil {
+		diags.AddError("Invalid Grid connection", err.Error())
+	}
+	return diags
+}
+
+// configureGrid builds the API client of a Grid connection and ensures the Grid is ready to be managed by Terraform.
+func (p *NIOSProvider) configureGrid(ctx context.Context, name string, data ConnectionModel, diags *diag.Diagnostics) *config.Grid {
+	// Diagnostics of named connections mention the connection they belong to
+	summary := func(s string) string {
+		if name == config.DefaultGrid {
+			return s
+		}
+		return fmt.Sprintf("%s (grid %q)", s, name)
+	}
+
 	client := niosclient.NewAPIClient(
 		option.WithClientName(fmt.Sprintf("terraform/%s#%s", p.version, p.commit)),
 		option.WithNIOSUsername(data.NIOSUsername.ValueString()),
@@ -116,28 +279,83 @@ func (p *NIOSProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		option.WithProxyURL(data.ProxyURL.ValueString()),
 	)
 
-	// Set ProxySearch configuration
-	config.SetProxySearch(data.ProxySearch.ValueString())
+	if !data.SslVerify.ValueBool() && (data.CACertFile.ValueString() != "" || data.CACertPEM.ValueString() != "") {
+		diags.AddWarning(
+			summary("CA certificate is not used"),
+			"A CA certificate is configured but `ssl_verify` is not enabled, the Infoblox NIOS certificate will not be verified.",
+		)
+	}
+
+	// Credentials and client certificates can also be set through the environment, which the client configuration resolves
+	clientCfg := client.GridAPI.Cfg
+	clientCert, clientKey := data.ClientCert.ValueString(), data.ClientKey.ValueString()
+	if clientCert == "" && clientKey == "" {
+		clientCert, clientKey = string(clientCfg.ClientCert), string(clientCfg.ClientKey)
+	}
+
+	httpClient, err := transport.NewHTTPClient(transport.Settings{
+		Username:              clientCfg.NIOSUsername,
+		Password:              clientCfg.NIOSPassword,
+		ProxyURL:              data.ProxyURL.ValueString(),
+		CloudAPIHostURL:       data.CloudAPIHostURL.ValueString(),
+		MaxConcurrentRequests: int(data.MaxConcurrentRequests.ValueInt64()),
+		RequestsPerSecond:     float64(data.RequestsPerSecond.ValueInt64()),
+		BatchReads:            data.BatchReads.ValueBool(),
+		TLS: transport.TLSSettings{
+			SslVerify:  data.SslVerify.ValueBool(),
+			CACertFile: data.CACertFile.ValueString(),
+			CACertPEM:  data.CACertPEM.ValueString(),
+			ClientCert: clientCert,
+			ClientKey:  clientKey,
+		},
+	})
+	if err != nil {
+		diags.AddError(summary("Invalid connection configuration"), err.Error())
+		return nil
+	}
+
+	// The go client always builds its own insecure HTTP client, replace it with the configured one
+	setHTTPClient(client, httpClient)
+
+	wapiVersion := utils.NormalizeWAPIVersion(data.WAPIVersion.ValueString())
+	if wapiVersion == "" {
+		wapiVersion, err = utils.DetectWAPIVersion(ctx, httpClient, clientCfg.NIOSHostURL)
+		if err != nil {
+			diags.AddWarning(
+				summary("Unable to detect WAPI version"),
+				fmt.Sprintf("Falling back to WAPI %s: %s", utils.DefaultWAPIVersion, err.Error()),
+			)
+			wapiVersion = utils.DefaultWAPIVersion
+		}
+	}
+	utils.SetWAPIVersion(client, wapiVersion)
 
-	// Set global retry timeout if specified
-	if !data.RetryTimeout.IsNull() && !data.RetryTimeout.IsUnknown() {
-		retry.SetRetryTimeout(data.RetryTimeout.ValueInt64())
+	// The NIOS session opened by the HTTP client is closed when the provider shuts down
+	transport.RegisterSession(httpClient, utils.WAPIBaseURL(clientCfg))
+
+	grid := &config.Grid{
+		Name:         name,
+		Client:       client,
+		ProxySearch:  data.ProxySearch.ValueString(),
+		RetryTimeout: retry.DefaultRetryTimeout,
+	}
+	if !data.RetryTimeout.IsNull() && !data.RetryTimeout.IsUnknown() && data.RetryTimeout.ValueInt64() >= 0 {
+		grid.RetryTimeout = time.Duration(data.RetryTimeout.ValueInt64()) * time.Second
 	}
 
-	err := checkAndCreatePreRequisites(ctx, client)
+	// The extensible attribute definition is a Grid level object, it is not delegated to a Cloud Platform member
+	err = checkAndCreatePreRequisites(transport.WithGridMaster(config.WithGrid(ctx, grid)), client)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to ensure Terraform extensible attribute exists",
+		diags.AddError(
+			summary("Failed to ensure Terraform extensible attribute exists"),
 			err.Error(),
 		)
 	}
-	resp.DataSourceData = client
-	resp.ResourceData = client
-	resp.ListResourceData = client
+	return grid
 }
 
 func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return wrapResources([]func() resource.Resource{
 
 		dns.NewRecordAResource,
 		dns.NewRecordAaaaResource,
@@ -164,8 +382,14 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		dns.NewNsgroupForwardingmemberResource,
 		dns.NewNsgroupForwardstubserverResource,
 		dns.NewNsgroupStubmemberResource,
+		dns.NewDns64groupResource,
+		dns.NewDdnsPrincipalclusterResource,
+		dns.NewDdnsPrincipalclusterGroupResource,
+		dns.NewRecordnamepolicyResource,
 		dns.NewIPAllocationResource,
 		dns.NewIPAssociationResource,
+		dns.NewRecordHostResource,
+		dns.NewDnssecOperationResource,
 		dns.NewSharedrecordgroupResource,
 		dns.NewSharedrecordTxtResource,
 		dns.NewSharedrecordMxResource,
@@ -173,6 +397,8 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		dns.NewSharedrecordAResource,
 		dns.NewSharedrecordCnameResource,
 		dns.NewSharedrecordAaaaResource,
+		dns.NewGridDnsResource,
+		dns.NewMemberDnsResource,
 
 		dhcp.NewFixedaddressResource,
 		dhcp.NewSharednetworkResource,
@@ -198,6 +424,8 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		dhcp.NewIpv6filteroptionResource,
 		dhcp.NewFilterrelayagentResource,
 		dhcp.NewFilteroptionResource,
+		dhcp.NewGridDhcppropertiesResource,
+		dhcp.NewMemberDhcppropertiesResource,
 
 		dtc.NewDtcLbdnResource,
 		dtc.NewDtcServerResource,
@@ -214,6 +442,8 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		dtc.NewDtcRecordNaptrResource,
 		dtc.NewDtcRecordCnameResource,
 		dtc.NewDtcRecordAaaaResource,
+		dtc.NewDtcCertificateResource,
+		dtc.NewDtcTopologyRuleResource,
 
 		ipam.NewNetworkResource,
 		ipam.NewNetworktemplateResource,
@@ -230,6 +460,10 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 
 		cloud.NewAwsrte53taskgroupResource,
 		cloud.NewAwsuserResource,
+		cloud.NewAzurednstaskgroupResource,
+		cloud.NewAzureuserResource,
+		cloud.NewGcpdnstaskgroupResource,
+		cloud.NewGcpuserResource,
 
 		security.NewAdminuserResource,
 		security.NewAdmingroupResource,
@@ -242,6 +476,14 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		security.NewLdapAuthServiceResource,
 		security.NewTacacsplusAuthserviceResource,
 		security.NewRadiusAuthserviceResource,
+		security.NewAdAuthServiceResource,
+		security.NewAuthpolicyResource,
+		security.NewApprovalworkflowResource,
+		security.NewUserprofileResource,
+		security.NewNetworkuserResource,
+		security.NewHsmThaleslunagroupResource,
+		security.NewHsmEntrustnshieldgroupResource,
+		security.NewHsmAllgroupsResource,
 
 		misc.NewRulesetResource,
 		misc.NewBfdtemplateResource,
@@ -262,6 +504,8 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		grid.NewMemberResource,
 		grid.NewUpgradescheduleResource,
 		grid.NewGridJoinResource,
+		grid.NewGridThreatinsightResource,
+		grid.NewMemberThreatinsightResource,
 
 		discovery.NewDiscoveryCredentialgroupResource,
 		discovery.NewVdiscoverytaskResource,
@@ -294,11 +538,20 @@ func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 		microsoft.NewMsserverResource,
 		microsoft.NewMsserverAdsitesSiteResource,
 		microsoft.NewMssuperscopeResource,
-	}
+		microsoft.NewMsserverDhcpResource,
+		microsoft.NewMsserverDnsResource,
+
+		threatprotection.NewThreatprotectionProfileResource,
+		threatprotection.NewThreatprotectionProfileRuleResource,
+		threatprotection.NewThreatprotectionGridRuleResource,
+
+		threatinsight.NewThreatinsightAllowlistResource,
+		threatinsight.NewThreatinsightCloudclientResource,
+	})
 }
 
 func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return wrapDataSources([]func() datasource.DataSource{
 
 		dns.NewRecordADataSource,
 		dns.NewRecordAaaaDataSource,
@@ -325,7 +578,21 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		dns.NewNsgroupForwardingmemberDataSource,
 		dns.NewNsgroupForwardstubserverDataSource,
 		dns.NewNsgroupStubmemberDataSource,
+		dns.NewDns64groupDataSource,
+		dns.NewDdnsPrincipalclusterDataSource,
+		dns.NewDdnsPrincipalclusterGroupDataSource,
+		dns.NewRecordnamepolicyDataSource,
 		dns.NewRecordHostDataSource,
+		dns.NewDnssecDsRecordsDataSource,
+		dns.NewRecordDnskeyDataSource,
+		dns.NewRecordDsDataSource,
+		dns.NewRecordNsecDataSource,
+		dns.NewRecordNsec3DataSource,
+		dns.NewRecordNsec3paramDataSource,
+		dns.NewRecordRrsigDataSource,
+		dns.NewRecordDhcidDataSource,
+		dns.NewZoneAuthDiscrepancyDataSource,
+		dns.NewAllrecordsDataSource,
 		dns.NewSharedrecordgroupDataSource,
 		dns.NewSharedrecordTxtDataSource,
 		dns.NewSharedrecordMxDataSource,
@@ -358,6 +625,8 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		dhcp.NewIpv6filteroptionDataSource,
 		dhcp.NewFilterrelayagentDataSource,
 		dhcp.NewFilteroptionDataSource,
+		dhcp.NewLeaseDataSource,
+		dhcp.NewDhcpStatisticsDataSource,
 
 		dtc.NewDtcLbdnDataSource,
 		dtc.NewDtcServerDataSource,
@@ -375,6 +644,9 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		dtc.NewDtcRecordNaptrDataSource,
 		dtc.NewDtcRecordCnameDataSource,
 		dtc.NewDtcRecordAaaaDataSource,
+		dtc.NewDtcTopologyLabelDataSource,
+		dtc.NewDtcObjectDataSource,
+		dtc.NewDtcAllrecordsDataSource,
 
 		ipam.NewNetworkDataSource,
 		ipam.NewNetworktemplateDataSource,
@@ -388,9 +660,16 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		ipam.NewVlanrangeDataSource,
 		ipam.NewSuperhostDataSource,
 		ipam.NewIpv6networktemplateDataSource,
+		ipam.NewIpv4addressDataSource,
+		ipam.NewIpv6addressDataSource,
+		ipam.NewIpamStatisticsDataSource,
 
 		cloud.NewAwsrte53taskgroupDataSource,
 		cloud.NewAwsuserDataSource,
+		cloud.NewAzurednstaskgroupDataSource,
+		cloud.NewAzureuserDataSource,
+		cloud.NewGcpdnstaskgroupDataSource,
+		cloud.NewGcpuserDataSource,
 
 		security.NewAdminroleDataSource,
 		security.NewAdminuserDataSource,
@@ -403,6 +682,12 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		security.NewLdapAuthServiceDataSource,
 		security.NewTacacsplusAuthserviceDataSource,
 		security.NewRadiusAuthserviceDataSource,
+		security.NewAdAuthServiceDataSource,
+		security.NewLocaluserAuthserviceDataSource,
+		security.NewApprovalworkflowDataSource,
+		security.NewNetworkuserDataSource,
+		security.NewHsmThaleslunagroupDataSource,
+		security.NewHsmEntrustnshieldgroupDataSource,
 
 		misc.NewRulesetDataSource,
 		misc.NewBfdtemplateDataSource,
@@ -454,17 +739,29 @@ func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.Data
 		microsoft.NewMsserverDataSource,
 		microsoft.NewMsserverAdsitesSiteDataSource,
 		microsoft.NewMssuperscopeDataSource,
-	}
+		microsoft.NewMsserverDhcpDataSource,
+		microsoft.NewMsserverDnsDataSource,
+		microsoft.NewMsserverAdsitesDomainDataSource,
+
+		threatprotection.NewThreatprotectionRulesetDataSource,
+		threatprotection.NewThreatprotectionRulecategoryDataSource,
+		threatprotection.NewThreatprotectionActiveRulesetDataSource,
+
+		threatinsight.NewThreatinsightAllowlistDataSource,
+		threatinsight.NewThreatinsightInsightAllowlistDataSource,
+		threatinsight.NewThreatinsightModulesetDataSource,
+		threatinsight.NewThreatinsightActiveModulesetDataSource,
+	})
 }
 
 func (p *NIOSProvider) ListResources(ctx context.Context) []func() list.ListResource {
-	return []func() list.ListResource{
+	return wrapListResources([]func() list.ListResource{
 		dns.NewRecordAList,
 
 		dhcp.NewFixedaddressList,
 
 		ipam.NewNetworkviewList,
-	}
+	})
 }
 
 func New(version, commit string) func() provider.Provider {
@@ -476,6 +773,30 @@ func New(version, commit string) func() provider.Provider {
 	}
 }
 
+// setHTTPClient makes every WAPI service client use the given HTTP client.
+// Each service client is built with its own configuration, so the client has to be set on all of them.
+func setHTTPClient(client *niosclient.APIClient, httpClient *http.Client) {
+	client.ACLAPI.Cfg.HTTPClient = httpClient
+	client.CloudAPI.Cfg.HTTPClient = httpClient
+	client.DHCPAPI.Cfg.HTTPClient = httpClient
+	client.DiscoveryAPI.Cfg.HTTPClient = httpClient
+	client.DNSAPI.Cfg.HTTPClient = httpClient
+	client.DTCAPI.Cfg.HTTPClient = httpClient
+	client.FederatedRealmsAPI.Cfg.HTTPClient = httpClient
+	client.GridAPI.Cfg.HTTPClient = httpClient
+	client.IPAMAPI.Cfg.HTTPClient = httpClient
+	client.MicrosoftAPI.Cfg.HTTPClient = httpClient
+	client.MiscAPI.Cfg.HTTPClient = httpClient
+	client.NotificationAPI.Cfg.HTTPClient = httpClient
+	client.ParentalControlAPI.Cfg.HTTPClient = httpClient
+	client.RIRAPI.Cfg.HTTPClient = httpClient
+	client.RPZAPI.Cfg.HTTPClient = httpClient
+	client.SecurityAPI.Cfg.HTTPClient = httpClient
+	client.SmartFolderAPI.Cfg.HTTPClient = httpClient
+	client.ThreatInsightAPI.Cfg.HTTPClient = httpClient
+	client.ThreatProtectionAPI.Cfg.HTTPClient = httpClient
+}
+
 // checkAndCreatePreRequisites creates Terraform Internal ID EA if it doesn't exist
 func checkAndCreatePreRequisites(ctx context.Context, client *niosclient.APIClient) error {
 	var readableAttributesForEADefinition = "allowed_object_types,comment,default_value,flags,list_values,max,min,name,namespace,type"
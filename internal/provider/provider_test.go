@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/transport"
+)
+
+// testProviderConfig builds a provider configuration from the given top level attribute values, the other attributes are null
+func testProviderConfig(t *testing.T, p provider.Provider, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+// TestProviderConfigure_BatchReads tests that batch_reads enables the batching transport of the default connection
+func TestProviderConfigure_BatchReads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/extensibleattributedef") {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"result": [{"_ref": "extensibleattributedef/ZG5z:Terraform%20Internal%20ID", "name": "Terraform Internal ID", "type": "STRING"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		batchReads tftypes.Value
+		expected   bool
+	}{
+		{name: "batch_reads not set", batchReads: tftypes.NewValue(tftypes.Bool, nil), expected: false},
+		{name: "batch_reads disabled", batchReads: tftypes.NewValue(tftypes.Bool, false), expected: false},
+		{name: "batch_reads enabled", batchReads: tftypes.NewValue(tftypes.Bool, true), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := New("test", "test")()
+			req := provider.ConfigureRequest{
+				Config: testProviderConfig(t, p, map[string]tftypes.Value{
+					"nios_host_url": tftypes.NewValue(tftypes.String, server.URL),
+					"nios_username": tftypes.NewValue(tftypes.String, "admin"),
+					"nios_password": tftypes.NewValue(tftypes.String, "secret"),
+					"wapi_version":  tftypes.NewValue(tftypes.String, "2.13.6"),
+					"batch_reads":   tt.batchReads,
+				}),
+			}
+			var resp provider.ConfigureResponse
+			p.Configure(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no error, got: %v", resp.Diagnostics)
+			}
+
+			grids, ok := resp.ResourceData.(*config.Grids)
+			if !ok {
+				t.Fatalf("Expected *config.Grids, got: %T", resp.ResourceData)
+			}
+			grid, err := grids.Get(config.DefaultGrid)
+			if err != nil {
+				t.Fatalf("Expected the default connection, got: %v", err)
+			}
+
+			_, batching := grid.Client.DNSAPI.Cfg.HTTPClient.Transport.(*transport.BatchTransport)
+			if batching != tt.expected {
+				t.Errorf("Expected batching transport %t, got %t", tt.expected, batching)
+			}
+		})
+	}
+}
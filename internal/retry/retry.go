@@ -2,10 +2,15 @@ package retry
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"net"
+	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,31 +19,40 @@ import (
 const (
 	initialBackoff  = 1 * time.Second
 	maxBackoff      = 30 * time.Second
+	maxRetryAfter   = 5 * time.Minute
 	retryTimeoutMsg = "retry timeout exceeded while waiting for the operation to complete, the failure may be due to a transient issue or request cancellation"
 )
 
-// Can be overridden at the provider config level
-var RetryTimeout = 60 * time.Second
+// DefaultRetryTimeout is the retry timeout used when the context carries no Grid connection specific timeout
+const DefaultRetryTimeout = 60 * time.Second
 
 type (
 	RetryableFunc func(error) bool
 	RetryFunc     func(ctx context.Context) (int, error)
 )
 
-// SetRetryTimeout sets the global retry timeout duration.
-func SetRetryTimeout(timeout int64) {
-	if timeout < 0 {
-		return
+// timeoutKey is the context key of the retry timeout of the Grid connection handling a call
+type timeoutKey struct{}
+
+// WithTimeout returns a copy of ctx carrying the retry timeout of a Grid connection
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, timeout)
+}
+
+// Timeout returns the retry timeout carried by ctx, or DefaultRetryTimeout if ctx carries none
+func Timeout(ctx context.Context) time.Duration {
+	if timeout, ok := ctx.Value(timeoutKey{}).(time.Duration); ok {
+		return timeout
 	}
-	RetryTimeout = time.Duration(timeout) * time.Second
+	return DefaultRetryTimeout
 }
 
-// Do retries fn with the global RetryTimeout until:
+// Do retries fn with the retry timeout carried by parentCtx until:
 // - fn succeeds
 // - error is non-retryable
 // - context is canceled or times out
 func Do(parentCtx context.Context, isRetryable RetryableFunc, fn RetryFunc) error {
-	return DoWithTimeout(parentCtx, RetryTimeout, isRetryable, fn)
+	return DoWithTimeout(parentCtx, Timeout(parentCtx), isRetryable, fn)
 }
 
 // DoWithTimeout retries fn with a custom timeout until:
@@ -54,7 +68,11 @@ func DoWithTimeout(parentCtx context.Context, timeout time.Duration, isRetryable
 
 	for {
 		attempt++
-		_, err := fn(ctx)
+
+		// Each attempt gets its own hint so a Retry-After header seen by RetryAfterTransport
+		// only influences the wait that follows the response carrying it
+		hint := &retryAfterHint{}
+		statusCode, err := fn(context.WithValue(ctx, retryAfterKey{}, hint))
 		if err == nil {
 			return nil
 		}
@@ -67,20 +85,25 @@ func DoWithTimeout(parentCtx context.Context, timeout time.Duration, isRetryable
 		}
 
 		// Stop retrying if error is not retryable
-		if isRetryable == nil || !isRetryable(err) {
+		if isRetryable == nil || !isRetryable(withStatusCode(err, statusCode)) {
 			return err
 		}
 
+		wait := hint.get()
+		if wait <= 0 {
+			wait = jitter(backoff)
+		}
+
 		tflog.Warn(ctx, fmt.Sprintf(
-			"Transient error detected, retrying request (attempt=%d, backoff=%s, err=%v)",
-			attempt, backoff, err,
+			"Transient error detected, retrying request (attempt=%d, status=%d, wait=%s, err=%v)",
+			attempt, statusCode, wait, err,
 		))
 
-		// Wait before retrying with exponential backoff
+		// Wait before retrying with exponential backoff or the server requested delay
 		select {
 		case <-ctx.Done():
 			return errors.New(retryTimeoutMsg)
-		case <-time.After(backoff):
+		case <-time.After(wait):
 		}
 
 		// Increase backoff for next iteration, capped at maxBackoff
@@ -91,16 +114,63 @@ func DoWithTimeout(parentCtx context.Context, timeout time.Duration, isRetryable
 	}
 }
 
-// TransientErrors determines if an error is retryable based on transient conditions.
-// TODO: Currently returns false, treating all errors as non-retryable.
-// This can be extended in the future to include predicates for specific transient errors
-// such as network errors, temporary service unavailability (5xx errors), etc.
+// jitter returns a random duration in [backoff/2, backoff] so that parallel
+// operations hitting the same transient failure do not retry in lockstep.
+func jitter(backoff time.Duration) time.Duration {
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+	return half + rand.N(half+1)
+}
+
+// transientStatusCodes are HTTP statuses returned by NIOS or an intermediate proxy
+// when the Grid Master is temporarily unable to serve the request.
+var transientStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// transientWAPIErrorPatterns match the text of WAPI error bodies returned while
+// the Grid database is locked or the Grid Master is busy processing other requests.
+var transientWAPIErrorPatterns = []string{
+	"database is locked",
+	"database locked",
+	"grid is busy",
+	"server is busy",
+	"system is busy",
+	"temporarily unavailable",
+	"try again later",
+}
+
+// TransientErrors determines if an error is retryable based on transient conditions:
+// - the HTTP status code returned by the RetryFunc is 429, 502, 503 or 504
+// - the WAPI error body reports a locked database or a busy Grid
+// - the error is a network error (see IsNetworkError)
 func TransientErrors(err error) bool {
 	if err == nil {
 		return false
 	}
-	// Everything is non-retryable for now
-	return false
+
+	if transientStatusCodes[StatusCode(err)] {
+		return true
+	}
+
+	if wapiErr, ok := ParseWAPIError(err); ok {
+		msg := strings.ToLower(wapiErr.Error + " " + wapiErr.Text)
+		for _, pattern := range transientWAPIErrorPatterns {
+			if strings.Contains(msg, pattern) {
+				return true
+			}
+		}
+		// A well-formed WAPI error that is not a busy/locked condition is a
+		// definitive answer from the server and must not be retried
+		return false
+	}
+
+	return IsNetworkError(err)
 }
 
 // IsNetworkError checks if the error is a network-related error.
@@ -136,8 +206,146 @@ func IsNetworkError(err error) bool {
 	return false
 }
 
-// IsAlreadyExistsErr is currently disabled and always returns false.
-// Later, when retryable errors are added, we should add proper NIOS error string matching here for resource already exists cases.
-func IsAlreadyExistsErr(_err error) bool {
-	return false
+// IsAlreadyExistsErr reports whether the WAPI rejected a create because the object already exists.
+// This typically happens when a create is retried after a transient failure that occurred
+// once the object had already been committed on the Grid.
+func IsAlreadyExistsErr(err error) bool {
+	wapiErr, ok := ParseWAPIError(err)
+	if !ok {
+		return false
+	}
+	return wapiErr.Code == "Client.Ibap.Data.Conflict" &&
+		strings.Contains(strings.ToLower(wapiErr.Error+" "+wapiErr.Text), "already exists")
+}
+
+// WAPIError is the error body returned by NIOS WAPI for failed requests.
+type WAPIError struct {
+	Error string `json:"Error"`
+	Code  string `json:"code"`
+	Text  string `json:"text"`
+}
+
+// ParseWAPIError extracts the WAPI error body from an error returned by the NIOS client.
+// It returns false if the error does not carry a JSON WAPI error body.
+func ParseWAPIError(err error) (*WAPIError, bool) {
+	var bodyErr interface{ Body() []byte }
+	if err == nil || !errors.As(err, &bodyErr) {
+		return nil, false
+	}
+
+	var wapiErr WAPIError
+	if jsonErr := json.Unmarshal(bodyErr.Body(), &wapiErr); jsonErr != nil {
+		return nil, false
+	}
+	if wapiErr.Error == "" && wapiErr.Code == "" && wapiErr.Text == "" {
+		return nil, false
+	}
+	return &wapiErr, true
+}
+
+// statusCodeError attaches the HTTP status code returned by a RetryFunc to its error
+// so that a RetryableFunc can take the status into account.
+type statusCodeError struct {
+	statusCode int
+	err        error
+}
+
+func (e *statusCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *statusCodeError) Unwrap() error {
+	return e.err
+}
+
+func withStatusCode(err error, statusCode int) error {
+	if statusCode == 0 {
+		return err
+	}
+	return &statusCodeError{statusCode: statusCode, err: err}
+}
+
+// StatusCode returns the HTTP status code attached to err by Do/DoWithTimeout,
+// or 0 if the request did not get an HTTP response.
+func StatusCode(err error) int {
+	var scErr *statusCodeError
+	if errors.As(err, &scErr) {
+		return scErr.statusCode
+	}
+	return 0
+}
+
+type retryAfterKey struct{}
+
+// retryAfterHint carries the Retry-After delay of the latest response from the transport
+// back to the retry loop.
+type retryAfterHint struct {
+	mu    sync.Mutex
+	delay time.Duration
+}
+
+func (h *retryAfterHint) set(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.delay = d
+}
+
+func (h *retryAfterHint) get() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.delay
+}
+
+// RetryAfterTransport records the Retry-After header of 429 and 503 responses
+// so that Do/DoWithTimeout wait for the delay requested by the server instead of
+// the exponential backoff.
+type RetryAfterTransport struct {
+	Transport http.RoundTripper
+}
+
+// NewRetryAfterTransport wraps next with a RetryAfterTransport.
+func NewRetryAfterTransport(next http.RoundTripper) *RetryAfterTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &RetryAfterTransport{Transport: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if hint, ok := req.Context().Value(retryAfterKey{}).(*retryAfterHint); ok {
+			hint.set(ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()))
+		}
+	}
+	return resp, nil
+}
+
+// ParseRetryAfter parses a Retry-After header value given either as delay seconds or
+// as an HTTP date. It returns 0 if the value is empty or invalid, and caps the delay at maxRetryAfter.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		delay = date.Sub(now)
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+	if delay > maxRetryAfter {
+		return maxRetryAfter
+	}
+	return delay
 }
@@ -4,10 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
 
+// bodyError mimics the error returned by the NIOS client, which exposes the response body
+type bodyError struct {
+	msg  string
+	body []byte
+}
+
+func (e bodyError) Error() string { return e.msg }
+
+func (e bodyError) Body() []byte { return e.body }
+
 // TestDo_Success tests that Do returns nil when the function succeeds
 func TestDo_Success(t *testing.T) {
 	callCount := 0
@@ -54,9 +66,7 @@ func TestDo_NonRetryableError(t *testing.T) {
 // TestDo_RetryableErrorWithEventualSuccess tests retrying until success
 func TestDo_RetryableErrorWithEventualSuccess(t *testing.T) {
 	// Reduce timeout for faster test execution
-	originalTimeout := RetryTimeout
-	SetRetryTimeout(5)
-	defer func() { RetryTimeout = originalTimeout }()
+	ctx := WithTimeout(context.Background(), 5*time.Second)
 
 	callCount := 0
 	fn := func(ctx context.Context) (int, error) {
@@ -71,7 +81,7 @@ func TestDo_RetryableErrorWithEventualSuccess(t *testing.T) {
 		return true // Always retryable
 	}
 
-	err := Do(context.Background(), isRetryable, fn)
+	err := Do(ctx, isRetryable, fn)
 	if err != nil {
 		t.Errorf("Expected no error after retries, got: %v", err)
 	}
@@ -83,9 +93,7 @@ func TestDo_RetryableErrorWithEventualSuccess(t *testing.T) {
 // TestDo_ContextTimeout tests that Do respects context timeout
 func TestDo_ContextTimeout(t *testing.T) {
 	// Set a very short timeout
-	originalTimeout := RetryTimeout
-	SetRetryTimeout(1)
-	defer func() { RetryTimeout = originalTimeout }()
+	ctx := WithTimeout(context.Background(), 1*time.Second)
 
 	callCount := 0
 	fn := func(ctx context.Context) (int, error) {
@@ -99,7 +107,7 @@ func TestDo_ContextTimeout(t *testing.T) {
 		return true
 	}
 
-	err := Do(context.Background(), isRetryable, fn)
+	err := Do(ctx, isRetryable, fn)
 	if err == nil {
 		t.Error("Expected timeout error, got nil")
 	}
@@ -157,25 +165,15 @@ func TestDo_NilRetryableFunc(t *testing.T) {
 	}
 }
 
-// TestSetRetryTimeout tests the SetRetryTimeout function
-func TestSetRetryTimeout(t *testing.T) {
-	originalTimeout := RetryTimeout
-	defer func() { RetryTimeout = originalTimeout }()
-
-	SetRetryTimeout(10)
-	if RetryTimeout != 10*time.Second {
-		t.Fatalf("expected 10s, got %v", RetryTimeout)
-	}
-
-	SetRetryTimeout(0)
-	if RetryTimeout != 0 {
-		t.Fatalf("expected 0, got %v", RetryTimeout)
+// TestTimeout tests that the retry timeout carried by the context takes precedence over DefaultRetryTimeout
+func TestTimeout(t *testing.T) {
+	if got := Timeout(context.Background()); got != DefaultRetryTimeout {
+		t.Errorf("expected %v without a connection timeout, got %v", DefaultRetryTimeout, got)
 	}
 
-	RetryTimeout = 5 * time.Second
-	SetRetryTimeout(-1)
-	if RetryTimeout != 5*time.Second {
-		t.Fatalf("negative timeout should not change value")
+	ctx := WithTimeout(context.Background(), 5*time.Second)
+	if got := Timeout(ctx); got != 5*time.Second {
+		t.Errorf("expected 5s from the context, got %v", got)
 	}
 }
 
@@ -271,3 +269,177 @@ func TestDoWithTimeout_CustomTimeout(t *testing.T) {
 		t.Errorf("Expected timeout message, got: %v", err)
 	}
 }
+
+// TestTransientErrors tests classification of status codes, WAPI error bodies and network errors
+func TestTransientErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"plain error", errors.New("invalid value"), false},
+		{"bad gateway", withStatusCode(errors.New("502 Bad Gateway"), http.StatusBadGateway), true},
+		{"service unavailable", withStatusCode(errors.New("503 Service Unavailable"), http.StatusServiceUnavailable), true},
+		{"gateway timeout", withStatusCode(errors.New("504 Gateway Timeout"), http.StatusGatewayTimeout), true},
+		{"too many requests", withStatusCode(errors.New("429 Too Many Requests"), http.StatusTooManyRequests), true},
+		{"bad request", withStatusCode(errors.New("400 Bad Request"), http.StatusBadRequest), false},
+		{
+			"database locked",
+			withStatusCode(bodyError{
+				msg:  "400 Bad Request",
+				body: []byte(`{"Error": "AdmConProtoError: The database is locked, please try again later", "code": "Client.Ibap.Proto", "text": "The database is locked, please try again later"}`),
+			}, http.StatusBadRequest),
+			true,
+		},
+		{
+			"grid busy",
+			bodyError{
+				msg:  "400 Bad Request",
+				body: []byte(`{"Error": "AdmConError: Grid is busy", "code": "Server", "text": "Grid is busy"}`),
+			},
+			true,
+		},
+		{
+			"definitive wapi error",
+			bodyError{
+				msg:  "400 Bad Request, connection reset",
+				body: []byte(`{"Error": "AdmConDataError: None (IBDataConflictError)", "code": "Client.Ibap.Data.Conflict", "text": "connection reset is not a valid name"}`),
+			},
+			false,
+		},
+		{"connection reset", errors.New("read tcp 10.0.0.1:443: connection reset by peer"), true},
+		{"connection refused", errors.New("dial tcp 10.0.0.1:443: connection refused"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TransientErrors(tt.err); got != tt.want {
+				t.Errorf("TransientErrors(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsAlreadyExistsErr tests detection of duplicate object errors
+func TestIsAlreadyExistsErr(t *testing.T) {
+	duplicate := bodyError{
+		msg:  "400 Bad Request",
+		body: []byte(`{"Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:The record 'a.example.com' already exists.)", "code": "Client.Ibap.Data.Conflict", "text": "The record 'a.example.com' already exists."}`),
+	}
+	if !IsAlreadyExistsErr(duplicate) {
+		t.Error("expected duplicate object error to be detected")
+	}
+
+	conflict := bodyError{
+		msg:  "400 Bad Request",
+		body: []byte(`{"Error": "AdmConDataError: None (IBDataConflictError)", "code": "Client.Ibap.Data.Conflict", "text": "Invalid value for ttl"}`),
+	}
+	if IsAlreadyExistsErr(conflict) {
+		t.Error("expected conflict without duplicate object to be ignored")
+	}
+
+	if IsAlreadyExistsErr(errors.New("already exists")) {
+		t.Error("expected error without WAPI body to be ignored")
+	}
+}
+
+// TestDo_PassesStatusCodeToRetryableFunc tests that the RetryableFunc sees the status returned by the RetryFunc
+func TestDo_PassesStatusCodeToRetryableFunc(t *testing.T) {
+	var seen int
+	fn := func(ctx context.Context) (int, error) {
+		return http.StatusBadRequest, errors.New("bad request")
+	}
+
+	isRetryable := func(err error) bool {
+		seen = StatusCode(err)
+		return false
+	}
+
+	err := Do(context.Background(), isRetryable, fn)
+	if err == nil || err.Error() != "bad request" {
+		t.Errorf("expected original error, got: %v", err)
+	}
+	if seen != http.StatusBadRequest {
+		t.Errorf("expected status %d, got: %d", http.StatusBadRequest, seen)
+	}
+}
+
+// TestParseRetryAfter tests parsing of delay seconds and HTTP dates
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"invalid", 0},
+		{"-5", 0},
+		{"3", 3 * time.Second},
+		{"3600", maxRetryAfter},
+		{now.Add(10 * time.Second).Format(http.TimeFormat), 10 * time.Second},
+		{now.Add(-10 * time.Second).Format(http.TimeFormat), 0},
+	}
+
+	for _, tt := range tests {
+		if got := ParseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("ParseRetryAfter(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+// TestJitter tests that jitter stays within [backoff/2, backoff]
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		got := jitter(initialBackoff)
+		if got < initialBackoff/2 || got > initialBackoff {
+			t.Fatalf("jitter(%s) = %s, out of range", initialBackoff, got)
+		}
+	}
+}
+
+// TestDoWithTimeout_RetryAfter tests that the Retry-After header recorded by RetryAfterTransport is honoured
+func TestDoWithTimeout_RetryAfter(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryAfterTransport(http.DefaultTransport)}
+
+	fn := func(ctx context.Context) (int, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			return 0, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return resp.StatusCode, errors.New(resp.Status)
+		}
+		return resp.StatusCode, nil
+	}
+
+	start := time.Now()
+	err := DoWithTimeout(context.Background(), 10*time.Second, TransientErrors, fn)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got: %d", requests)
+	}
+	if elapsed := time.Since(start); elapsed < 2*time.Second {
+		t.Errorf("Expected to wait for Retry-After delay, waited %s", elapsed)
+	}
+}
@@ -139,7 +139,7 @@ func (d *NamedaclDataSource) Read(ctx context.Context, req datasource.ReadReques
 				ReturnFieldsPlus(readableAttributesForNamedacl).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
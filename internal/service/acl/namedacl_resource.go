@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/infobloxopen/infoblox-nios-go-client/acl"
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type NamedaclResource struct {
 	client *niosclient.APIClient
 }
 
+// NamedaclResourceModel describes the resource data model, extending NamedaclModel with the operation timeouts.
+type NamedaclResourceModel struct {
+	NamedaclModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NamedaclResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "acl_namedacl"
 }
@@ -41,6 +49,7 @@ func (r *NamedaclResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage a Named Access Control List (ACL)",
 		Attributes:          NamedaclResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *NamedaclResource) Configure(ctx context.Context, req resource.Configure
 
 func (r *NamedaclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NamedaclModel
+	var data NamedaclResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *NamedaclResource) Create(ctx context.Context, req resource.CreateReques
 
 	var apiRes *acl.CreateNamedaclResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *NamedaclResource) Create(ctx context.Context, req resource.CreateReques
 
 func (r *NamedaclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NamedaclModel
+	var data NamedaclResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *NamedaclResource) Read(ctx context.Context, req resource.ReadRequest, r
 		apiRes  *acl.GetNamedaclResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.ACLAPI.
 			NamedaclAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNamedacl).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *NamedaclResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NamedaclResource) ReadByExtAttrs(ctx context.Context, data *NamedaclModel, resp *resource.ReadResponse) bool {
+func (r *NamedaclResource) ReadByExtAttrs(ctx context.Context, data *NamedaclResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *NamedaclResource) ReadByExtAttrs(ctx context.Context, data *NamedaclMod
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNamedacl).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Namedacl by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *NamedaclResource) ReadByExtAttrs(ctx context.Context, data *NamedaclMod
 
 func (r *NamedaclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NamedaclModel
+	var data NamedaclResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -326,7 +335,7 @@ func (r *NamedaclResource) Update(ctx context.Context, req resource.UpdateReques
 
 	var apiRes *acl.UpdateNamedaclResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *NamedaclResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *NamedaclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NamedaclModel
+	var data NamedaclResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *NamedaclResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.ACLAPI.
 			NamedaclAPI.
 			Delete(ctx, resourceRef).
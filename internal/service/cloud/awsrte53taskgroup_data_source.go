@@ -132,7 +132,7 @@ func (d *Awsrte53taskgroupDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForAwsrte53taskgroup).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type Awsrte53taskgroupResource struct {
 	client *niosclient.APIClient
 }
 
+// Awsrte53taskgroupResourceModel describes the resource data model, extending Awsrte53taskgroupModel with the operation timeouts.
+type Awsrte53taskgroupResourceModel struct {
+	Awsrte53taskgroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Awsrte53taskgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "cloud_aws_route53_task_group"
 }
@@ -42,6 +49,7 @@ func (r *Awsrte53taskgroupResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an AWS Route 53 Task Group.",
 		Attributes:          Awsrte53taskgroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +74,7 @@ func (r *Awsrte53taskgroupResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *Awsrte53taskgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data Awsrte53taskgroupModel
+	var data Awsrte53taskgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +97,7 @@ func (r *Awsrte53taskgroupResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *cloud.CreateAwsrte53taskgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -130,7 +138,7 @@ func (r *Awsrte53taskgroupResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *Awsrte53taskgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data Awsrte53taskgroupModel
+	var data Awsrte53taskgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -146,14 +154,14 @@ func (r *Awsrte53taskgroupResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *cloud.GetAwsrte53taskgroupResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.CloudAPI.
 			Awsrte53taskgroupAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForAwsrte53taskgroup).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -183,7 +191,7 @@ func (r *Awsrte53taskgroupResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *Awsrte53taskgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Awsrte53taskgroupModel
+	var data Awsrte53taskgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -214,7 +222,7 @@ func (r *Awsrte53taskgroupResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *cloud.UpdateAwsrte53taskgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -247,7 +255,7 @@ func (r *Awsrte53taskgroupResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *Awsrte53taskgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Awsrte53taskgroupModel
+	var data Awsrte53taskgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -258,7 +266,7 @@ func (r *Awsrte53taskgroupResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.CloudAPI.
 			Awsrte53taskgroupAPI.
 			Delete(ctx, resourceRef).
@@ -284,22 +292,20 @@ func (r *Awsrte53taskgroupResource) ImportState(ctx context.Context, req resourc
 }
 
 // function that will process your AWS account IDs file and return the token
-func (r *Awsrte53taskgroupResource) processAwsAccountIdsFile(ctx context.Context, data *Awsrte53taskgroupModel, diags *diag.Diagnostics) bool {
+func (r *Awsrte53taskgroupResource) processAwsAccountIdsFile(ctx context.Context, data *Awsrte53taskgroupResourceModel, diags *diag.Diagnostics) bool {
 	// Check if aws_account_ids_file_path is provided
 	if data.AwsAccountIdsFilePath.IsNull() || data.AwsAccountIdsFilePath.IsUnknown() {
 		return true // No file to process, continue
 	}
 
 	// Get connection details from client configuration
-	baseUrl := r.client.CloudAPI.Cfg.NIOSHostURL
-	username := r.client.CloudAPI.Cfg.NIOSUsername
-	password := r.client.CloudAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.CloudAPI.Cfg)
 
 	// Get the file path from the model
 	filePath := data.AwsAccountIdsFilePath.ValueString()
 
 	// Upload the AWS account IDs file and get the token
-	token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+	token, err := utils.UploadFileWithToken(ctx, r.client.CloudAPI.Cfg.HTTPClient, baseUrl, filePath)
 	if err != nil {
 		diags.AddError(
 			"Client Error",
@@ -314,7 +320,7 @@ func (r *Awsrte53taskgroupResource) processAwsAccountIdsFile(ctx context.Context
 }
 
 func (r *Awsrte53taskgroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data Awsrte53taskgroupModel
+	var data Awsrte53taskgroupResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -132,7 +132,7 @@ func (d *AwsuserDataSource) Read(ctx context.Context, req datasource.ReadRequest
 				ReturnFieldsPlus(readableAttributesForAwsuser).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
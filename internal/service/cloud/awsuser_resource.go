@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type AwsuserResource struct {
 	client *niosclient.APIClient
 }
 
+// AwsuserResourceModel describes the resource data model, extending AwsuserModel with the operation timeouts.
+type AwsuserResourceModel struct {
+	AwsuserModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *AwsuserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "cloud_aws_user"
 }
@@ -41,6 +49,7 @@ func (r *AwsuserResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an AWS User.",
 		Attributes:          AwsuserResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -65,7 +74,7 @@ func (r *AwsuserResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *AwsuserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data AwsuserModel
+	var data AwsuserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -81,7 +90,7 @@ func (r *AwsuserResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var apiRes *cloud.CreateAwsuserResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -122,7 +131,7 @@ func (r *AwsuserResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *AwsuserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data AwsuserModel
+	var data AwsuserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -138,14 +147,14 @@ func (r *AwsuserResource) Read(ctx context.Context, req resource.ReadRequest, re
 		apiRes  *cloud.GetAwsuserResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.CloudAPI.
 			AwsuserAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForAwsuser).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -175,7 +184,7 @@ func (r *AwsuserResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *AwsuserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data AwsuserModel
+	var data AwsuserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -199,7 +208,7 @@ func (r *AwsuserResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var apiRes *cloud.UpdateAwsuserResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -232,7 +241,7 @@ func (r *AwsuserResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *AwsuserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data AwsuserModel
+	var data AwsuserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -243,7 +252,7 @@ func (r *AwsuserResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.CloudAPI.
 			AwsuserAPI.
 			Delete(ctx, resourceRef).
@@ -0,0 +1,77 @@
+package cloud_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccAzurednstaskgroupDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_cloud_azure_dns_task_group.test"
+	resourceName := "nios_cloud_azure_dns_task_group.test"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAzurednstaskgroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzurednstaskgroupDataSourceConfigFilters(taskGroupName, gridMember),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					}, testAccCheckAzurednstaskgroupResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckAzurednstaskgroupResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "azure_subscription_ids_file_token", dataSourceName, "result.0.azure_subscription_ids_file_token"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "consolidate_zones", dataSourceName, "result.0.consolidate_zones"),
+		resource.TestCheckResourceAttrPair(resourceName, "consolidated_view", dataSourceName, "result.0.consolidated_view"),
+		resource.TestCheckResourceAttrPair(resourceName, "disabled", dataSourceName, "result.0.disabled"),
+		resource.TestCheckResourceAttrPair(resourceName, "grid_member", dataSourceName, "result.0.grid_member"),
+		resource.TestCheckResourceAttrPair(resourceName, "multiple_subscriptions_sync_policy", dataSourceName, "result.0.multiple_subscriptions_sync_policy"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "network_view", dataSourceName, "result.0.network_view"),
+		resource.TestCheckResourceAttrPair(resourceName, "network_view_mapping_policy", dataSourceName, "result.0.network_view_mapping_policy"),
+		resource.TestCheckResourceAttrPair(resourceName, "subscriptions_list", dataSourceName, "result.0.subscriptions_list"),
+		resource.TestCheckResourceAttrPair(resourceName, "sync_status", dataSourceName, "result.0.sync_status"),
+		resource.TestCheckResourceAttrPair(resourceName, "task_list", dataSourceName, "result.0.task_list"),
+		resource.TestCheckResourceAttrPair(resourceName, "tenant_id", dataSourceName, "result.0.tenant_id"),
+	}
+}
+
+func testAccAzurednstaskgroupDataSourceConfigFilters(taskGroupName, gridMember string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_dns_task_group" "test" {
+  name                        = %q
+  grid_member                 = %q
+  disabled                    = false
+  network_view_mapping_policy = "AUTO_CREATE"
+}
+
+data "nios_cloud_azure_dns_task_group" "test" {
+  filters = {
+    name        = nios_cloud_azure_dns_task_group.test.name
+    grid_member = nios_cloud_azure_dns_task_group.test.grid_member
+  }
+}
+`, taskGroupName, gridMember)
+}
@@ -0,0 +1,384 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAzurednstaskgroup = "comment,consolidate_zones,consolidated_view,disabled,grid_member,multiple_subscriptions_sync_policy,name,network_view,network_view_mapping_policy,subscriptions_list,sync_status,task_list,tenant_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AzurednstaskgroupResource{}
+var _ resource.ResourceWithImportState = &AzurednstaskgroupResource{}
+
+func NewAzurednstaskgroupResource() resource.Resource {
+	return &AzurednstaskgroupResource{}
+}
+
+// AzurednstaskgroupResource defines the resource implementation.
+type AzurednstaskgroupResource struct {
+	client *niosclient.APIClient
+}
+
+// AzurednstaskgroupResourceModel describes the resource data model, extending AzurednstaskgroupModel with the operation timeouts.
+type AzurednstaskgroupResourceModel struct {
+	AzurednstaskgroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *AzurednstaskgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "cloud_azure_dns_task_group"
+}
+
+func (r *AzurednstaskgroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Azure DNS Task Group.",
+		Attributes:          AzurednstaskgroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *AzurednstaskgroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AzurednstaskgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AzurednstaskgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Process Azure subscription IDs file if multiple_subscriptions_sync_policy is UPLOAD_CHILDREN
+	if !data.MultipleSubscriptionsSyncPolicy.IsNull() && data.MultipleSubscriptionsSyncPolicy.ValueString() == "UPLOAD_CHILDREN" {
+		if !r.processAzureSubscriptionIdsFile(ctx, &data, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics, true)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *cloud.CreateAzurednstaskgroupResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			AzurednstaskgroupAPI.
+			Create(ctx).
+			Azurednstaskgroup(*payload).
+			ReturnFieldsPlus(readableAttributesForAzurednstaskgroup).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if retry.IsAlreadyExistsErr(err) {
+			// Resource already exists, import required
+			resp.Diagnostics.AddError(
+				"Resource Already Exists",
+				fmt.Sprintf("Resource already exists, error: %s.\nPlease import the existing resource into terraform state.", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Azurednstaskgroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.CreateAzurednstaskgroupResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AzurednstaskgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AzurednstaskgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *cloud.GetAzurednstaskgroupResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			AzurednstaskgroupAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForAzurednstaskgroup).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Azurednstaskgroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetAzurednstaskgroupResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AzurednstaskgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data AzurednstaskgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Process Azure subscription IDs file if multiple_subscriptions_sync_policy is UPLOAD_CHILDREN
+	if !data.MultipleSubscriptionsSyncPolicy.IsNull() && data.MultipleSubscriptionsSyncPolicy.ValueString() == "UPLOAD_CHILDREN" {
+		if !r.processAzureSubscriptionIdsFile(ctx, &data, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	payload := data.Expand(ctx, &resp.Diagnostics, false)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *cloud.UpdateAzurednstaskgroupResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			AzurednstaskgroupAPI.
+			Update(ctx, resourceRef).
+			Azurednstaskgroup(*payload).
+			ReturnFieldsPlus(readableAttributesForAzurednstaskgroup).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Azurednstaskgroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateAzurednstaskgroupResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AzurednstaskgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AzurednstaskgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.CloudAPI.
+			AzurednstaskgroupAPI.
+			Delete(ctx, resourceRef).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Azurednstaskgroup, got error: %s", err))
+		return
+	}
+}
+
+func (r *AzurednstaskgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// function that will process your Azure subscription IDs file and return the token
+func (r *AzurednstaskgroupResource) processAzureSubscriptionIdsFile(ctx context.Context, data *AzurednstaskgroupResourceModel, diags *diag.Diagnostics) bool {
+	// Check if azure_subscription_ids_file_path is provided
+	if data.AzureSubscriptionIdsFilePath.IsNull() || data.AzureSubscriptionIdsFilePath.IsUnknown() {
+		return true // No file to process, continue
+	}
+
+	// Get connection details from client configuration
+	baseUrl := utils.WAPIBaseURL(r.client.CloudAPI.Cfg)
+
+	// Get the file path from the model
+	filePath := data.AzureSubscriptionIdsFilePath.ValueString()
+
+	// Upload the Azure subscription IDs file and get the token
+	token, err := utils.UploadFileWithToken(ctx, r.client.CloudAPI.Cfg.HTTPClient, baseUrl, filePath)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to process Azure subscription IDs file %s, got error: %s", filePath, err),
+		)
+		return false
+	}
+
+	// Store the token in the azure_subscription_ids_file_token field
+	data.AzureSubscriptionIdsFileToken = types.StringValue(token)
+	return true
+}
+
+func (r *AzurednstaskgroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AzurednstaskgroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For Configuration object, any attributes not defined by the user appear as null, unless derived from another instance.
+	// We perform IsUnknown() check to handle variables from .tfvars that are resolved
+	// during the plan phase rather than validation phase, preventing false validation errors.
+
+	// Validation 1: Handle filter validation
+	if !data.TaskList.IsNull() && !data.TaskList.IsUnknown() {
+		var taskList []AzurednstaskgroupTaskListModel
+		diags := data.TaskList.ElementsAs(ctx, &taskList, false)
+		if !diags.HasError() {
+			for i, task := range taskList {
+				// Check if filter is known and not null before checking if it's empty
+				if !task.Filter.IsUnknown() && !task.Filter.IsNull() && task.Filter.ValueString() == "" {
+					resp.Diagnostics.AddError(
+						"Invalid Filter Configuration",
+						fmt.Sprintf("task_list[%d].filter cannot be empty string. Use '*' for wildcard or omit the filter attribute.", i),
+					)
+				}
+			}
+		}
+	}
+
+	// Validation 2: azure_subscription_ids_file_path can only be used with UPLOAD_CHILDREN policy
+	if !data.AzureSubscriptionIdsFilePath.IsUnknown() && !data.MultipleSubscriptionsSyncPolicy.IsUnknown() {
+		if !data.AzureSubscriptionIdsFilePath.IsNull() && data.AzureSubscriptionIdsFilePath.ValueString() != "" {
+			policyValue := "NONE"
+			if !data.MultipleSubscriptionsSyncPolicy.IsNull() {
+				policyValue = data.MultipleSubscriptionsSyncPolicy.ValueString()
+			}
+			if policyValue != "UPLOAD_CHILDREN" {
+				resp.Diagnostics.AddError(
+					"Invalid Configuration",
+					"'azure_subscription_ids_file_path' can only be used when 'multiple_subscriptions_sync_policy' is set to 'UPLOAD_CHILDREN'. "+
+						"Current policy is '"+policyValue+"'. "+
+						"Either remove 'azure_subscription_ids_file_path' or set 'multiple_subscriptions_sync_policy' to 'UPLOAD_CHILDREN'.",
+				)
+			}
+		}
+	}
+
+	// Validation 3: UPLOAD_CHILDREN policy requires azure_subscription_ids_file_path
+	if !data.MultipleSubscriptionsSyncPolicy.IsUnknown() {
+		if !data.MultipleSubscriptionsSyncPolicy.IsNull() && data.MultipleSubscriptionsSyncPolicy.ValueString() == "UPLOAD_CHILDREN" {
+			if !data.AzureSubscriptionIdsFilePath.IsUnknown() {
+				if data.AzureSubscriptionIdsFilePath.IsNull() || data.AzureSubscriptionIdsFilePath.ValueString() == "" {
+					resp.Diagnostics.AddError(
+						"Invalid Configuration",
+						"When 'multiple_subscriptions_sync_policy' is 'UPLOAD_CHILDREN', 'azure_subscription_ids_file_path' must be provided. "+
+							"Please specify the path to a file containing Azure subscription IDs.",
+					)
+				}
+			}
+		}
+	}
+}
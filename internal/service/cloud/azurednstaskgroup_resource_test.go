@@ -0,0 +1,386 @@
+package cloud_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAzurednstaskgroup = "comment,consolidate_zones,consolidated_view,disabled,grid_member,multiple_subscriptions_sync_policy,name,network_view,network_view_mapping_policy,subscriptions_list,sync_status,task_list,tenant_id"
+
+// OBJECTS TO BE PRESENT IN GRID FOR TESTS :
+// A Grid Master and a Member are required for testing
+
+func TestAccAzurednstaskgroupResource_basic(t *testing.T) {
+	var resourceName = "nios_cloud_azure_dns_task_group.test"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzurednstaskgroupBasicConfig(taskGroupName, gridMember),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", taskGroupName),
+					resource.TestCheckResourceAttr(resourceName, "grid_member", gridMember),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					// Test fields with default value,
+					resource.TestCheckResourceAttr(resourceName, "consolidate_zones", "false"),
+					resource.TestCheckResourceAttr(resourceName, "network_view_mapping_policy", "AUTO_CREATE"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzurednstaskgroupResource_disappears(t *testing.T) {
+	resourceName := "nios_cloud_azure_dns_task_group.test"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAzurednstaskgroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzurednstaskgroupBasicConfig(taskGroupName, gridMember),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					testAccCheckAzurednstaskgroupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAzurednstaskgroupResource_Comment(t *testing.T) {
+	var resourceName = "nios_cloud_azure_dns_task_group.test_comment"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzurednstaskgroupComment(taskGroupName, gridMember, "COMMENT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "COMMENT"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAzurednstaskgroupComment(taskGroupName, gridMember, "COMMENT_UPDATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "COMMENT_UPDATE"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzurednstaskgroupResource_Disabled(t *testing.T) {
+	var resourceName = "nios_cloud_azure_dns_task_group.test_disabled"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzurednstaskgroupDisabled(taskGroupName, gridMember, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAzurednstaskgroupDisabled(taskGroupName, gridMember, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzurednstaskgroupResource_Name(t *testing.T) {
+	var resourceName = "nios_cloud_azure_dns_task_group.test_name"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+	updatedTaskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzurednstaskgroupName(taskGroupName, gridMember),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", taskGroupName),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAzurednstaskgroupName(updatedTaskGroupName, gridMember),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedTaskGroupName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzurednstaskgroupResource_NetworkViewMappingPolicy(t *testing.T) {
+	var resourceName = "nios_cloud_azure_dns_task_group.test_network_view_mapping_policy"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzurednstaskgroupNetworkViewMappingPolicy(taskGroupName, gridMember, "DIRECT", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "network_view_mapping_policy", "DIRECT"),
+					resource.TestCheckResourceAttr(resourceName, "network_view", "default"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzurednstaskgroupResource_TaskList(t *testing.T) {
+	var resourceName = "nios_cloud_azure_dns_task_group.test_task_list"
+	var v cloud.Azurednstaskgroup
+	taskGroupName := acctest.RandomNameWithPrefix("test-taskgroup")
+	gridMember := utils.GetNIOSGridMasterHostName()
+	userName := acctest.RandomNameWithPrefix("azure-user")
+	taskList := []map[string]any{
+		{
+			"azure_user":         "${nios_cloud_azure_user.test.ref}",
+			"credentials_type":   "DIRECT",
+			"disabled":           false,
+			"filter":             "*",
+			"name":               "test1",
+			"schedule_interval":  5,
+			"schedule_units":     "DAYS",
+			"sync_private_zones": true,
+			"sync_public_zones":  true,
+		},
+	}
+	taskListUpdate := []map[string]any{
+		{
+			"azure_user":         "${nios_cloud_azure_user.test.ref}",
+			"credentials_type":   "DIRECT",
+			"disabled":           false,
+			"filter":             "*",
+			"name":               "test1",
+			"schedule_interval":  1,
+			"schedule_units":     "HOURS",
+			"sync_private_zones": true,
+			"sync_public_zones":  false,
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzurednstaskgroupTaskList(userName, taskGroupName, gridMember, taskList),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "task_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_list.0.azure_user", "nios_cloud_azure_user.test", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "task_list.0.name", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "task_list.0.schedule_interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "task_list.0.schedule_units", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "task_list.0.sync_public_zones", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAzurednstaskgroupTaskList(userName, taskGroupName, gridMember, taskListUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzurednstaskgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "task_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_list.0.schedule_interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_list.0.schedule_units", "HOURS"),
+					resource.TestCheckResourceAttr(resourceName, "task_list.0.sync_public_zones", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckAzurednstaskgroupExists(ctx context.Context, resourceName string, v *cloud.Azurednstaskgroup) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.CloudAPI.
+			AzurednstaskgroupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForAzurednstaskgroup).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetAzurednstaskgroupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetAzurednstaskgroupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckAzurednstaskgroupDestroy(ctx context.Context, v *cloud.Azurednstaskgroup) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.CloudAPI.
+			AzurednstaskgroupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForAzurednstaskgroup).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckAzurednstaskgroupDisappears(ctx context.Context, v *cloud.Azurednstaskgroup) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.CloudAPI.
+			AzurednstaskgroupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccAzurednstaskgroupBasicConfig(taskGroupName, gridMember string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_dns_task_group" "test" {
+  name        = %q
+  grid_member = %q
+  disabled    = false
+  network_view_mapping_policy = "AUTO_CREATE"
+}
+`, taskGroupName, gridMember)
+}
+
+func testAccAzurednstaskgroupComment(taskGroupName, gridMember, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_dns_task_group" "test_comment" {
+  name        = %q
+  grid_member = %q
+  comment     = %q
+}
+`, taskGroupName, gridMember, comment)
+}
+
+func testAccAzurednstaskgroupDisabled(taskGroupName, gridMember, disabled string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_dns_task_group" "test_disabled" {
+  name        = %q
+  grid_member = %q
+  disabled    = %s
+}
+`, taskGroupName, gridMember, disabled)
+}
+
+func testAccAzurednstaskgroupName(taskGroupName, gridMember string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_dns_task_group" "test_name" {
+  name        = %q
+  grid_member = %q
+}
+`, taskGroupName, gridMember)
+}
+
+func testAccAzurednstaskgroupNetworkViewMappingPolicy(taskGroupName, gridMember, networkViewMappingPolicy, networkView string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_dns_task_group" "test_network_view_mapping_policy" {
+  name        = %q
+  grid_member = %q
+  network_view_mapping_policy = %q
+  network_view                = %q
+}
+`, taskGroupName, gridMember, networkViewMappingPolicy, networkView)
+}
+
+func testAccAzurednstaskgroupTaskList(userName, taskGroupName, gridMember string, taskList []map[string]any) string {
+	taskListHCL := utils.ConvertSliceOfMapsToHCL(taskList)
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_user" "test" {
+  client_id         = "9e5c4b6a-0f0a-4c3b-8f1f-6d2e4a7b9c10"
+  client_secret_key = "Xk8Q~exampleSecretValue0123456789abcdef"
+  name              = %q
+  tenant_id         = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+}
+
+resource "nios_cloud_azure_dns_task_group" "test_task_list" {
+  name                        = %q
+  grid_member                 = %q
+  task_list                   = %s
+  network_view_mapping_policy = "DIRECT"
+  network_view                = "default"
+  depends_on                  = [nios_cloud_azure_user.test]
+}
+`, userName, taskGroupName, gridMember, taskListHCL)
+}
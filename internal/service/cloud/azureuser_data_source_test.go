@@ -0,0 +1,68 @@
+package cloud_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccAzureuserDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_cloud_azure_user.test"
+	resourceName := "nios_cloud_azure_user.test"
+	var v cloud.Azureuser
+	clientId := acctest.RandomAlphaNumeric(32)
+	clientSecretKey := "Xk8Q~" + acctest.RandomAlphaNumeric(34)
+	name := acctest.RandomNameWithPrefix("azure-user")
+	tenantId := "72f988bf-86f1-41af-91ab-2d7cd011db47"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAzureuserDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureuserDataSourceConfigFilters(clientId, clientSecretKey, name, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					}, testAccCheckAzureuserResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckAzureuserResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "client_id", dataSourceName, "result.0.client_id"),
+		resource.TestCheckResourceAttrPair(resourceName, "last_used", dataSourceName, "result.0.last_used"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "status", dataSourceName, "result.0.status"),
+		resource.TestCheckResourceAttrPair(resourceName, "tenant_id", dataSourceName, "result.0.tenant_id"),
+	}
+}
+
+func testAccAzureuserDataSourceConfigFilters(clientId, clientSecretKey, name, tenantId string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_user" "test" {
+  client_id         = %q
+  client_secret_key = %q
+  name              = %q
+  tenant_id         = %q
+}
+
+data "nios_cloud_azure_user" "test" {
+  filters = {
+    name = nios_cloud_azure_user.test.name
+  }
+}
+`, clientId, clientSecretKey, name, tenantId)
+}
@@ -0,0 +1,278 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAzureuser = "client_id,last_used,name,status,tenant_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AzureuserResource{}
+var _ resource.ResourceWithImportState = &AzureuserResource{}
+
+func NewAzureuserResource() resource.Resource {
+	return &AzureuserResource{}
+}
+
+// AzureuserResource defines the resource implementation.
+type AzureuserResource struct {
+	client *niosclient.APIClient
+}
+
+// AzureuserResourceModel describes the resource data model, extending AzureuserModel with the operation timeouts.
+type AzureuserResourceModel struct {
+	AzureuserModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *AzureuserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "cloud_azure_user"
+}
+
+func (r *AzureuserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an Azure User.",
+		Attributes:          AzureuserResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *AzureuserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AzureuserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AzureuserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *cloud.CreateAzureuserResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			AzureuserAPI.
+			Create(ctx).
+			Azureuser(*payload).
+			ReturnFieldsPlus(readableAttributesForAzureuser).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if retry.IsAlreadyExistsErr(err) {
+			// Resource already exists, import required
+			resp.Diagnostics.AddError(
+				"Resource Already Exists",
+				fmt.Sprintf("Resource already exists, error: %s.\nPlease import the existing resource into terraform state.", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Azureuser, got error: %s", err))
+		return
+	}
+
+	res := apiRes.CreateAzureuserResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AzureuserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AzureuserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *cloud.GetAzureuserResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			AzureuserAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForAzureuser).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Azureuser, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetAzureuserResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AzureuserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data AzureuserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var apiRes *cloud.UpdateAzureuserResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			AzureuserAPI.
+			Update(ctx, resourceRef).
+			Azureuser(*payload).
+			ReturnFieldsPlus(readableAttributesForAzureuser).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Azureuser, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateAzureuserResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AzureuserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AzureuserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.CloudAPI.
+			AzureuserAPI.
+			Delete(ctx, resourceRef).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Azureuser, got error: %s", err))
+		return
+	}
+}
+
+func (r *AzureuserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
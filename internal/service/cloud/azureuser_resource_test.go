@@ -0,0 +1,275 @@
+package cloud_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAzureuser = "client_id,last_used,name,status,tenant_id"
+
+func TestAccAzureuserResource_basic(t *testing.T) {
+	var resourceName = "nios_cloud_azure_user.test"
+	var v cloud.Azureuser
+	clientId := acctest.RandomAlphaNumeric(32)
+	name := acctest.RandomNameWithPrefix("azure-user")
+	tenantId := "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	clientSecretKey := "Xk8Q~" + acctest.RandomAlphaNumeric(34)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzureuserBasicConfig(clientId, clientSecretKey, name, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "client_id", clientId),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", tenantId),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzureuserResource_disappears(t *testing.T) {
+	resourceName := "nios_cloud_azure_user.test"
+	var v cloud.Azureuser
+	clientId := acctest.RandomAlphaNumeric(32)
+	name := acctest.RandomNameWithPrefix("azure-user")
+	tenantId := "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	clientSecretKey := "Xk8Q~" + acctest.RandomAlphaNumeric(34)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAzureuserDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureuserBasicConfig(clientId, clientSecretKey, name, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					testAccCheckAzureuserDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureuserResource_ClientId(t *testing.T) {
+	var resourceName = "nios_cloud_azure_user.test_client_id"
+	var v cloud.Azureuser
+	clientId := acctest.RandomAlphaNumeric(32)
+	updatedClientId := acctest.RandomAlphaNumeric(32)
+	name := acctest.RandomNameWithPrefix("azure-user")
+	tenantId := "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	clientSecretKey := "Xk8Q~" + acctest.RandomAlphaNumeric(34)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzureuserClientId(clientId, clientSecretKey, name, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "client_id", clientId),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAzureuserClientId(updatedClientId, clientSecretKey, name, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "client_id", updatedClientId),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzureuserResource_Name(t *testing.T) {
+	var resourceName = "nios_cloud_azure_user.test_name"
+	var v cloud.Azureuser
+	clientId := acctest.RandomAlphaNumeric(32)
+	name := acctest.RandomNameWithPrefix("azure-user")
+	updatedName := acctest.RandomNameWithPrefix("azure-user")
+	tenantId := "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	clientSecretKey := "Xk8Q~" + acctest.RandomAlphaNumeric(34)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzureuserName(clientId, clientSecretKey, name, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAzureuserName(clientId, clientSecretKey, updatedName, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAzureuserResource_TenantId(t *testing.T) {
+	var resourceName = "nios_cloud_azure_user.test_tenant_id"
+	var v cloud.Azureuser
+	clientId := acctest.RandomAlphaNumeric(32)
+	name := acctest.RandomNameWithPrefix("azure-user")
+	tenantId := "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	updatedTenantId := "0b1e6b4c-5d3a-4f0e-9c43-2f6f3a8d7e21"
+	clientSecretKey := "Xk8Q~" + acctest.RandomAlphaNumeric(34)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAzureuserTenantId(clientId, clientSecretKey, name, tenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", tenantId),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAzureuserTenantId(clientId, clientSecretKey, name, updatedTenantId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", updatedTenantId),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckAzureuserExists(ctx context.Context, resourceName string, v *cloud.Azureuser) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.CloudAPI.
+			AzureuserAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForAzureuser).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetAzureuserResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetAzureuserResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckAzureuserDestroy(ctx context.Context, v *cloud.Azureuser) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.CloudAPI.
+			AzureuserAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForAzureuser).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckAzureuserDisappears(ctx context.Context, v *cloud.Azureuser) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.CloudAPI.
+			AzureuserAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccAzureuserBasicConfig(clientId, clientSecretKey, name, tenantId string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_user" "test" {
+  client_id         = %q
+  client_secret_key = %q
+  name              = %q
+  tenant_id         = %q
+}
+`, clientId, clientSecretKey, name, tenantId)
+}
+
+func testAccAzureuserClientId(clientId, clientSecretKey, name, tenantId string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_user" "test_client_id" {
+  client_id         = %q
+  client_secret_key = %q
+  name              = %q
+  tenant_id         = %q
+}
+`, clientId, clientSecretKey, name, tenantId)
+}
+
+func testAccAzureuserName(clientId, clientSecretKey, name, tenantId string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_user" "test_name" {
+  client_id         = %q
+  client_secret_key = %q
+  name              = %q
+  tenant_id         = %q
+}
+`, clientId, clientSecretKey, name, tenantId)
+}
+
+func testAccAzureuserTenantId(clientId, clientSecretKey, name, tenantId string) string {
+	return fmt.Sprintf(`
+resource "nios_cloud_azure_user" "test_tenant_id" {
+  client_id         = %q
+  client_secret_key = %q
+  name              = %q
+  tenant_id         = %q
+}
+`, clientId, clientSecretKey, name, tenantId)
+}
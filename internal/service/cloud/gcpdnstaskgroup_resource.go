@@ -0,0 +1,384 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForGcpdnstaskgroup = "comment,consolidate_zones,consolidated_view,disabled,grid_member,multiple_projects_sync_policy,name,network_view,network_view_mapping_policy,project_id,projects_list,sync_child_projects,sync_status,task_list"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GcpdnstaskgroupResource{}
+var _ resource.ResourceWithImportState = &GcpdnstaskgroupResource{}
+
+func NewGcpdnstaskgroupResource() resource.Resource {
+	return &GcpdnstaskgroupResource{}
+}
+
+// GcpdnstaskgroupResource defines the resource implementation.
+type GcpdnstaskgroupResource struct {
+	client *niosclient.APIClient
+}
+
+// GcpdnstaskgroupResourceModel describes the resource data model, extending GcpdnstaskgroupModel with the operation timeouts.
+type GcpdnstaskgroupResourceModel struct {
+	GcpdnstaskgroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *GcpdnstaskgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "cloud_gcp_dns_task_group"
+}
+
+func (r *GcpdnstaskgroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a GCP DNS Task Group.",
+		Attributes:          GcpdnstaskgroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *GcpdnstaskgroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GcpdnstaskgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GcpdnstaskgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Process GCP project IDs file if multiple_projects_sync_policy is UPLOAD_CHILDREN
+	if !data.MultipleProjectsSyncPolicy.IsNull() && data.MultipleProjectsSyncPolicy.ValueString() == "UPLOAD_CHILDREN" {
+		if !r.processGcpProjectIdsFile(ctx, &data, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics, true)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *cloud.CreateGcpdnstaskgroupResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			GcpdnstaskgroupAPI.
+			Create(ctx).
+			Gcpdnstaskgroup(*payload).
+			ReturnFieldsPlus(readableAttributesForGcpdnstaskgroup).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if retry.IsAlreadyExistsErr(err) {
+			// Resource already exists, import required
+			resp.Diagnostics.AddError(
+				"Resource Already Exists",
+				fmt.Sprintf("Resource already exists, error: %s.\nPlease import the existing resource into terraform state.", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Gcpdnstaskgroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.CreateGcpdnstaskgroupResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GcpdnstaskgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GcpdnstaskgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *cloud.GetGcpdnstaskgroupResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			GcpdnstaskgroupAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForGcpdnstaskgroup).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Gcpdnstaskgroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetGcpdnstaskgroupResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GcpdnstaskgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data GcpdnstaskgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Process GCP project IDs file if multiple_projects_sync_policy is UPLOAD_CHILDREN
+	if !data.MultipleProjectsSyncPolicy.IsNull() && data.MultipleProjectsSyncPolicy.ValueString() == "UPLOAD_CHILDREN" {
+		if !r.processGcpProjectIdsFile(ctx, &data, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	payload := data.Expand(ctx, &resp.Diagnostics, false)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *cloud.UpdateGcpdnstaskgroupResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.CloudAPI.
+			GcpdnstaskgroupAPI.
+			Update(ctx, resourceRef).
+			Gcpdnstaskgroup(*payload).
+			ReturnFieldsPlus(readableAttributesForGcpdnstaskgroup).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Gcpdnstaskgroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateGcpdnstaskgroupResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GcpdnstaskgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GcpdnstaskgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.CloudAPI.
+			GcpdnstaskgroupAPI.
+			Delete(ctx, resourceRef).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Gcpdnstaskgroup, got error: %s", err))
+		return
+	}
+}
+
+func (r *GcpdnstaskgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// function that will process your GCP project IDs file and return the token
+func (r *GcpdnstaskgroupResource) processGcpProjectIdsFile(ctx context.Context, data *GcpdnstaskgroupResourceModel, diags *diag.Diagnostics) bool {
+	// Check if gcp_project_ids_file_path is provided
+	if data.GcpProjectIdsFilePath.IsNull() || data.GcpProjectIdsFilePath.IsUnknown() {
+		return true // No file to process, continue
+	}
+
+	// Get connection details from client configuration
+	baseUrl := utils.WAPIBaseURL(r.client.CloudAPI.Cfg)
+
+	// Get the file path from the model
+	filePath := data.GcpProjectIdsFilePath.ValueString()
+
+	// Upload the GCP project IDs file and get the token
+	token, err := utils.UploadFileWithToken(ctx, r.client.CloudAPI.Cfg.HTTPClient, baseUrl, filePath)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to process GCP project IDs file %s, got error: %s", filePath, err),
+		)
+		return false
+	}
+
+	// Store the token in the gcp_project_ids_file_token field
+	data.GcpProjectIdsFileToken = types.StringValue(token)
+	return true
+}
+
+func (r *GcpdnstaskgroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GcpdnstaskgroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For Configuration object, any attributes not defined by the user appear as null, unless derived from another instance.
+	// We perform IsUnknown() check to handle variables from .tfvars that are resolved
+	// during the plan phase rather than validation phase, preventing false validation errors.
+
+	// Validation 1: Handle filter validation
+	if !data.TaskList.IsNull() && !data.TaskList.IsUnknown() {
+		var taskList []GcpdnstaskgroupTaskListModel
+		diags := data.TaskList.ElementsAs(ctx, &taskList, false)
+		if !diags.HasError() {
+			for i, task := range taskList {
+				// Check if filter is known and not null before checking if it's empty
+				if !task.Filter.IsUnknown() && !task.Filter.IsNull() && task.Filter.ValueString() == "" {
+					resp.Diagnostics.AddError(
+						"Invalid Filter Configuration",
+						fmt.Sprintf("task_list[%d].filter cannot be empty string. Use '*' for wildcard or omit the filter attribute.", i),
+					)
+				}
+			}
+		}
+	}
+
+	// Validation 2: gcp_project_ids_file_path can only be used with UPLOAD_CHILDREN policy
+	if !data.GcpProjectIdsFilePath.IsUnknown() && !data.MultipleProjectsSyncPolicy.IsUnknown() {
+		if !data.GcpProjectIdsFilePath.IsNull() && data.GcpProjectIdsFilePath.ValueString() != "" {
+			policyValue := "NONE"
+			if !data.MultipleProjectsSyncPolicy.IsNull() {
+				policyValue = data.MultipleProjectsSyncPolicy.ValueString()
+			}
+			if policyValue != "UPLOAD_CHILDREN" {
+				resp.Diagnostics.AddError(
+					"Invalid Configuration",
+					"'gcp_project_ids_file_path' can only be used when 'multiple_projects_sync_policy' is set to 'UPLOAD_CHILDREN'. "+
+						"Current policy is '"+policyValue+"'. "+
+						"Either remove 'gcp_project_ids_file_path' or set 'multiple_projects_sync_policy' to 'UPLOAD_CHILDREN'.",
+				)
+			}
+		}
+	}
+
+	// Validation 3: UPLOAD_CHILDREN policy requires gcp_project_ids_file_path
+	if !data.MultipleProjectsSyncPolicy.IsUnknown() {
+		if !data.MultipleProjectsSyncPolicy.IsNull() && data.MultipleProjectsSyncPolicy.ValueString() == "UPLOAD_CHILDREN" {
+			if !data.GcpProjectIdsFilePath.IsUnknown() {
+				if data.GcpProjectIdsFilePath.IsNull() || data.GcpProjectIdsFilePath.ValueString() == "" {
+					resp.Diagnostics.AddError(
+						"Invalid Configuration",
+						"When 'multiple_projects_sync_policy' is 'UPLOAD_CHILDREN', 'gcp_project_ids_file_path' must be provided. "+
+							"Please specify the path to a file containing GCP project IDs.",
+					)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package cloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type AzureuserModel struct {
+	Ref             types.String `tfsdk:"ref"`
+	ClientId        types.String `tfsdk:"client_id"`
+	ClientSecretKey types.String `tfsdk:"client_secret_key"`
+	LastUsed        types.Int64  `tfsdk:"last_used"`
+	Name            types.String `tfsdk:"name"`
+	Status          types.String `tfsdk:"status"`
+	TenantId        types.String `tfsdk:"tenant_id"`
+}
+
+var AzureuserAttrTypes = map[string]attr.Type{
+	"ref":               types.StringType,
+	"client_id":         types.StringType,
+	"client_secret_key": types.StringType,
+	"last_used":         types.Int64Type,
+	"name":              types.StringType,
+	"status":            types.StringType,
+	"tenant_id":         types.StringType,
+}
+
+var AzureuserResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"client_id": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+			stringvalidator.LengthAtMost(255),
+		},
+		MarkdownDescription: "The Client ID of the Azure application registered for this user. Maximum 255 characters.",
+	},
+	"client_secret_key": schema.StringAttribute{
+		Required:  true,
+		Sensitive: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The Client Secret Key of the Azure application registered for this user.",
+	},
+	"last_used": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when this Azure user credentials was last used.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtMost(64),
+		},
+		MarkdownDescription: "The Azure user name. Maximum 64 characters.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Indicate the validity status of this Azure user.",
+	},
+	"tenant_id": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+			stringvalidator.LengthAtMost(64),
+		},
+		MarkdownDescription: "The Azure Tenant ID of this Azure user. Maximum 64 characters.",
+	},
+}
+
+func (m *AzureuserModel) Expand(ctx context.Context, diags *diag.Diagnostics) *cloud.Azureuser {
+	if m == nil {
+		return nil
+	}
+	to := &cloud.Azureuser{
+		ClientId:        flex.ExpandStringPointer(m.ClientId),
+		ClientSecretKey: flex.ExpandStringPointer(m.ClientSecretKey),
+		Name:            flex.ExpandStringPointer(m.Name),
+		TenantId:        flex.ExpandStringPointer(m.TenantId),
+	}
+	return to
+}
+
+func FlattenAzureuser(ctx context.Context, from *cloud.Azureuser, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(AzureuserAttrTypes)
+	}
+	m := AzureuserModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, AzureuserAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *AzureuserModel) Flatten(ctx context.Context, from *cloud.Azureuser, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = AzureuserModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.ClientId = flex.FlattenStringPointer(from.ClientId)
+	m.LastUsed = flex.FlattenInt64Pointer(from.LastUsed)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Status = flex.FlattenStringPointer(from.Status)
+	m.TenantId = flex.FlattenStringPointer(from.TenantId)
+}
@@ -0,0 +1,251 @@
+package cloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	planmodifiers "github.com/infobloxopen/terraform-provider-nios/internal/planmodifiers/immutable"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type GcpdnstaskgroupModel struct {
+	Ref                        types.String `tfsdk:"ref"`
+	ProjectId                  types.String `tfsdk:"project_id"`
+	ProjectsList               types.String `tfsdk:"projects_list"`
+	GcpProjectIdsFileToken     types.String `tfsdk:"gcp_project_ids_file_token"`
+	GcpProjectIdsFilePath      types.String `tfsdk:"gcp_project_ids_file_path"`
+	Comment                    types.String `tfsdk:"comment"`
+	ConsolidateZones           types.Bool   `tfsdk:"consolidate_zones"`
+	ConsolidatedView           types.String `tfsdk:"consolidated_view"`
+	Disabled                   types.Bool   `tfsdk:"disabled"`
+	GridMember                 types.String `tfsdk:"grid_member"`
+	MultipleProjectsSyncPolicy types.String `tfsdk:"multiple_projects_sync_policy"`
+	Name                       types.String `tfsdk:"name"`
+	NetworkView                types.String `tfsdk:"network_view"`
+	NetworkViewMappingPolicy   types.String `tfsdk:"network_view_mapping_policy"`
+	SyncChildProjects          types.Bool   `tfsdk:"sync_child_projects"`
+	SyncStatus                 types.String `tfsdk:"sync_status"`
+	TaskList                   types.List   `tfsdk:"task_list"`
+}
+
+var GcpdnstaskgroupAttrTypes = map[string]attr.Type{
+	"ref":                           types.StringType,
+	"project_id":                    types.StringType,
+	"projects_list":                 types.StringType,
+	"gcp_project_ids_file_token":    types.StringType,
+	"gcp_project_ids_file_path":     types.StringType,
+	"comment":                       types.StringType,
+	"consolidate_zones":             types.BoolType,
+	"consolidated_view":             types.StringType,
+	"disabled":                      types.BoolType,
+	"grid_member":                   types.StringType,
+	"multiple_projects_sync_policy": types.StringType,
+	"name":                          types.StringType,
+	"network_view":                  types.StringType,
+	"network_view_mapping_policy":   types.StringType,
+	"sync_child_projects":           types.BoolType,
+	"sync_status":                   types.StringType,
+	"task_list":                     types.ListType{ElemType: types.ObjectType{AttrTypes: GcpdnstaskgroupTaskListAttrTypes}},
+}
+
+var GcpdnstaskgroupResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"project_id": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The GCP Project ID associated with this task group.",
+	},
+	"projects_list": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The GCP Project IDs list associated with this task group.",
+	},
+	"gcp_project_ids_file_token": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The GCP project IDs file's token.",
+	},
+	"gcp_project_ids_file_path": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The GCP project IDs file's path.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(0, 256),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "Comment for the task group; maximum 256 characters.",
+	},
+	"consolidate_zones": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Indicates if all zones need to be saved into a single view.",
+		PlanModifiers: []planmodifier.Bool{
+			planmodifiers.ImmutableBool(),
+		},
+	},
+	"consolidated_view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("consolidate_zones")),
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the DNS view for consolidating zones.",
+		PlanModifiers: []planmodifier.String{
+			planmodifiers.ImmutableString(),
+		},
+	},
+	"disabled": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Indicates if the task group is enabled or disabled.",
+	},
+	"grid_member": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Member on which the tasks in this task group will be run.",
+	},
+	"multiple_projects_sync_policy": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("NONE"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("DISCOVER_CHILDREN", "NONE", "UPLOAD_CHILDREN"),
+		},
+		MarkdownDescription: "Discover all child projects or upload the child project IDs to discover.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of this GCP DNS sync task group.",
+	},
+	"network_view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the tenant's network view.",
+		PlanModifiers: []planmodifier.String{
+			planmodifiers.ImmutableString(),
+		},
+	},
+	"network_view_mapping_policy": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("AUTO_CREATE"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("AUTO_CREATE", "DIRECT"),
+		},
+		MarkdownDescription: "The network view mapping policy.",
+		PlanModifiers: []planmodifier.String{
+			planmodifiers.ImmutableString(),
+		},
+	},
+	"sync_child_projects": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Synchronizing child projects is enabled or disabled.",
+	},
+	"sync_status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Indicate the overall sync status of this task group.",
+	},
+	"task_list": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: GcpdnstaskgroupTaskListResourceSchemaAttributes,
+		},
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "List of GCP DNS tasks in this group.",
+	},
+}
+
+func (m *GcpdnstaskgroupModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *cloud.Gcpdnstaskgroup {
+	if m == nil {
+		return nil
+	}
+	to := &cloud.Gcpdnstaskgroup{
+		GcpProjectIdsFileToken:     flex.ExpandStringPointer(m.GcpProjectIdsFileToken),
+		Comment:                    flex.ExpandStringPointer(m.Comment),
+		Disabled:                   flex.ExpandBoolPointer(m.Disabled),
+		GridMember:                 flex.ExpandStringPointer(m.GridMember),
+		MultipleProjectsSyncPolicy: flex.ExpandStringPointer(m.MultipleProjectsSyncPolicy),
+		Name:                       flex.ExpandStringPointer(m.Name),
+		SyncChildProjects:          flex.ExpandBoolPointer(m.SyncChildProjects),
+		TaskList:                   flex.ExpandFrameworkListNestedBlock(ctx, m.TaskList, diags, ExpandGcpdnstaskgroupTaskList),
+	}
+	if isCreate {
+		to.ConsolidateZones = flex.ExpandBoolPointer(m.ConsolidateZones)
+		to.NetworkView = flex.ExpandStringPointer(m.NetworkView)
+		to.ConsolidatedView = flex.ExpandStringPointer(m.ConsolidatedView)
+		to.NetworkViewMappingPolicy = flex.ExpandStringPointer(m.NetworkViewMappingPolicy)
+	}
+	return to
+}
+
+func FlattenGcpdnstaskgroup(ctx context.Context, from *cloud.Gcpdnstaskgroup, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(GcpdnstaskgroupAttrTypes)
+	}
+	m := GcpdnstaskgroupModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, GcpdnstaskgroupAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *GcpdnstaskgroupModel) Flatten(ctx context.Context, from *cloud.Gcpdnstaskgroup, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = GcpdnstaskgroupModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.ProjectId = flex.FlattenStringPointer(from.ProjectId)
+	m.ProjectsList = flex.FlattenStringPointer(from.ProjectsList)
+	m.GcpProjectIdsFileToken = flex.FlattenStringPointer(from.GcpProjectIdsFileToken)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.ConsolidateZones = types.BoolPointerValue(from.ConsolidateZones)
+	m.ConsolidatedView = flex.FlattenStringPointer(from.ConsolidatedView)
+	m.Disabled = types.BoolPointerValue(from.Disabled)
+	m.GridMember = flex.FlattenStringPointer(from.GridMember)
+	m.MultipleProjectsSyncPolicy = flex.FlattenStringPointer(from.MultipleProjectsSyncPolicy)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.NetworkView = flex.FlattenStringPointer(from.NetworkView)
+	m.NetworkViewMappingPolicy = flex.FlattenStringPointer(from.NetworkViewMappingPolicy)
+	m.SyncChildProjects = types.BoolPointerValue(from.SyncChildProjects)
+	m.SyncStatus = flex.FlattenStringPointer(from.SyncStatus)
+	planList := m.TaskList
+	m.TaskList = flex.FlattenFrameworkListNestedBlock(ctx, from.TaskList, GcpdnstaskgroupTaskListAttrTypes, diags, FlattenGcpdnstaskgroupTaskList)
+	if !planList.IsUnknown() {
+		reOrderedList, diags := utils.ReorderAndFilterNestedListResponse(ctx, planList, m.TaskList, "name")
+		if !diags.HasError() {
+			m.TaskList = reOrderedList.(basetypes.ListValue)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package cloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type GcpdnstaskgroupTaskListModel struct {
+	Name                       types.String `tfsdk:"name"`
+	Disabled                   types.Bool   `tfsdk:"disabled"`
+	State                      types.String `tfsdk:"state"`
+	StateMsg                   types.String `tfsdk:"state_msg"`
+	Filter                     types.String `tfsdk:"filter"`
+	ScheduleInterval           types.Int64  `tfsdk:"schedule_interval"`
+	ScheduleUnits              types.String `tfsdk:"schedule_units"`
+	GcpUser                    types.String `tfsdk:"gcp_user"`
+	StatusTimestamp            types.Int64  `tfsdk:"status_timestamp"`
+	LastRun                    types.Int64  `tfsdk:"last_run"`
+	SyncPublicZones            types.Bool   `tfsdk:"sync_public_zones"`
+	SyncPrivateZones           types.Bool   `tfsdk:"sync_private_zones"`
+	ZoneCount                  types.Int64  `tfsdk:"zone_count"`
+	CredentialsType            types.String `tfsdk:"credentials_type"`
+	OverlayState               types.String `tfsdk:"overlay_state"`
+	OverlayStatusTimestamp     types.Int64  `tfsdk:"overlay_status_timestamp"`
+	DnsSyncState               types.String `tfsdk:"dns_sync_state"`
+	DnsSyncStatusTimestamp     types.Int64  `tfsdk:"dns_sync_status_timestamp"`
+	ProjectSyncState           types.String `tfsdk:"project_sync_state"`
+	ProjectSyncStatusTimestamp types.Int64  `tfsdk:"project_sync_status_timestamp"`
+	SyncInterval               types.Int64  `tfsdk:"sync_interval"`
+	DnsSyncZoneCount           types.Int64  `tfsdk:"dns_sync_zone_count"`
+}
+
+var GcpdnstaskgroupTaskListAttrTypes = map[string]attr.Type{
+	"name":                          types.StringType,
+	"disabled":                      types.BoolType,
+	"state":                         types.StringType,
+	"state_msg":                     types.StringType,
+	"filter":                        types.StringType,
+	"schedule_interval":             types.Int64Type,
+	"schedule_units":                types.StringType,
+	"gcp_user":                      types.StringType,
+	"status_timestamp":              types.Int64Type,
+	"last_run":                      types.Int64Type,
+	"sync_public_zones":             types.BoolType,
+	"sync_private_zones":            types.BoolType,
+	"zone_count":                    types.Int64Type,
+	"credentials_type":              types.StringType,
+	"overlay_state":                 types.StringType,
+	"overlay_status_timestamp":      types.Int64Type,
+	"dns_sync_state":                types.StringType,
+	"dns_sync_status_timestamp":     types.Int64Type,
+	"project_sync_state":            types.StringType,
+	"project_sync_status_timestamp": types.Int64Type,
+	"sync_interval":                 types.Int64Type,
+	"dns_sync_zone_count":           types.Int64Type,
+}
+
+var GcpdnstaskgroupTaskListResourceSchemaAttributes = map[string]schema.Attribute{
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of this task.",
+	},
+	"disabled": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Indicates if the task is enabled or disabled.",
+	},
+	"state": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Indicate the sync status of this task.",
+	},
+	"state_msg": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "State message for the task.",
+	},
+	"filter": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("*"),
+		MarkdownDescription: "Filter for this task.",
+	},
+	"schedule_interval": schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(60),
+		MarkdownDescription: "Periodic interval for this task.",
+	},
+	"schedule_units": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("MINS"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("DAYS", "HOURS", "MINS"),
+		},
+		MarkdownDescription: "Units for the schedule interval.",
+	},
+	"gcp_user": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Reference to associated GCP user whose credentials are to be used for this task.",
+	},
+	"status_timestamp": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when the last state was logged.",
+	},
+	"last_run": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when the task was started last.",
+	},
+	"sync_public_zones": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Indicates whether public zones are synchronized.",
+	},
+	"sync_private_zones": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Indicates whether private zones are synchronized.",
+	},
+	"zone_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of zones synchronized by this task.",
+	},
+	"credentials_type": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("DIRECT"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("DIRECT", "INDIRECT"),
+		},
+		MarkdownDescription: "Credentials type used for connecting to the cloud management platform.",
+	},
+	"overlay_state": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Indicate the overlay sync status of this task.",
+	},
+	"overlay_status_timestamp": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when the last overlay state was logged.",
+	},
+	"dns_sync_state": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Indicate the DNS sync status of this task.",
+	},
+	"dns_sync_status_timestamp": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when the last DNS sync state was logged.",
+	},
+	"project_sync_state": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Indicate the project sync status of this task.",
+	},
+	"project_sync_status_timestamp": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when the last project sync state was logged.",
+	},
+	"sync_interval": schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The interval for synchronizing the child projects of this task.",
+	},
+	"dns_sync_zone_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of zones synchronized by the DNS sync of this task.",
+	},
+}
+
+func ExpandGcpdnstaskgroupTaskList(ctx context.Context, o types.Object, diags *diag.Diagnostics) *cloud.GcpdnstaskgroupTaskList {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m GcpdnstaskgroupTaskListModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *GcpdnstaskgroupTaskListModel) Expand(ctx context.Context, diags *diag.Diagnostics) *cloud.GcpdnstaskgroupTaskList {
+	if m == nil {
+		return nil
+	}
+	to := &cloud.GcpdnstaskgroupTaskList{
+		Name:             flex.ExpandStringPointer(m.Name),
+		Disabled:         flex.ExpandBoolPointer(m.Disabled),
+		Filter:           flex.ExpandStringPointer(m.Filter),
+		ScheduleInterval: flex.ExpandInt64Pointer(m.ScheduleInterval),
+		ScheduleUnits:    flex.ExpandStringPointer(m.ScheduleUnits),
+		GcpUser:          flex.ExpandStringPointer(m.GcpUser),
+		SyncPublicZones:  flex.ExpandBoolPointer(m.SyncPublicZones),
+		SyncPrivateZones: flex.ExpandBoolPointer(m.SyncPrivateZones),
+		CredentialsType:  flex.ExpandStringPointer(m.CredentialsType),
+		SyncInterval:     flex.ExpandInt64Pointer(m.SyncInterval),
+	}
+	return to
+}
+
+func FlattenGcpdnstaskgroupTaskList(ctx context.Context, from *cloud.GcpdnstaskgroupTaskList, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(GcpdnstaskgroupTaskListAttrTypes)
+	}
+	m := GcpdnstaskgroupTaskListModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, GcpdnstaskgroupTaskListAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *GcpdnstaskgroupTaskListModel) Flatten(ctx context.Context, from *cloud.GcpdnstaskgroupTaskList, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = GcpdnstaskgroupTaskListModel{}
+	}
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Disabled = types.BoolPointerValue(from.Disabled)
+	m.State = flex.FlattenStringPointer(from.State)
+	m.StateMsg = flex.FlattenStringPointer(from.StateMsg)
+	m.Filter = flex.FlattenStringPointer(from.Filter)
+	m.ScheduleInterval = flex.FlattenInt64Pointer(from.ScheduleInterval)
+	m.ScheduleUnits = flex.FlattenStringPointer(from.ScheduleUnits)
+	m.GcpUser = flex.FlattenStringPointer(from.GcpUser)
+	m.StatusTimestamp = flex.FlattenInt64Pointer(from.StatusTimestamp)
+	m.LastRun = flex.FlattenInt64Pointer(from.LastRun)
+	m.SyncPublicZones = types.BoolPointerValue(from.SyncPublicZones)
+	m.SyncPrivateZones = types.BoolPointerValue(from.SyncPrivateZones)
+	m.ZoneCount = flex.FlattenInt64Pointer(from.ZoneCount)
+	m.CredentialsType = flex.FlattenStringPointer(from.CredentialsType)
+	m.OverlayState = flex.FlattenStringPointer(from.OverlayState)
+	m.OverlayStatusTimestamp = flex.FlattenInt64Pointer(from.OverlayStatusTimestamp)
+	m.DnsSyncState = flex.FlattenStringPointer(from.DnsSyncState)
+	m.DnsSyncStatusTimestamp = flex.FlattenInt64Pointer(from.DnsSyncStatusTimestamp)
+	m.ProjectSyncState = flex.FlattenStringPointer(from.ProjectSyncState)
+	m.ProjectSyncStatusTimestamp = flex.FlattenInt64Pointer(from.ProjectSyncStatusTimestamp)
+	m.SyncInterval = flex.FlattenInt64Pointer(from.SyncInterval)
+	m.DnsSyncZoneCount = flex.FlattenInt64Pointer(from.DnsSyncZoneCount)
+}
@@ -0,0 +1,211 @@
+package cloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/cloud"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type GcpuserModel struct {
+	Ref                     types.String `tfsdk:"ref"`
+	AuthProviderX509CertUrl types.String `tfsdk:"auth_provider_x509_cert_url"`
+	AuthUri                 types.String `tfsdk:"auth_uri"`
+	ClientEmail             types.String `tfsdk:"client_email"`
+	ClientId                types.String `tfsdk:"client_id"`
+	ClientX509CertUrl       types.String `tfsdk:"client_x509_cert_url"`
+	FileName                types.String `tfsdk:"file_name"`
+	LastUsed                types.Int64  `tfsdk:"last_used"`
+	PrivateKey              types.String `tfsdk:"private_key"`
+	PrivateKeyId            types.String `tfsdk:"private_key_id"`
+	ProjectId               types.String `tfsdk:"project_id"`
+	Status                  types.String `tfsdk:"status"`
+	TokenUri                types.String `tfsdk:"token_uri"`
+	Type                    types.String `tfsdk:"type"`
+	UserName                types.String `tfsdk:"user_name"`
+}
+
+var GcpuserAttrTypes = map[string]attr.Type{
+	"ref":                         types.StringType,
+	"auth_provider_x509_cert_url": types.StringType,
+	"auth_uri":                    types.StringType,
+	"client_email":                types.StringType,
+	"client_id":                   types.StringType,
+	"client_x509_cert_url":        types.StringType,
+	"file_name":                   types.StringType,
+	"last_used":                   types.Int64Type,
+	"private_key":                 types.StringType,
+	"private_key_id":              types.StringType,
+	"project_id":                  types.StringType,
+	"status":                      types.StringType,
+	"token_uri":                   types.StringType,
+	"type":                        types.StringType,
+	"user_name":                   types.StringType,
+}
+
+var GcpuserResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"auth_provider_x509_cert_url": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("https://www.googleapis.com/oauth2/v1/certs"),
+		MarkdownDescription: "The URL of the public x509 certificate of the authentication provider.",
+	},
+	"auth_uri": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("https://accounts.google.com/o/oauth2/auth"),
+		MarkdownDescription: "The OAuth 2.0 authorization URI.",
+	},
+	"client_email": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The email address of the GCP service account.",
+	},
+	"client_id": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The Client ID of the GCP service account.",
+	},
+	"client_x509_cert_url": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The URL of the public x509 certificate of the GCP service account.",
+	},
+	"file_name": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the service account key file the credentials of this GCP user were taken from.",
+	},
+	"last_used": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when this GCP user credentials was last used.",
+	},
+	"private_key": schema.StringAttribute{
+		Required:  true,
+		Sensitive: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The private key of the GCP service account.",
+	},
+	"private_key_id": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The ID of the private key of the GCP service account.",
+	},
+	"project_id": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The GCP Project ID of the GCP service account.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Indicate the validity status of this GCP user.",
+	},
+	"token_uri": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("https://oauth2.googleapis.com/token"),
+		MarkdownDescription: "The OAuth 2.0 token URI.",
+	},
+	"type": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("service_account"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("service_account"),
+		},
+		MarkdownDescription: "The type of the GCP credentials.",
+	},
+	"user_name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+			stringvalidator.LengthAtMost(64),
+		},
+		MarkdownDescription: "The GCP user name. Maximum 64 characters.",
+	},
+}
+
+func (m *GcpuserModel) Expand(ctx context.Context, diags *diag.Diagnostics) *cloud.Gcpuser {
+	if m == nil {
+		return nil
+	}
+	to := &cloud.Gcpuser{
+		AuthProviderX509CertUrl: flex.ExpandStringPointer(m.AuthProviderX509CertUrl),
+		AuthUri:                 flex.ExpandStringPointer(m.AuthUri),
+		ClientEmail:             flex.ExpandStringPointer(m.ClientEmail),
+		ClientId:                flex.ExpandStringPointer(m.ClientId),
+		ClientX509CertUrl:       flex.ExpandStringPointer(m.ClientX509CertUrl),
+		FileName:                flex.ExpandStringPointer(m.FileName),
+		PrivateKey:              flex.ExpandStringPointer(m.PrivateKey),
+		PrivateKeyId:            flex.ExpandStringPointer(m.PrivateKeyId),
+		ProjectId:               flex.ExpandStringPointer(m.ProjectId),
+		TokenUri:                flex.ExpandStringPointer(m.TokenUri),
+		Type:                    flex.ExpandStringPointer(m.Type),
+		UserName:                flex.ExpandStringPointer(m.UserName),
+	}
+	return to
+}
+
+func FlattenGcpuser(ctx context.Context, from *cloud.Gcpuser, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(GcpuserAttrTypes)
+	}
+	m := GcpuserModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, GcpuserAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *GcpuserModel) Flatten(ctx context.Context, from *cloud.Gcpuser, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = GcpuserModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AuthProviderX509CertUrl = flex.FlattenStringPointer(from.AuthProviderX509CertUrl)
+	m.AuthUri = flex.FlattenStringPointer(from.AuthUri)
+	m.ClientEmail = flex.FlattenStringPointer(from.ClientEmail)
+	m.ClientId = flex.FlattenStringPointer(from.ClientId)
+	m.ClientX509CertUrl = flex.FlattenStringPointer(from.ClientX509CertUrl)
+	m.FileName = flex.FlattenStringPointer(from.FileName)
+	m.LastUsed = flex.FlattenInt64Pointer(from.LastUsed)
+	m.PrivateKeyId = flex.FlattenStringPointer(from.PrivateKeyId)
+	m.ProjectId = flex.FlattenStringPointer(from.ProjectId)
+	m.Status = flex.FlattenStringPointer(from.Status)
+	m.TokenUri = flex.FlattenStringPointer(from.TokenUri)
+	m.Type = flex.FlattenStringPointer(from.Type)
+	m.UserName = flex.FlattenStringPointer(from.UserName)
+}
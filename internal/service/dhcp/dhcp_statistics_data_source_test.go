@@ -0,0 +1,47 @@
+package dhcp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDhcpStatisticsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dhcp_statistics.test"
+	network := "10.120.33.0/24"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDhcpStatisticsDataSourceConfigFilters(network),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.dhcp_utilization"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.dhcp_utilization_status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.total_hosts"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccDhcpStatisticsDataSourceConfigFilters(network string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_network" "test" {
+  network = %q
+}
+
+data "nios_dhcp_statistics" "test" {
+  filters = {
+    statistics_object = nios_ipam_network.test.ref
+  }
+}
+`, network)
+}
@@ -139,7 +139,7 @@ func (d *DhcpfailoverDataSource) Read(ctx context.Context, req datasource.ReadRe
 				ReturnFieldsPlus(readableAttributesForDhcpfailover).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
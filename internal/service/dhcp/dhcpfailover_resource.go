@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type DhcpfailoverResource struct {
 	client *niosclient.APIClient
 }
 
+// DhcpfailoverResourceModel describes the resource data model, extending DhcpfailoverModel with the operation timeouts.
+type DhcpfailoverResourceModel struct {
+	DhcpfailoverModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DhcpfailoverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_failover"
 }
@@ -43,6 +51,7 @@ func (r *DhcpfailoverResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Failover.",
 		Attributes:          DhcpfailoverResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *DhcpfailoverResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *DhcpfailoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DhcpfailoverModel
+	var data DhcpfailoverResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -90,7 +99,7 @@ func (r *DhcpfailoverResource) Create(ctx context.Context, req resource.CreateRe
 
 	var apiRes *dhcp.CreateDhcpfailoverResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *DhcpfailoverResource) Create(ctx context.Context, req resource.CreateRe
 
 func (r *DhcpfailoverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DhcpfailoverModel
+	var data DhcpfailoverResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *DhcpfailoverResource) Read(ctx context.Context, req resource.ReadReques
 		apiRes  *dhcp.GetDhcpfailoverResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			DhcpfailoverAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDhcpfailover).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -221,7 +230,7 @@ func (r *DhcpfailoverResource) Read(ctx context.Context, req resource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DhcpfailoverResource) ReadByExtAttrs(ctx context.Context, data *DhcpfailoverModel, resp *resource.ReadResponse) bool {
+func (r *DhcpfailoverResource) ReadByExtAttrs(ctx context.Context, data *DhcpfailoverResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -248,7 +257,7 @@ func (r *DhcpfailoverResource) ReadByExtAttrs(ctx context.Context, data *Dhcpfai
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDhcpfailover).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Dhcpfailover by extattrs, got error: %s", err))
@@ -279,7 +288,7 @@ func (r *DhcpfailoverResource) ReadByExtAttrs(ctx context.Context, data *Dhcpfai
 
 func (r *DhcpfailoverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DhcpfailoverModel
+	var data DhcpfailoverResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -329,7 +338,7 @@ func (r *DhcpfailoverResource) Update(ctx context.Context, req resource.UpdateRe
 
 	var apiRes *dhcp.UpdateDhcpfailoverResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -371,7 +380,7 @@ func (r *DhcpfailoverResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *DhcpfailoverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DhcpfailoverModel
+	var data DhcpfailoverResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -382,7 +391,7 @@ func (r *DhcpfailoverResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			DhcpfailoverAPI.
 			Delete(ctx, resourceRef).
@@ -409,7 +418,7 @@ func (r *DhcpfailoverResource) ImportState(ctx context.Context, req resource.Imp
 }
 
 func (r *DhcpfailoverResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data DhcpfailoverModel
+	var data DhcpfailoverResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
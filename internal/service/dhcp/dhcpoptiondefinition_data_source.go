@@ -133,7 +133,7 @@ func (d *DhcpoptiondefinitionDataSource) Read(ctx context.Context, req datasourc
 				ReturnFieldsPlus(readableAttributesForDhcpoptiondefinition).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
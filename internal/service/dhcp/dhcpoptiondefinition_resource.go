@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type DhcpoptiondefinitionResource struct {
 	client *niosclient.APIClient
 }
 
+// DhcpoptiondefinitionResourceModel describes the resource data model, extending DhcpoptiondefinitionModel with the operation timeouts.
+type DhcpoptiondefinitionResourceModel struct {
+	DhcpoptiondefinitionModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DhcpoptiondefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_optiondefinition"
 }
@@ -42,6 +49,7 @@ func (r *DhcpoptiondefinitionResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Option Definition.",
 		Attributes:          DhcpoptiondefinitionResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +74,7 @@ func (r *DhcpoptiondefinitionResource) Configure(ctx context.Context, req resour
 }
 
 func (r *DhcpoptiondefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data DhcpoptiondefinitionModel
+	var data DhcpoptiondefinitionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,7 +90,7 @@ func (r *DhcpoptiondefinitionResource) Create(ctx context.Context, req resource.
 
 	var apiRes *dhcp.CreateDhcpoptiondefinitionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -123,7 +131,7 @@ func (r *DhcpoptiondefinitionResource) Create(ctx context.Context, req resource.
 }
 
 func (r *DhcpoptiondefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data DhcpoptiondefinitionModel
+	var data DhcpoptiondefinitionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -139,14 +147,14 @@ func (r *DhcpoptiondefinitionResource) Read(ctx context.Context, req resource.Re
 		apiRes  *dhcp.GetDhcpoptiondefinitionResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			DhcpoptiondefinitionAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDhcpoptiondefinition).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -176,8 +184,8 @@ func (r *DhcpoptiondefinitionResource) Read(ctx context.Context, req resource.Re
 
 func (r *DhcpoptiondefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DhcpoptiondefinitionModel
-	var stateData DhcpoptiondefinitionModel
+	var data DhcpoptiondefinitionResourceModel
+	var stateData DhcpoptiondefinitionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -210,7 +218,7 @@ func (r *DhcpoptiondefinitionResource) Update(ctx context.Context, req resource.
 
 	var apiRes *dhcp.UpdateDhcpoptiondefinitionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -243,7 +251,7 @@ func (r *DhcpoptiondefinitionResource) Update(ctx context.Context, req resource.
 }
 
 func (r *DhcpoptiondefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DhcpoptiondefinitionModel
+	var data DhcpoptiondefinitionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -254,7 +262,7 @@ func (r *DhcpoptiondefinitionResource) Delete(ctx context.Context, req resource.
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			DhcpoptiondefinitionAPI.
 			Delete(ctx, resourceRef).
@@ -281,7 +289,7 @@ func (r *DhcpoptiondefinitionResource) ImportState(ctx context.Context, req reso
 
 // updateRefIfOptionSpaceChanged updates the ref if the option space name changes by
 // finding the option definition with the new space name and updating the data model accordingly.
-func (r *DhcpoptiondefinitionResource) updateRefIfOptionSpaceChanged(ctx context.Context, resp *resource.UpdateResponse, data *DhcpoptiondefinitionModel, stateData *DhcpoptiondefinitionModel) {
+func (r *DhcpoptiondefinitionResource) updateRefIfOptionSpaceChanged(ctx context.Context, resp *resource.UpdateResponse, data *DhcpoptiondefinitionResourceModel, stateData *DhcpoptiondefinitionResourceModel) {
 	if resp.Diagnostics.HasError() {
 		return
 	}
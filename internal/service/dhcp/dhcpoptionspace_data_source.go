@@ -133,7 +133,7 @@ func (d *DhcpoptionspaceDataSource) Read(ctx context.Context, req datasource.Rea
 				ReturnFieldsPlus(readableAttributesForDhcpoptionspace).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
@@ -40,6 +42,12 @@ type DhcpoptionspaceResource struct {
 	client *niosclient.APIClient
 }
 
+// DhcpoptionspaceResourceModel describes the resource data model, extending DhcpoptionspaceModel with the operation timeouts.
+type DhcpoptionspaceResourceModel struct {
+	DhcpoptionspaceModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DhcpoptionspaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_optionspace"
 }
@@ -48,6 +56,7 @@ func (r *DhcpoptionspaceResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Option Space.",
 		Attributes:          DhcpoptionspaceResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -72,7 +81,7 @@ func (r *DhcpoptionspaceResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *DhcpoptionspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data DhcpoptionspaceModel
+	var data DhcpoptionspaceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *DhcpoptionspaceResource) Create(ctx context.Context, req resource.Creat
 
 	var apiRes *dhcp.CreateDhcpoptionspaceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -129,7 +138,7 @@ func (r *DhcpoptionspaceResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *DhcpoptionspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data DhcpoptionspaceModel
+	var data DhcpoptionspaceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,14 +154,14 @@ func (r *DhcpoptionspaceResource) Read(ctx context.Context, req resource.ReadReq
 		apiRes  *dhcp.GetDhcpoptionspaceResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			DhcpoptionspaceAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDhcpoptionspace).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 		if httpRes != nil {
 			return httpRes.StatusCode, callErr
@@ -181,7 +190,7 @@ func (r *DhcpoptionspaceResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *DhcpoptionspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DhcpoptionspaceModel
+	var data DhcpoptionspaceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -205,7 +214,7 @@ func (r *DhcpoptionspaceResource) Update(ctx context.Context, req resource.Updat
 
 	var apiRes *dhcp.UpdateDhcpoptionspaceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -238,7 +247,7 @@ func (r *DhcpoptionspaceResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *DhcpoptionspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DhcpoptionspaceModel
+	var data DhcpoptionspaceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -249,7 +258,7 @@ func (r *DhcpoptionspaceResource) Delete(ctx context.Context, req resource.Delet
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.DoWithTimeout(ctx, OptionSpaceOperationTimeout, IsOptionDefinitionReferenced, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, OptionSpaceOperationTimeout), IsOptionDefinitionReferenced, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			DhcpoptionspaceAPI.
 			Delete(ctx, resourceRef).
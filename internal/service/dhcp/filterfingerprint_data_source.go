@@ -140,7 +140,7 @@ func (d *FilterfingerprintDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForFilterfingerprint).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type FilterfingerprintResource struct {
 	client *niosclient.APIClient
 }
 
+// FilterfingerprintResourceModel describes the resource data model, extending FilterfingerprintModel with the operation timeouts.
+type FilterfingerprintResourceModel struct {
+	FilterfingerprintModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FilterfingerprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_filterfingerprint"
 }
@@ -41,6 +49,7 @@ func (r *FilterfingerprintResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Fingerprint Filter resource.",
 		Attributes:          FilterfingerprintResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *FilterfingerprintResource) Configure(ctx context.Context, req resource.
 
 func (r *FilterfingerprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FilterfingerprintModel
+	var data FilterfingerprintResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *FilterfingerprintResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *dhcp.CreateFilterfingerprintResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *FilterfingerprintResource) Create(ctx context.Context, req resource.Cre
 
 func (r *FilterfingerprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FilterfingerprintModel
+	var data FilterfingerprintResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *FilterfingerprintResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *dhcp.GetFilterfingerprintResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FilterfingerprintAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFilterfingerprint).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *FilterfingerprintResource) Read(ctx context.Context, req resource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *FilterfingerprintResource) ReadByExtAttrs(ctx context.Context, data *FilterfingerprintModel, resp *resource.ReadResponse) bool {
+func (r *FilterfingerprintResource) ReadByExtAttrs(ctx context.Context, data *FilterfingerprintResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +258,7 @@ func (r *FilterfingerprintResource) ReadByExtAttrs(ctx context.Context, data *Fi
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForFilterfingerprint).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Filterfingerprint by extattrs, got error: %s", err))
@@ -281,7 +290,7 @@ func (r *FilterfingerprintResource) ReadByExtAttrs(ctx context.Context, data *Fi
 
 func (r *FilterfingerprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FilterfingerprintModel
+	var data FilterfingerprintResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -332,7 +341,7 @@ func (r *FilterfingerprintResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *dhcp.UpdateFilterfingerprintResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -375,7 +384,7 @@ func (r *FilterfingerprintResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *FilterfingerprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FilterfingerprintModel
+	var data FilterfingerprintResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -386,7 +395,7 @@ func (r *FilterfingerprintResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FilterfingerprintAPI.
 			Delete(ctx, resourceRef).
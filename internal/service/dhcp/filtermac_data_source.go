@@ -139,7 +139,7 @@ func (d *FiltermacDataSource) Read(ctx context.Context, req datasource.ReadReque
 				ReturnFieldsPlus(readableAttributesForFiltermac).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
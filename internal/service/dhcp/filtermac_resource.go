@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +38,12 @@ type FiltermacResource struct {
 	client *niosclient.APIClient
 }
 
+// FiltermacResourceModel describes the resource data model, extending FiltermacModel with the operation timeouts.
+type FiltermacResourceModel struct {
+	FiltermacModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FiltermacResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_filtermac"
 }
@@ -44,6 +52,7 @@ func (r *FiltermacResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP MAC Address Filter.",
 		Attributes:          FiltermacResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -69,7 +78,7 @@ func (r *FiltermacResource) Configure(ctx context.Context, req resource.Configur
 
 func (r *FiltermacResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FiltermacModel
+	var data FiltermacResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -91,7 +100,7 @@ func (r *FiltermacResource) Create(ctx context.Context, req resource.CreateReque
 
 	var apiRes *dhcp.CreateFiltermacResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -138,7 +147,7 @@ func (r *FiltermacResource) Create(ctx context.Context, req resource.CreateReque
 
 func (r *FiltermacResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FiltermacModel
+	var data FiltermacResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -160,14 +169,14 @@ func (r *FiltermacResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *dhcp.GetFiltermacResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FiltermacAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFiltermac).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *FiltermacResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *FiltermacResource) ReadByExtAttrs(ctx context.Context, data *FiltermacModel, resp *resource.ReadResponse) bool {
+func (r *FiltermacResource) ReadByExtAttrs(ctx context.Context, data *FiltermacResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +258,7 @@ func (r *FiltermacResource) ReadByExtAttrs(ctx context.Context, data *FiltermacM
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForFiltermac).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Filtermac by extattrs, got error: %s", err))
@@ -280,7 +289,7 @@ func (r *FiltermacResource) ReadByExtAttrs(ctx context.Context, data *FiltermacM
 
 func (r *FiltermacResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FiltermacModel
+	var data FiltermacResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -330,7 +339,7 @@ func (r *FiltermacResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var apiRes *dhcp.UpdateFiltermacResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -372,7 +381,7 @@ func (r *FiltermacResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *FiltermacResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FiltermacModel
+	var data FiltermacResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -383,7 +392,7 @@ func (r *FiltermacResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FiltermacAPI.
 			Delete(ctx, resourceRef).
@@ -410,7 +419,7 @@ func (r *FiltermacResource) ImportState(ctx context.Context, req resource.Import
 }
 
 func (r *FiltermacResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data FiltermacModel
+	var data FiltermacResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
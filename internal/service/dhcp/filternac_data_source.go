@@ -139,7 +139,7 @@ func (d *FilternacDataSource) Read(ctx context.Context, req datasource.ReadReque
 				ReturnFieldsPlus(readableAttributesForFilternac).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
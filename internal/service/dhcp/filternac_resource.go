@@ -10,11 +10,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type FilternacResource struct {
 	client *niosclient.APIClient
 }
 
+// FilternacResourceModel describes the resource data model, extending FilternacModel with the operation timeouts.
+type FilternacResourceModel struct {
+	FilternacModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FilternacResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_filternac"
 }
@@ -43,6 +51,7 @@ func (r *FilternacResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP NAC Filter.",
 		Attributes:          FilternacResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *FilternacResource) Configure(ctx context.Context, req resource.Configur
 
 func (r *FilternacResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FilternacModel
+	var data FilternacResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -91,7 +100,7 @@ func (r *FilternacResource) Create(ctx context.Context, req resource.CreateReque
 
 	var apiRes *dhcp.CreateFilternacResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -139,7 +148,7 @@ func (r *FilternacResource) Create(ctx context.Context, req resource.CreateReque
 
 func (r *FilternacResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FilternacModel
+	var data FilternacResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -161,14 +170,14 @@ func (r *FilternacResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *dhcp.GetFilternacResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FilternacAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFilternac).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -224,7 +233,7 @@ func (r *FilternacResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *FilternacResource) ReadByExtAttrs(ctx context.Context, data *FilternacModel, resp *resource.ReadResponse) bool {
+func (r *FilternacResource) ReadByExtAttrs(ctx context.Context, data *FilternacResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -251,7 +260,7 @@ func (r *FilternacResource) ReadByExtAttrs(ctx context.Context, data *FilternacM
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForFilternac).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Filternac by extattrs, got error: %s", err))
@@ -282,7 +291,7 @@ func (r *FilternacResource) ReadByExtAttrs(ctx context.Context, data *FilternacM
 
 func (r *FilternacResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FilternacModel
+	var data FilternacResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -333,7 +342,7 @@ func (r *FilternacResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var apiRes *dhcp.UpdateFilternacResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -376,7 +385,7 @@ func (r *FilternacResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *FilternacResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FilternacModel
+	var data FilternacResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -387,7 +396,7 @@ func (r *FilternacResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FilternacAPI.
 			Delete(ctx, resourceRef).
@@ -413,7 +422,7 @@ func (r *FilternacResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "associate_internal_id", []byte("true"))...)
 }
 func (r *FilternacResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data FilternacModel
+	var data FilternacResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
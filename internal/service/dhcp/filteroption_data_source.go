@@ -140,7 +140,7 @@ func (d *FilteroptionDataSource) Read(ctx context.Context, req datasource.ReadRe
 				ReturnFieldsPlus(readableAttributesForFilteroption).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
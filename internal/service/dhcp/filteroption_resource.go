@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +38,12 @@ type FilteroptionResource struct {
 	client *niosclient.APIClient
 }
 
+// FilteroptionResourceModel describes the resource data model, extending FilteroptionModel with the operation timeouts.
+type FilteroptionResourceModel struct {
+	FilteroptionModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FilteroptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_filteroption"
 }
@@ -44,6 +52,7 @@ func (r *FilteroptionResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Filteroption Resource.",
 		Attributes:          FilteroptionResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -69,7 +78,7 @@ func (r *FilteroptionResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *FilteroptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FilteroptionModel
+	var data FilteroptionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -92,7 +101,7 @@ func (r *FilteroptionResource) Create(ctx context.Context, req resource.CreateRe
 
 	var apiRes *dhcp.CreateFilteroptionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -140,7 +149,7 @@ func (r *FilteroptionResource) Create(ctx context.Context, req resource.CreateRe
 
 func (r *FilteroptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FilteroptionModel
+	var data FilteroptionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -162,14 +171,14 @@ func (r *FilteroptionResource) Read(ctx context.Context, req resource.ReadReques
 		apiRes  *dhcp.GetFilteroptionResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FilteroptionAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFilteroption).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -225,7 +234,7 @@ func (r *FilteroptionResource) Read(ctx context.Context, req resource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *FilteroptionResource) ReadByExtAttrs(ctx context.Context, data *FilteroptionModel, resp *resource.ReadResponse) bool {
+func (r *FilteroptionResource) ReadByExtAttrs(ctx context.Context, data *FilteroptionResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -252,7 +261,7 @@ func (r *FilteroptionResource) ReadByExtAttrs(ctx context.Context, data *Filtero
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForFilteroption).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Filteroption by extattrs, got error: %s", err))
@@ -283,7 +292,7 @@ func (r *FilteroptionResource) ReadByExtAttrs(ctx context.Context, data *Filtero
 
 func (r *FilteroptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FilteroptionModel
+	var data FilteroptionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -334,7 +343,7 @@ func (r *FilteroptionResource) Update(ctx context.Context, req resource.UpdateRe
 
 	var apiRes *dhcp.UpdateFilteroptionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -377,7 +386,7 @@ func (r *FilteroptionResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *FilteroptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FilteroptionModel
+	var data FilteroptionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -388,7 +397,7 @@ func (r *FilteroptionResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FilteroptionAPI.
 			Delete(ctx, resourceRef).
@@ -415,7 +424,7 @@ func (r *FilteroptionResource) ImportState(ctx context.Context, req resource.Imp
 }
 
 func (r *FilteroptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data FilteroptionModel
+	var data FilteroptionResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -139,7 +139,7 @@ func (d *FilterrelayagentDataSource) Read(ctx context.Context, req datasource.Re
 				ReturnFieldsPlus(readableAttributesForFilterrelayagent).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
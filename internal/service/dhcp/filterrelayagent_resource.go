@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type FilterrelayagentResource struct {
 	client *niosclient.APIClient
 }
 
+// FilterrelayagentResourceModel describes the resource data model, extending FilterrelayagentModel with the operation timeouts.
+type FilterrelayagentResourceModel struct {
+	FilterrelayagentModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FilterrelayagentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_filterrelayagent"
 }
@@ -43,6 +51,7 @@ func (r *FilterrelayagentResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Filter Relay Agent resource.",
 		Attributes:          FilterrelayagentResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *FilterrelayagentResource) Configure(ctx context.Context, req resource.C
 
 func (r *FilterrelayagentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FilterrelayagentModel
+	var data FilterrelayagentResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -91,7 +100,7 @@ func (r *FilterrelayagentResource) Create(ctx context.Context, req resource.Crea
 
 	var apiRes *dhcp.CreateFilterrelayagentResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -139,7 +148,7 @@ func (r *FilterrelayagentResource) Create(ctx context.Context, req resource.Crea
 
 func (r *FilterrelayagentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FilterrelayagentModel
+	var data FilterrelayagentResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -161,14 +170,14 @@ func (r *FilterrelayagentResource) Read(ctx context.Context, req resource.ReadRe
 		apiRes  *dhcp.GetFilterrelayagentResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FilterrelayagentAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFilterrelayagent).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -224,7 +233,7 @@ func (r *FilterrelayagentResource) Read(ctx context.Context, req resource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *FilterrelayagentResource) ReadByExtAttrs(ctx context.Context, data *FilterrelayagentModel, resp *resource.ReadResponse) bool {
+func (r *FilterrelayagentResource) ReadByExtAttrs(ctx context.Context, data *FilterrelayagentResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -251,7 +260,7 @@ func (r *FilterrelayagentResource) ReadByExtAttrs(ctx context.Context, data *Fil
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForFilterrelayagent).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Filterrelayagent by extattrs, got error: %s", err))
@@ -282,7 +291,7 @@ func (r *FilterrelayagentResource) ReadByExtAttrs(ctx context.Context, data *Fil
 
 func (r *FilterrelayagentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FilterrelayagentModel
+	var data FilterrelayagentResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -333,7 +342,7 @@ func (r *FilterrelayagentResource) Update(ctx context.Context, req resource.Upda
 
 	var apiRes *dhcp.UpdateFilterrelayagentResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -376,7 +385,7 @@ func (r *FilterrelayagentResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *FilterrelayagentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FilterrelayagentModel
+	var data FilterrelayagentResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -387,7 +396,7 @@ func (r *FilterrelayagentResource) Delete(ctx context.Context, req resource.Dele
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FilterrelayagentAPI.
 			Delete(ctx, resourceRef).
@@ -414,7 +423,7 @@ func (r *FilterrelayagentResource) ImportState(ctx context.Context, req resource
 }
 
 func (r *FilterrelayagentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data FilterrelayagentModel
+	var data FilterrelayagentResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -18,6 +18,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -37,6 +38,12 @@ type FingerprintResource struct {
 	client *niosclient.APIClient
 }
 
+// FingerprintResourceModel describes the resource data model, extending FingerprintModel with the operation timeouts.
+type FingerprintResourceModel struct {
+	FingerprintModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FingerprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_fingerprint"
 }
@@ -45,6 +52,7 @@ func (r *FingerprintResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Fingerprint.",
 		Attributes:          FingerprintResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -70,7 +78,7 @@ func (r *FingerprintResource) Configure(ctx context.Context, req resource.Config
 
 func (r *FingerprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FingerprintModel
+	var data FingerprintResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -93,7 +101,7 @@ func (r *FingerprintResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *dhcp.CreateFingerprintResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -141,7 +149,7 @@ func (r *FingerprintResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *FingerprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FingerprintModel
+	var data FingerprintResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -163,14 +171,14 @@ func (r *FingerprintResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *dhcp.GetFingerprintResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FingerprintAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFingerprint).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -226,7 +234,7 @@ func (r *FingerprintResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *FingerprintResource) ReadByExtAttrs(ctx context.Context, data *FingerprintModel, resp *resource.ReadResponse) bool {
+func (r *FingerprintResource) ReadByExtAttrs(ctx context.Context, data *FingerprintResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -253,7 +261,7 @@ func (r *FingerprintResource) ReadByExtAttrs(ctx context.Context, data *Fingerpr
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForFingerprint).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fingerprint by extattrs, got error: %s", err))
@@ -285,7 +293,7 @@ func (r *FingerprintResource) ReadByExtAttrs(ctx context.Context, data *Fingerpr
 
 func (r *FingerprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FingerprintModel
+	var data FingerprintResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -336,7 +344,7 @@ func (r *FingerprintResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *dhcp.UpdateFingerprintResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -379,7 +387,7 @@ func (r *FingerprintResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *FingerprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FingerprintModel
+	var data FingerprintResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -390,7 +398,7 @@ func (r *FingerprintResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FingerprintAPI.
 			Delete(ctx, resourceRef).
@@ -412,7 +420,7 @@ func (r *FingerprintResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *FingerprintResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data FingerprintModel
+	var data FingerprintResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -188,7 +188,7 @@ func (d *FixedaddressDataSource) Read(ctx context.Context, req datasource.ReadRe
 				ReturnFieldsPlus(readableAttributesForFixedaddress).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
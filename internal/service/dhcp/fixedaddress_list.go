@@ -15,6 +15,7 @@ import (
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -180,8 +181,10 @@ func (l *FixedaddressList) List(ctx context.Context, req list.ListRequest, strea
 					}
 					continue
 				}
-				result1 := FlattenFixedaddress(ctx, &item, &result.Diagnostics)
-				result.Diagnostics.Append(result.Resource.Set(ctx, &result1)...)
+				resourceModel := FixedaddressResourceModel{Timeouts: timeouts.Null()}
+				resourceModel.Flatten(ctx, &item, &result.Diagnostics)
+				resourceModel.ExtAttrsAll = types.MapNull(types.StringType)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &resourceModel)...)
 				if result.Diagnostics.HasError() {
 					if !push(result) {
 						return
@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +37,12 @@ type FixedaddressResource struct {
 	client *niosclient.APIClient
 }
 
+// FixedaddressResourceModel describes the resource data model, extending FixedaddressModel with the operation timeouts.
+type FixedaddressResourceModel struct {
+	FixedaddressModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FixedaddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_fixed_address"
 	resp.ResourceBehavior = resource.ResourceBehavior{
@@ -47,6 +54,7 @@ func (r *FixedaddressResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Fixed Address.",
 		Attributes:          FixedaddressResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -82,7 +90,7 @@ func (r *FixedaddressResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *FixedaddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FixedaddressModel
+	var data FixedaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -111,7 +119,11 @@ func (r *FixedaddressResource) Create(ctx context.Context, req resource.CreateRe
 
 	var apiRes *dhcp.CreateFixedaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network are serialized so that parallel resources get distinct addresses
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -167,7 +179,7 @@ func (r *FixedaddressResource) Create(ctx context.Context, req resource.CreateRe
 
 func (r *FixedaddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FixedaddressModel
+	var data FixedaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -189,14 +201,14 @@ func (r *FixedaddressResource) Read(ctx context.Context, req resource.ReadReques
 		apiRes  *dhcp.GetFixedaddressResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FixedaddressAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFixedaddress).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -255,7 +267,7 @@ func (r *FixedaddressResource) Read(ctx context.Context, req resource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *FixedaddressResource) ReadByExtAttrs(ctx context.Context, data *FixedaddressModel, resp *resource.ReadResponse) bool {
+func (r *FixedaddressResource) ReadByExtAttrs(ctx context.Context, data *FixedaddressResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -282,7 +294,7 @@ func (r *FixedaddressResource) ReadByExtAttrs(ctx context.Context, data *Fixedad
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForFixedaddress).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fixedaddress by extattrs, got error: %s", err))
@@ -317,7 +329,7 @@ func (r *FixedaddressResource) ReadByExtAttrs(ctx context.Context, data *Fixedad
 
 func (r *FixedaddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FixedaddressModel
+	var data FixedaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -368,7 +380,7 @@ func (r *FixedaddressResource) Update(ctx context.Context, req resource.UpdateRe
 
 	var apiRes *dhcp.UpdateFixedaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -414,7 +426,7 @@ func (r *FixedaddressResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *FixedaddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FixedaddressModel
+	var data FixedaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -425,7 +437,7 @@ func (r *FixedaddressResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FixedaddressAPI.
 			Delete(ctx, resourceRef).
@@ -446,7 +458,7 @@ func (r *FixedaddressResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
-func (r *FixedaddressResource) UpdateFuncCallAttributeName(ctx context.Context, data FixedaddressModel, diags *diag.Diagnostics) types.Object {
+func (r *FixedaddressResource) UpdateFuncCallAttributeName(ctx context.Context, data FixedaddressResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -473,7 +485,7 @@ func (r *FixedaddressResource) ImportState(ctx context.Context, req resource.Imp
 }
 
 func (r *FixedaddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data FixedaddressModel
+	var data FixedaddressResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
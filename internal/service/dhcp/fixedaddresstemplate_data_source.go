@@ -140,7 +140,7 @@ func (d *FixedaddresstemplateDataSource) Read(ctx context.Context, req datasourc
 				ReturnFieldsPlus(readableAttributesForFixedaddresstemplate).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type FixedaddresstemplateResource struct {
 	client *niosclient.APIClient
 }
 
+// FixedaddresstemplateResourceModel describes the resource data model, extending FixedaddresstemplateModel with the operation timeouts.
+type FixedaddresstemplateResourceModel struct {
+	FixedaddresstemplateModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *FixedaddresstemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_fixedaddresstemplate"
 }
@@ -41,6 +49,7 @@ func (r *FixedaddresstemplateResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Fixed Address Template.",
 		Attributes:          FixedaddresstemplateResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *FixedaddresstemplateResource) Configure(ctx context.Context, req resour
 
 func (r *FixedaddresstemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data FixedaddresstemplateModel
+	var data FixedaddresstemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,7 +91,7 @@ func (r *FixedaddresstemplateResource) Create(ctx context.Context, req resource.
 
 	var apiRes *dhcp.CreateFixedaddresstemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -129,7 +138,7 @@ func (r *FixedaddresstemplateResource) Create(ctx context.Context, req resource.
 
 func (r *FixedaddresstemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data FixedaddresstemplateModel
+	var data FixedaddresstemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,14 +154,14 @@ func (r *FixedaddresstemplateResource) Read(ctx context.Context, req resource.Re
 		apiRes  *dhcp.GetFixedaddresstemplateResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			FixedaddresstemplateAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForFixedaddresstemplate).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -186,7 +195,7 @@ func (r *FixedaddresstemplateResource) Read(ctx context.Context, req resource.Re
 
 func (r *FixedaddresstemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data FixedaddresstemplateModel
+	var data FixedaddresstemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -224,7 +233,7 @@ func (r *FixedaddresstemplateResource) Update(ctx context.Context, req resource.
 
 	var apiRes *dhcp.UpdateFixedaddresstemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -263,7 +272,7 @@ func (r *FixedaddresstemplateResource) Update(ctx context.Context, req resource.
 }
 
 func (r *FixedaddresstemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data FixedaddresstemplateModel
+	var data FixedaddresstemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -274,7 +283,7 @@ func (r *FixedaddresstemplateResource) Delete(ctx context.Context, req resource.
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			FixedaddresstemplateAPI.
 			Delete(ctx, resourceRef).
@@ -296,7 +305,7 @@ func (r *FixedaddresstemplateResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *FixedaddresstemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data FixedaddresstemplateModel
+	var data FixedaddresstemplateResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
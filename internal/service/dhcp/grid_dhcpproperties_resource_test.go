@@ -0,0 +1,229 @@
+package dhcp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForGridDhcpproperties = "authority,bootfile,bootserver,capture_hostname,ddns_domainname,ddns_generate_hostname,ddns_retry_interval,ddns_server_always_updates,ddns_ttl,ddns_update_fixed_addresses,ddns_use_option81,deny_bootp,disable_all_nac_filters,dns_update_style,email_list,enable_ddns,enable_dhcp_thresholds,enable_email_warnings,enable_fingerprint,enable_gss_tsig,enable_hostname_rewrite,enable_leasequery,enable_roaming_hosts,enable_snmp_warnings,format_log_option_82,grid,gss_tsig_keys,high_water_mark,high_water_mark_reset,hostname_rewrite_policy,ignore_dhcp_option_list_request,ignore_id,ignore_mac_addresses,immediate_fa_configuration,ipv6_capture_hostname,ipv6_ddns_domainname,ipv6_ddns_enable_option_fqdn,ipv6_ddns_server_always_updates,ipv6_ddns_ttl,ipv6_default_prefix,ipv6_dns_update_style,ipv6_domain_name,ipv6_domain_name_servers,ipv6_enable_ddns,ipv6_enable_gss_tsig,ipv6_enable_lease_scavenging,ipv6_enable_retry_updates,ipv6_generate_hostname,ipv6_gss_tsig_keys,ipv6_kdc_server,ipv6_lease_scavenging_time,ipv6_microsoft_code_page,ipv6_options,ipv6_prefixes,ipv6_recycle_leases,ipv6_remember_expired_client_association,ipv6_retry_updates_interval,ipv6_txt_record_handling,ipv6_update_dns_on_lease_renewal,kdc_server,lease_logging_member,lease_per_client_settings,lease_scavenge_time,log_lease_events,logic_filter_rules,low_water_mark,low_water_mark_reset,microsoft_code_page,nextserver,option60_match_rules,options,ping_count,ping_timeout,preferred_lifetime,prefix_length_mode,protocol_hostname_rewrite_policies,pxe_lease_time,recycle_leases,restart_setting,retry_ddns_updates,syslog_facility,txt_record_handling,update_dns_on_lease_renewal,valid_lifetime"
+
+func TestAccGridDhcppropertiesResource_basic(t *testing.T) {
+	var resourceName = "nios_grid_dhcp_properties.test"
+	var v grid.GridDhcpproperties
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDhcppropertiesBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttrSet(resourceName, "authority"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridDhcppropertiesResource_Authority(t *testing.T) {
+	var resourceName = "nios_grid_dhcp_properties.test_authority"
+	var v grid.GridDhcpproperties
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDhcppropertiesAuthority(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authority", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridDhcppropertiesAuthority(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authority", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridDhcppropertiesResource_EmailList(t *testing.T) {
+	var resourceName = "nios_grid_dhcp_properties.test_email_list"
+	var v grid.GridDhcpproperties
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDhcppropertiesEmailList(`["admin@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "email_list.0", "admin@example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridDhcppropertiesEmailList(`["admin@example.com", "ops@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "email_list.0", "admin@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "email_list.1", "ops@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridDhcppropertiesResource_PingCount(t *testing.T) {
+	var resourceName = "nios_grid_dhcp_properties.test_ping_count"
+	var v grid.GridDhcpproperties
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDhcppropertiesPingCount(3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ping_count", "3"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridDhcppropertiesPingCount(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ping_count", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridDhcppropertiesResource_Options(t *testing.T) {
+	var resourceName = "nios_grid_dhcp_properties.test_options"
+	var v grid.GridDhcpproperties
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDhcppropertiesOptions("domain-name", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.name", "domain-name"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.value", "example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridDhcppropertiesOptions("domain-name", "example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.value", "example.org"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckGridDhcppropertiesExists(ctx context.Context, resourceName string, v *grid.GridDhcpproperties) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			GridDhcppropertiesAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForGridDhcpproperties).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetGridDhcppropertiesResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetGridDhcppropertiesResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccGridDhcppropertiesBasicConfig() string {
+	return `
+resource "nios_grid_dhcp_properties" "test" {
+}
+`
+}
+
+func testAccGridDhcppropertiesAuthority(authority bool) string {
+	return fmt.Sprintf(`
+resource "nios_grid_dhcp_properties" "test_authority" {
+  authority = %t
+}
+`, authority)
+}
+
+func testAccGridDhcppropertiesEmailList(emailList string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_dhcp_properties" "test_email_list" {
+  email_list = %s
+}
+`, emailList)
+}
+
+func testAccGridDhcppropertiesPingCount(pingCount int) string {
+	return fmt.Sprintf(`
+resource "nios_grid_dhcp_properties" "test_ping_count" {
+  ping_count = %d
+}
+`, pingCount)
+}
+
+func testAccGridDhcppropertiesOptions(name, value string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_dhcp_properties" "test_options" {
+  options = [
+    {
+      name       = %q
+      value      = %q
+      use_option = true
+    }
+  ]
+}
+`, name, value)
+}
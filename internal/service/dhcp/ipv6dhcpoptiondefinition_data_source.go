@@ -133,7 +133,7 @@ func (d *Ipv6dhcpoptiondefinitionDataSource) Read(ctx context.Context, req datas
 				ReturnFieldsPlus(readableAttributesForIpv6dhcpoptiondefinition).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +36,12 @@ type Ipv6dhcpoptiondefinitionResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6dhcpoptiondefinitionResourceModel describes the resource data model, extending Ipv6dhcpoptiondefinitionModel with the operation timeouts.
+type Ipv6dhcpoptiondefinitionResourceModel struct {
+	Ipv6dhcpoptiondefinitionModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6dhcpoptiondefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6optiondefinition"
 }
@@ -43,6 +50,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Schema(ctx context.Context, req resou
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an IPv6 DHCP Option Definition.",
 		Attributes:          Ipv6dhcpoptiondefinitionResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +75,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Configure(ctx context.Context, req re
 }
 
 func (r *Ipv6dhcpoptiondefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data Ipv6dhcpoptiondefinitionModel
+	var data Ipv6dhcpoptiondefinitionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -83,7 +91,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Create(ctx context.Context, req resou
 
 	var apiRes *dhcp.CreateIpv6dhcpoptiondefinitionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -124,7 +132,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Create(ctx context.Context, req resou
 }
 
 func (r *Ipv6dhcpoptiondefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data Ipv6dhcpoptiondefinitionModel
+	var data Ipv6dhcpoptiondefinitionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -140,14 +148,14 @@ func (r *Ipv6dhcpoptiondefinitionResource) Read(ctx context.Context, req resourc
 		apiRes  *dhcp.GetIpv6dhcpoptiondefinitionResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6dhcpoptiondefinitionAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6dhcpoptiondefinition).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -177,8 +185,8 @@ func (r *Ipv6dhcpoptiondefinitionResource) Read(ctx context.Context, req resourc
 
 func (r *Ipv6dhcpoptiondefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6dhcpoptiondefinitionModel
-	var stateData Ipv6dhcpoptiondefinitionModel
+	var data Ipv6dhcpoptiondefinitionResourceModel
+	var stateData Ipv6dhcpoptiondefinitionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -211,7 +219,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Update(ctx context.Context, req resou
 
 	var apiRes *dhcp.UpdateIpv6dhcpoptiondefinitionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -244,7 +252,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Update(ctx context.Context, req resou
 }
 
 func (r *Ipv6dhcpoptiondefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6dhcpoptiondefinitionModel
+	var data Ipv6dhcpoptiondefinitionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -255,7 +263,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Delete(ctx context.Context, req resou
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6dhcpoptiondefinitionAPI.
 			Delete(ctx, resourceRef).
@@ -278,7 +286,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) Delete(ctx context.Context, req resou
 
 func (r *Ipv6dhcpoptiondefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 
-	var data Ipv6dhcpoptiondefinitionModel
+	var data Ipv6dhcpoptiondefinitionResourceModel
 	// Read Terraform prior state data into the model.
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -325,7 +333,7 @@ func (r *Ipv6dhcpoptiondefinitionResource) ImportState(ctx context.Context, req
 
 // updateRefIfSpaceChanged updates the ref if the option space name changes by
 // finding the option definition with the new space name and updating the data model accordingly.
-func (r *Ipv6dhcpoptiondefinitionResource) updateRefIfSpaceChanged(ctx context.Context, resp *resource.UpdateResponse, data *Ipv6dhcpoptiondefinitionModel, stateData *Ipv6dhcpoptiondefinitionModel) {
+func (r *Ipv6dhcpoptiondefinitionResource) updateRefIfSpaceChanged(ctx context.Context, resp *resource.UpdateResponse, data *Ipv6dhcpoptiondefinitionResourceModel, stateData *Ipv6dhcpoptiondefinitionResourceModel) {
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -133,7 +133,7 @@ func (d *Ipv6dhcpoptionspaceDataSource) Read(ctx context.Context, req datasource
 				ReturnFieldsPlus(readableAttributesForIpv6dhcpoptionspace).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
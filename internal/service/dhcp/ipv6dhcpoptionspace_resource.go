@@ -10,10 +10,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
@@ -39,6 +41,12 @@ type Ipv6dhcpoptionspaceResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6dhcpoptionspaceResourceModel describes the resource data model, extending Ipv6dhcpoptionspaceModel with the operation timeouts.
+type Ipv6dhcpoptionspaceResourceModel struct {
+	Ipv6dhcpoptionspaceModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6dhcpoptionspaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6optionspace"
 }
@@ -47,6 +55,7 @@ func (r *Ipv6dhcpoptionspaceResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an IPv6 DHCP Option Space.",
 		Attributes:          Ipv6dhcpoptionspaceResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -71,7 +80,7 @@ func (r *Ipv6dhcpoptionspaceResource) Configure(ctx context.Context, req resourc
 }
 
 func (r *Ipv6dhcpoptionspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data Ipv6dhcpoptionspaceModel
+	var data Ipv6dhcpoptionspaceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -87,7 +96,7 @@ func (r *Ipv6dhcpoptionspaceResource) Create(ctx context.Context, req resource.C
 
 	var apiRes *dhcp.CreateIpv6dhcpoptionspaceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -128,7 +137,7 @@ func (r *Ipv6dhcpoptionspaceResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *Ipv6dhcpoptionspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data Ipv6dhcpoptionspaceModel
+	var data Ipv6dhcpoptionspaceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -144,14 +153,14 @@ func (r *Ipv6dhcpoptionspaceResource) Read(ctx context.Context, req resource.Rea
 		apiRes  *dhcp.GetIpv6dhcpoptionspaceResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6dhcpoptionspaceAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6dhcpoptionspace).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 		if httpRes != nil {
 			return httpRes.StatusCode, callErr
@@ -180,7 +189,7 @@ func (r *Ipv6dhcpoptionspaceResource) Read(ctx context.Context, req resource.Rea
 
 func (r *Ipv6dhcpoptionspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6dhcpoptionspaceModel
+	var data Ipv6dhcpoptionspaceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -202,7 +211,7 @@ func (r *Ipv6dhcpoptionspaceResource) Update(ctx context.Context, req resource.U
 
 	var apiRes *dhcp.UpdateIpv6dhcpoptionspaceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -236,7 +245,7 @@ func (r *Ipv6dhcpoptionspaceResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *Ipv6dhcpoptionspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6dhcpoptionspaceModel
+	var data Ipv6dhcpoptionspaceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -247,7 +256,7 @@ func (r *Ipv6dhcpoptionspaceResource) Delete(ctx context.Context, req resource.D
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.DoWithTimeout(ctx, Ipv6OptionSpaceOperationTimeout, IsOptionDefinitionReferenced, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, Ipv6OptionSpaceOperationTimeout), IsOptionDefinitionReferenced, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6dhcpoptionspaceAPI.
 			Delete(ctx, resourceRef).
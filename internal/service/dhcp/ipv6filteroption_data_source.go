@@ -140,7 +140,7 @@ func (d *Ipv6filteroptionDataSource) Read(ctx context.Context, req datasource.Re
 				ReturnFieldsPlus(readableAttributesForIpv6filteroption).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type Ipv6filteroptionResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6filteroptionResourceModel describes the resource data model, extending Ipv6filteroptionModel with the operation timeouts.
+type Ipv6filteroptionResourceModel struct {
+	Ipv6filteroptionModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6filteroptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6filteroption"
 }
@@ -41,6 +49,7 @@ func (r *Ipv6filteroptionResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an IPv6 DHCP Option Filter.",
 		Attributes:          Ipv6filteroptionResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *Ipv6filteroptionResource) Configure(ctx context.Context, req resource.C
 
 func (r *Ipv6filteroptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6filteroptionModel
+	var data Ipv6filteroptionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *Ipv6filteroptionResource) Create(ctx context.Context, req resource.Crea
 
 	var apiRes *dhcp.CreateIpv6filteroptionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *Ipv6filteroptionResource) Create(ctx context.Context, req resource.Crea
 
 func (r *Ipv6filteroptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6filteroptionModel
+	var data Ipv6filteroptionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *Ipv6filteroptionResource) Read(ctx context.Context, req resource.ReadRe
 		apiRes  *dhcp.GetIpv6filteroptionResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6filteroptionAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6filteroption).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *Ipv6filteroptionResource) Read(ctx context.Context, req resource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *Ipv6filteroptionResource) ReadByExtAttrs(ctx context.Context, data *Ipv6filteroptionModel, resp *resource.ReadResponse) bool {
+func (r *Ipv6filteroptionResource) ReadByExtAttrs(ctx context.Context, data *Ipv6filteroptionResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +258,7 @@ func (r *Ipv6filteroptionResource) ReadByExtAttrs(ctx context.Context, data *Ipv
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForIpv6filteroption).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Ipv6filteroption by extattrs, got error: %s", err))
@@ -281,7 +290,7 @@ func (r *Ipv6filteroptionResource) ReadByExtAttrs(ctx context.Context, data *Ipv
 
 func (r *Ipv6filteroptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6filteroptionModel
+	var data Ipv6filteroptionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -332,7 +341,7 @@ func (r *Ipv6filteroptionResource) Update(ctx context.Context, req resource.Upda
 
 	var apiRes *dhcp.UpdateIpv6filteroptionResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -375,7 +384,7 @@ func (r *Ipv6filteroptionResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *Ipv6filteroptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6filteroptionModel
+	var data Ipv6filteroptionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -386,7 +395,7 @@ func (r *Ipv6filteroptionResource) Delete(ctx context.Context, req resource.Dele
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6filteroptionAPI.
 			Delete(ctx, resourceRef).
@@ -139,7 +139,7 @@ func (d *Ipv6fixedaddressDataSource) Read(ctx context.Context, req datasource.Re
 				ReturnFieldsPlus(readableAttributesForIpv6fixedaddress).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -16,6 +16,7 @@ import (
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +36,12 @@ type Ipv6fixedaddressResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6fixedaddressResourceModel describes the resource data model, extending Ipv6fixedaddressModel with the operation timeouts.
+type Ipv6fixedaddressResourceModel struct {
+	Ipv6fixedaddressModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6fixedaddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6fixedaddress"
 }
@@ -43,6 +50,7 @@ func (r *Ipv6fixedaddressResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP IPV6 Fixed Address.",
 		Attributes:          Ipv6fixedaddressResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +76,7 @@ func (r *Ipv6fixedaddressResource) Configure(ctx context.Context, req resource.C
 
 func (r *Ipv6fixedaddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6fixedaddressModel
+	var data Ipv6fixedaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -96,7 +104,11 @@ func (r *Ipv6fixedaddressResource) Create(ctx context.Context, req resource.Crea
 
 	var apiRes *dhcp.CreateIpv6fixedaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network are serialized so that parallel resources get distinct addresses
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -148,7 +160,7 @@ func (r *Ipv6fixedaddressResource) Create(ctx context.Context, req resource.Crea
 
 func (r *Ipv6fixedaddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6fixedaddressModel
+	var data Ipv6fixedaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -170,14 +182,14 @@ func (r *Ipv6fixedaddressResource) Read(ctx context.Context, req resource.ReadRe
 		apiRes  *dhcp.GetIpv6fixedaddressResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6fixedaddressAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6fixedaddress).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -232,7 +244,7 @@ func (r *Ipv6fixedaddressResource) Read(ctx context.Context, req resource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *Ipv6fixedaddressResource) ReadByExtAttrs(ctx context.Context, data *Ipv6fixedaddressModel, resp *resource.ReadResponse) bool {
+func (r *Ipv6fixedaddressResource) ReadByExtAttrs(ctx context.Context, data *Ipv6fixedaddressResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -259,7 +271,7 @@ func (r *Ipv6fixedaddressResource) ReadByExtAttrs(ctx context.Context, data *Ipv
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForIpv6fixedaddress).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Ipv6fixedaddress by extattrs, got error: %s", err))
@@ -290,7 +302,7 @@ func (r *Ipv6fixedaddressResource) ReadByExtAttrs(ctx context.Context, data *Ipv
 
 func (r *Ipv6fixedaddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6fixedaddressModel
+	var data Ipv6fixedaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -340,7 +352,7 @@ func (r *Ipv6fixedaddressResource) Update(ctx context.Context, req resource.Upda
 
 	var apiRes *dhcp.UpdateIpv6fixedaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -382,7 +394,7 @@ func (r *Ipv6fixedaddressResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *Ipv6fixedaddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6fixedaddressModel
+	var data Ipv6fixedaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -393,7 +405,7 @@ func (r *Ipv6fixedaddressResource) Delete(ctx context.Context, req resource.Dele
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6fixedaddressAPI.
 			Delete(ctx, resourceRef).
@@ -414,7 +426,7 @@ func (r *Ipv6fixedaddressResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
-func (r *Ipv6fixedaddressResource) UpdateFuncCallAttributeName(ctx context.Context, data Ipv6fixedaddressModel, diags *diag.Diagnostics) types.Object {
+func (r *Ipv6fixedaddressResource) UpdateFuncCallAttributeName(ctx context.Context, data Ipv6fixedaddressResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -434,7 +446,7 @@ func (r *Ipv6fixedaddressResource) ImportState(ctx context.Context, req resource
 }
 
 func (r *Ipv6fixedaddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data Ipv6fixedaddressModel
+	var data Ipv6fixedaddressResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
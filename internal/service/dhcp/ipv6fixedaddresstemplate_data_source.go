@@ -140,7 +140,7 @@ func (d *Ipv6fixedaddresstemplateDataSource) Read(ctx context.Context, req datas
 				ReturnFieldsPlus(readableAttributesForIpv6fixedaddresstemplate).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type Ipv6fixedaddresstemplateResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6fixedaddresstemplateResourceModel describes the resource data model, extending Ipv6fixedaddresstemplateModel with the operation timeouts.
+type Ipv6fixedaddresstemplateResourceModel struct {
+	Ipv6fixedaddresstemplateModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6fixedaddresstemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6fixedaddresstemplate"
 }
@@ -43,6 +51,7 @@ func (r *Ipv6fixedaddresstemplateResource) Schema(ctx context.Context, req resou
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP IPv6 Fixed Address Template.",
 		Attributes:          Ipv6fixedaddresstemplateResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *Ipv6fixedaddresstemplateResource) Configure(ctx context.Context, req re
 
 func (r *Ipv6fixedaddresstemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6fixedaddresstemplateModel
+	var data Ipv6fixedaddresstemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -84,7 +93,7 @@ func (r *Ipv6fixedaddresstemplateResource) Create(ctx context.Context, req resou
 
 	var apiRes *dhcp.CreateIpv6fixedaddresstemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -131,7 +140,7 @@ func (r *Ipv6fixedaddresstemplateResource) Create(ctx context.Context, req resou
 
 func (r *Ipv6fixedaddresstemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6fixedaddresstemplateModel
+	var data Ipv6fixedaddresstemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -147,14 +156,14 @@ func (r *Ipv6fixedaddresstemplateResource) Read(ctx context.Context, req resourc
 		apiRes  *dhcp.GetIpv6fixedaddresstemplateResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6fixedaddresstemplateAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6fixedaddresstemplate).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -189,7 +198,7 @@ func (r *Ipv6fixedaddresstemplateResource) Read(ctx context.Context, req resourc
 
 func (r *Ipv6fixedaddresstemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6fixedaddresstemplateModel
+	var data Ipv6fixedaddresstemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -227,7 +236,7 @@ func (r *Ipv6fixedaddresstemplateResource) Update(ctx context.Context, req resou
 
 	var apiRes *dhcp.UpdateIpv6fixedaddresstemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -266,7 +275,7 @@ func (r *Ipv6fixedaddresstemplateResource) Update(ctx context.Context, req resou
 }
 
 func (r *Ipv6fixedaddresstemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6fixedaddresstemplateModel
+	var data Ipv6fixedaddresstemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -277,7 +286,7 @@ func (r *Ipv6fixedaddresstemplateResource) Delete(ctx context.Context, req resou
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6fixedaddresstemplateAPI.
 			Delete(ctx, resourceRef).
@@ -299,7 +308,7 @@ func (r *Ipv6fixedaddresstemplateResource) Delete(ctx context.Context, req resou
 }
 
 func (r *Ipv6fixedaddresstemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data Ipv6fixedaddresstemplateModel
+	var data Ipv6fixedaddresstemplateResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -140,7 +140,7 @@ func (d *Ipv6rangeDataSource) Read(ctx context.Context, req datasource.ReadReque
 				ReturnFieldsPlus(readableAttributesForIpv6range).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
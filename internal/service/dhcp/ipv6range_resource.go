@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type Ipv6rangeResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6rangeResourceModel describes the resource data model, extending Ipv6rangeModel with the operation timeouts.
+type Ipv6rangeResourceModel struct {
+	Ipv6rangeModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6rangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6range"
 }
@@ -42,6 +50,7 @@ func (r *Ipv6rangeResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an IPv6 Range.",
 		Attributes:          Ipv6rangeResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *Ipv6rangeResource) Configure(ctx context.Context, req resource.Configur
 
 func (r *Ipv6rangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6rangeModel
+	var data Ipv6rangeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *Ipv6rangeResource) Create(ctx context.Context, req resource.CreateReque
 
 	var apiRes *dhcp.CreateIpv6rangeResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -136,7 +145,7 @@ func (r *Ipv6rangeResource) Create(ctx context.Context, req resource.CreateReque
 
 func (r *Ipv6rangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6rangeModel
+	var data Ipv6rangeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -158,14 +167,14 @@ func (r *Ipv6rangeResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *dhcp.GetIpv6rangeResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6rangeAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6range).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -220,7 +229,7 @@ func (r *Ipv6rangeResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *Ipv6rangeResource) ReadByExtAttrs(ctx context.Context, data *Ipv6rangeModel, resp *resource.ReadResponse) bool {
+func (r *Ipv6rangeResource) ReadByExtAttrs(ctx context.Context, data *Ipv6rangeResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -247,7 +256,7 @@ func (r *Ipv6rangeResource) ReadByExtAttrs(ctx context.Context, data *Ipv6rangeM
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForIpv6range).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Ipv6range by extattrs, got error: %s", err))
@@ -278,7 +287,7 @@ func (r *Ipv6rangeResource) ReadByExtAttrs(ctx context.Context, data *Ipv6rangeM
 
 func (r *Ipv6rangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6rangeModel
+	var data Ipv6rangeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -328,7 +337,7 @@ func (r *Ipv6rangeResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var apiRes *dhcp.UpdateIpv6rangeResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -370,7 +379,7 @@ func (r *Ipv6rangeResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *Ipv6rangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6rangeModel
+	var data Ipv6rangeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -381,7 +390,7 @@ func (r *Ipv6rangeResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6rangeAPI.
 			Delete(ctx, resourceRef).
@@ -403,7 +412,7 @@ func (r *Ipv6rangeResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *Ipv6rangeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data Ipv6rangeModel
+	var data Ipv6rangeResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -132,7 +132,7 @@ func (d *Ipv6rangetemplateDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForIpv6rangetemplate).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
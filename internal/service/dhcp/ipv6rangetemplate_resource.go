@@ -9,11 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -32,6 +34,12 @@ type Ipv6rangetemplateResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6rangetemplateResourceModel describes the resource data model, extending Ipv6rangetemplateModel with the operation timeouts.
+type Ipv6rangetemplateResourceModel struct {
+	Ipv6rangetemplateModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6rangetemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6_range_template"
 }
@@ -40,6 +48,7 @@ func (r *Ipv6rangetemplateResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an IPV6 Range Template.",
 		Attributes:          Ipv6rangetemplateResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -64,7 +73,7 @@ func (r *Ipv6rangetemplateResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *Ipv6rangetemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var config Ipv6rangetemplateModel
+	var config Ipv6rangetemplateResourceModel
 
 	// Parse the configuration
 	diags := req.Config.Get(ctx, &config)
@@ -110,7 +119,7 @@ func (r *Ipv6rangetemplateResource) ValidateConfig(ctx context.Context, req reso
 }
 
 func (r *Ipv6rangetemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data Ipv6rangetemplateModel
+	var data Ipv6rangetemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -126,7 +135,7 @@ func (r *Ipv6rangetemplateResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *dhcp.CreateIpv6rangetemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -167,7 +176,7 @@ func (r *Ipv6rangetemplateResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *Ipv6rangetemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data Ipv6rangetemplateModel
+	var data Ipv6rangetemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -183,14 +192,14 @@ func (r *Ipv6rangetemplateResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *dhcp.GetIpv6rangetemplateResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6rangetemplateAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6rangetemplate).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -220,7 +229,7 @@ func (r *Ipv6rangetemplateResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *Ipv6rangetemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6rangetemplateModel
+	var data Ipv6rangetemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -244,7 +253,7 @@ func (r *Ipv6rangetemplateResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *dhcp.UpdateIpv6rangetemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -277,7 +286,7 @@ func (r *Ipv6rangetemplateResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *Ipv6rangetemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6rangetemplateModel
+	var data Ipv6rangetemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -288,7 +297,7 @@ func (r *Ipv6rangetemplateResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6rangetemplateAPI.
 			Delete(ctx, resourceRef).
@@ -140,7 +140,7 @@ func (d *Ipv6sharednetworkDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForIpv6sharednetwork).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type Ipv6sharednetworkResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6sharednetworkResourceModel describes the resource data model, extending Ipv6sharednetworkModel with the operation timeouts.
+type Ipv6sharednetworkResourceModel struct {
+	Ipv6sharednetworkModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6sharednetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_ipv6sharednetwork"
 }
@@ -43,6 +51,7 @@ func (r *Ipv6sharednetworkResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an IPv6 Shared Network.",
 		Attributes:          Ipv6sharednetworkResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *Ipv6sharednetworkResource) Configure(ctx context.Context, req resource.
 
 func (r *Ipv6sharednetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6sharednetworkModel
+	var data Ipv6sharednetworkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -90,7 +99,7 @@ func (r *Ipv6sharednetworkResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *dhcp.CreateIpv6sharednetworkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *Ipv6sharednetworkResource) Create(ctx context.Context, req resource.Cre
 
 func (r *Ipv6sharednetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6sharednetworkModel
+	var data Ipv6sharednetworkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *Ipv6sharednetworkResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *dhcp.GetIpv6sharednetworkResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			Ipv6sharednetworkAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6sharednetwork).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -221,7 +230,7 @@ func (r *Ipv6sharednetworkResource) Read(ctx context.Context, req resource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *Ipv6sharednetworkResource) ReadByExtAttrs(ctx context.Context, data *Ipv6sharednetworkModel, resp *resource.ReadResponse) bool {
+func (r *Ipv6sharednetworkResource) ReadByExtAttrs(ctx context.Context, data *Ipv6sharednetworkResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -248,7 +257,7 @@ func (r *Ipv6sharednetworkResource) ReadByExtAttrs(ctx context.Context, data *Ip
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForIpv6sharednetwork).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Ipv6sharednetwork by extattrs, got error: %s", err))
@@ -279,7 +288,7 @@ func (r *Ipv6sharednetworkResource) ReadByExtAttrs(ctx context.Context, data *Ip
 
 func (r *Ipv6sharednetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6sharednetworkModel
+	var data Ipv6sharednetworkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -329,7 +338,7 @@ func (r *Ipv6sharednetworkResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *dhcp.UpdateIpv6sharednetworkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -371,7 +380,7 @@ func (r *Ipv6sharednetworkResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *Ipv6sharednetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6sharednetworkModel
+	var data Ipv6sharednetworkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -382,7 +391,7 @@ func (r *Ipv6sharednetworkResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			Ipv6sharednetworkAPI.
 			Delete(ctx, resourceRef).
@@ -404,7 +413,7 @@ func (r *Ipv6sharednetworkResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *Ipv6sharednetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data Ipv6sharednetworkModel
+	var data Ipv6sharednetworkResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,47 @@
+package dhcp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// DHCP Lease: an active IPv4 lease in the default network view
+
+func TestAccLeaseDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dhcp_lease.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLeaseDataSourceConfigFilters("default", "IPV4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.address"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network_view", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.protocol", "IPV4"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccLeaseDataSourceConfigFilters(networkView, protocol string) string {
+	return fmt.Sprintf(`
+data "nios_dhcp_lease" "test" {
+  filters = {
+    network_view = %q
+    protocol     = %q
+  }
+  max_results = 1
+}
+`, networkView, protocol)
+}
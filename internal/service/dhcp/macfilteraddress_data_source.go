@@ -139,7 +139,7 @@ func (d *MacfilteraddressDataSource) Read(ctx context.Context, req datasource.Re
 				ReturnFieldsPlus(readableAttributesForMacfilteraddress).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type MacfilteraddressResource struct {
 	client *niosclient.APIClient
 }
 
+// MacfilteraddressResourceModel describes the resource data model, extending MacfilteraddressModel with the operation timeouts.
+type MacfilteraddressResourceModel struct {
+	MacfilteraddressModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *MacfilteraddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_macfilteraddress"
 }
@@ -42,6 +50,7 @@ func (r *MacfilteraddressResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP MAC Filter Address.",
 		Attributes:          MacfilteraddressResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *MacfilteraddressResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *MacfilteraddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data MacfilteraddressModel
+	var data MacfilteraddressResourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *MacfilteraddressResource) ValidateConfig(ctx context.Context, req resou
 
 func (r *MacfilteraddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data MacfilteraddressModel
+	var data MacfilteraddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -110,7 +119,7 @@ func (r *MacfilteraddressResource) Create(ctx context.Context, req resource.Crea
 
 	var apiRes *dhcp.CreateMacfilteraddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -157,7 +166,7 @@ func (r *MacfilteraddressResource) Create(ctx context.Context, req resource.Crea
 
 func (r *MacfilteraddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data MacfilteraddressModel
+	var data MacfilteraddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -179,14 +188,14 @@ func (r *MacfilteraddressResource) Read(ctx context.Context, req resource.ReadRe
 		apiRes  *dhcp.GetMacfilteraddressResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			MacfilteraddressAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForMacfilteraddress).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -241,7 +250,7 @@ func (r *MacfilteraddressResource) Read(ctx context.Context, req resource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *MacfilteraddressResource) ReadByExtAttrs(ctx context.Context, data *MacfilteraddressModel, resp *resource.ReadResponse) bool {
+func (r *MacfilteraddressResource) ReadByExtAttrs(ctx context.Context, data *MacfilteraddressResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -268,7 +277,7 @@ func (r *MacfilteraddressResource) ReadByExtAttrs(ctx context.Context, data *Mac
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForMacfilteraddress).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Macfilteraddress by extattrs, got error: %s", err))
@@ -299,7 +308,7 @@ func (r *MacfilteraddressResource) ReadByExtAttrs(ctx context.Context, data *Mac
 
 func (r *MacfilteraddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data MacfilteraddressModel
+	var data MacfilteraddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -349,7 +358,7 @@ func (r *MacfilteraddressResource) Update(ctx context.Context, req resource.Upda
 
 	var apiRes *dhcp.UpdateMacfilteraddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -391,7 +400,7 @@ func (r *MacfilteraddressResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *MacfilteraddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data MacfilteraddressModel
+	var data MacfilteraddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -402,7 +411,7 @@ func (r *MacfilteraddressResource) Delete(ctx context.Context, req resource.Dele
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			MacfilteraddressAPI.
 			Delete(ctx, resourceRef).
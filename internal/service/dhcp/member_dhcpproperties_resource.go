@@ -0,0 +1,380 @@
+package dhcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMemberDhcpproperties = "auth_server_group,authn_captive_portal,authn_captive_portal_authenticated_filter,authn_captive_portal_enabled,authn_captive_portal_guest_filter,authn_server_group_enabled,authority,bootfile,bootserver,ddns_domainname,ddns_generate_hostname,ddns_retry_interval,ddns_server_always_updates,ddns_ttl,ddns_update_fixed_addresses,ddns_use_option81,ddns_zone_primaries,deny_bootp,dhcp_utilization,dhcp_utilization_status,dns_update_style,dynamic_hosts,email_list,enable_ddns,enable_dhcp,enable_dhcp_on_ipv6_lan2,enable_dhcp_on_lan2,enable_dhcp_thresholds,enable_dhcpv6_service,enable_email_warnings,enable_fingerprint,enable_gss_tsig,enable_hostname_rewrite,enable_leasequery,enable_snmp_warnings,gss_tsig_keys,high_water_mark,high_water_mark_reset,host_name,hostname_rewrite_policy,ignore_dhcp_option_list_request,ignore_id,ignore_mac_addresses,immediate_fa_configuration,ipv4addr,ipv6_ddns_domainname,ipv6_ddns_enable_option_fqdn,ipv6_ddns_hostname,ipv6_ddns_server_always_updates,ipv6_ddns_ttl,ipv6_dns_update_style,ipv6_domain_name,ipv6_domain_name_servers,ipv6_enable_ddns,ipv6_enable_gss_tsig,ipv6_enable_lease_scavenging,ipv6_enable_retry_updates,ipv6_generate_hostname,ipv6_gss_tsig_keys,ipv6_kdc_server,ipv6_lease_scavenging_time,ipv6_microsoft_code_page,ipv6_options,ipv6_recycle_leases,ipv6_remember_expired_client_association,ipv6_retry_updates_interval,ipv6_server_duid,ipv6_update_dns_on_lease_renewal,ipv6addr,kdc_server,lease_per_client_settings,lease_scavenge_time,log_lease_events,logic_filter_rules,low_water_mark,low_water_mark_reset,microsoft_code_page,nextserver,option60_match_rules,options,ping_count,ping_timeout,preferred_lifetime,prefix_length_mode,pxe_lease_time,recycle_leases,retry_ddns_updates,static_hosts,syslog_facility,total_hosts,update_dns_on_lease_renewal,use_authority,use_bootfile,use_bootserver,use_ddns_domainname,use_ddns_generate_hostname,use_ddns_ttl,use_ddns_update_fixed_addresses,use_ddns_use_option81,use_deny_bootp,use_dns_update_style,use_email_list,use_enable_ddns,use_enable_dhcp_thresholds,use_enable_fingerprint,use_enable_gss_tsig,use_enable_hostname_rewrite,use_enable_leasequery,use_enable_one_lease_per_client,use_gss_tsig_keys,use_ignore_dhcp_option_list_request,use_ignore_id,use_immediate_fa_configuration,use_ipv6_ddns_domainname,use_ipv6_ddns_enable_option_fqdn,use_ipv6_ddns_hostname,use_ipv6_ddns_ttl,use_ipv6_dns_update_style,use_ipv6_domain_name,use_ipv6_domain_name_servers,use_ipv6_enable_ddns,use_ipv6_enable_gss_tsig,use_ipv6_enable_retry_updates,use_ipv6_generate_hostname,use_ipv6_gss_tsig_keys,use_ipv6_lease_scavenging,use_ipv6_microsoft_code_page,use_ipv6_options,use_ipv6_recycle_leases,use_ipv6_update_dns_on_lease_renewal,use_lease_per_client_settings,use_lease_scavenge_time,use_log_lease_events,use_logic_filter_rules,use_microsoft_code_page,use_nextserver,use_options,use_ping_count,use_ping_timeout,use_preferred_lifetime,use_prefix_length_mode,use_pxe_lease_time,use_recycle_leases,use_retry_ddns_updates,use_syslog_facility,use_update_dns_on_lease_renewal,use_valid_lifetime,valid_lifetime"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MemberDhcppropertiesResource{}
+var _ resource.ResourceWithImportState = &MemberDhcppropertiesResource{}
+var _ resource.ResourceWithValidateConfig = &MemberDhcppropertiesResource{}
+
+func NewMemberDhcppropertiesResource() resource.Resource {
+	return &MemberDhcppropertiesResource{}
+}
+
+// MemberDhcppropertiesResource defines the resource implementation.
+type MemberDhcppropertiesResource struct {
+	client *niosclient.APIClient
+}
+
+// MemberDhcppropertiesResourceModel describes the resource data model, extending MemberDhcppropertiesModel with the operation timeouts.
+type MemberDhcppropertiesResourceModel struct {
+	MemberDhcppropertiesModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *MemberDhcppropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "grid_member_dhcp"
+}
+
+func (r *MemberDhcppropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DHCP properties of a Grid member.",
+		Attributes:          MemberDhcppropertiesResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *MemberDhcppropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MemberDhcppropertiesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MemberDhcppropertiesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DdnsServerAlwaysUpdates.IsNull() && !data.DdnsServerAlwaysUpdates.IsUnknown() {
+		// Check if ddns_use_option81 is set to false
+		if data.DdnsUseOption81.IsNull() || data.DdnsUseOption81.IsUnknown() || !data.DdnsUseOption81.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ddns_server_always_updates"),
+				"Invalid Configuration",
+				"ddns_use_option81 must be set to true if ddns_server_always_updates is configured.",
+			)
+		}
+	}
+
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		// Special DHCP option names that require use_option to be set
+		specialOptions := map[string]bool{
+			"routers":                  true,
+			"router-templates":         true,
+			"domain-name-servers":      true,
+			"domain-name":              true,
+			"broadcast-address":        true,
+			"broadcast-address-offset": true,
+			"dhcp-lease-time":          true,
+			"dhcp6.name-servers":       true,
+		}
+
+		specialOptionsNum := map[int64]bool{
+			3:  true,
+			6:  true,
+			15: true,
+			28: true,
+			51: true,
+			23: true,
+		}
+
+		var options []MemberDhcppropertiesOptionsModel
+		diags := data.Options.ElementsAs(ctx, &options, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i, option := range options {
+			isSpecialOption := false
+			optionName := ""
+			if option.Value.IsNull() || option.Value.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("options").AtListIndex(i).AtName("value"),
+					"Invalid configuration for DHCP Option",
+					"The 'value' attribute is a required field and must be set for all DHCP Options.",
+				)
+			}
+			if !option.Name.IsNull() && !option.Name.IsUnknown() {
+				optionName = option.Name.ValueString()
+				isSpecialOption = specialOptions[optionName]
+			} else if !option.Num.IsNull() && !option.Num.IsUnknown() {
+				optionNum := option.Num.ValueInt64()
+				isSpecialOption = specialOptionsNum[optionNum]
+				optionName = fmt.Sprintf("with num = %d", optionNum)
+			} else {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("options").AtListIndex(i).AtName("name"),
+					"Invalid configuration for DHCP Option",
+					"Either the 'name' or 'num' attribute must be set for all DHCP Options. "+
+						"Missing both attributes for 'option' at index "+fmt.Sprint(i)+".",
+				)
+				continue
+			}
+
+			if option.Value.ValueString() == "" {
+				if !isSpecialOption {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("options").AtListIndex(i).AtName("value"),
+						"Invalid configuration for DHCP Option",
+						"The 'value' attribute cannot be set as empty for Custom DHCP Option '"+optionName+"'.",
+					)
+				} else if !option.UseOption.IsUnknown() && !option.UseOption.IsNull() && !option.UseOption.ValueBool() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("options").AtListIndex(i).AtName("value"),
+						"Invalid configuration for DHCP Option",
+						"The 'value' attribute cannot be set as empty for Special DHCP Option '"+optionName+"' when 'use_option' is set to false.",
+					)
+				}
+			}
+
+			if !isSpecialOption && !option.UseOption.IsNull() && !option.UseOption.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("options").AtListIndex(i).AtName("use_option"),
+					"Invalid configuration",
+					fmt.Sprintf("The 'use_option' attribute should not be set for Custom DHCP Option '%s'. "+
+						"It is only applicable for Special Options: routers, router-templates, domain-name-servers, "+
+						"domain-name, broadcast-address, broadcast-address-offset, dhcp-lease-time, dhcp6.name-servers.",
+						optionName),
+				)
+			}
+		}
+	}
+}
+
+func (r *MemberDhcppropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MemberDhcppropertiesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listRes, _, err := r.client.GridAPI.
+		MemberDhcppropertiesAPI.
+		List(ctx).
+		Filters(map[string]interface{}{
+			"host_name": data.HostName.ValueString(),
+		}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForMemberDhcpproperties).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list MemberDhcpproperties, got error: %s", err))
+		return
+	}
+
+	list := listRes.ListMemberDhcppropertiesResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No DHCP properties exist for the member %s", data.HostName.ValueString()))
+		return
+	}
+
+	// Extract the singleton ref
+	listObj := list[0]
+
+	// Update it with desired plan
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *grid.UpdateMemberDhcppropertiesResponse
+
+	err = retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			MemberDhcppropertiesAPI.
+			Update(ctx, utils.ExtractResourceRef(listObj.GetRef())).
+			MemberDhcpproperties(*payload).
+			ReturnFieldsPlus(readableAttributesForMemberDhcpproperties).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if retry.IsAlreadyExistsErr(err) {
+			// Resource already exists, import required
+			resp.Diagnostics.AddError(
+				"Resource Already Exists",
+				fmt.Sprintf("Resource already exists, error: %s.\nPlease import the existing resource into terraform state.", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MemberDhcpproperties, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMemberDhcppropertiesResponseAsObject.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberDhcppropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MemberDhcppropertiesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *grid.GetMemberDhcppropertiesResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			MemberDhcppropertiesAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForMemberDhcpproperties).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MemberDhcpproperties, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetMemberDhcppropertiesResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberDhcppropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data MemberDhcppropertiesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var apiRes *grid.UpdateMemberDhcppropertiesResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			MemberDhcppropertiesAPI.
+			Update(ctx, resourceRef).
+			MemberDhcpproperties(*payload).
+			ReturnFieldsPlus(readableAttributesForMemberDhcpproperties).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MemberDhcpproperties, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMemberDhcppropertiesResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberDhcppropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The member DHCP properties cannot be deleted, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *MemberDhcppropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,247 @@
+package dhcp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Grid Members: the Grid Master
+
+var readableAttributesForMemberDhcpproperties = "auth_server_group,authn_captive_portal,authn_captive_portal_authenticated_filter,authn_captive_portal_enabled,authn_captive_portal_guest_filter,authn_server_group_enabled,authority,bootfile,bootserver,ddns_domainname,ddns_generate_hostname,ddns_retry_interval,ddns_server_always_updates,ddns_ttl,ddns_update_fixed_addresses,ddns_use_option81,ddns_zone_primaries,deny_bootp,dhcp_utilization,dhcp_utilization_status,dns_update_style,dynamic_hosts,email_list,enable_ddns,enable_dhcp,enable_dhcp_on_ipv6_lan2,enable_dhcp_on_lan2,enable_dhcp_thresholds,enable_dhcpv6_service,enable_email_warnings,enable_fingerprint,enable_gss_tsig,enable_hostname_rewrite,enable_leasequery,enable_snmp_warnings,gss_tsig_keys,high_water_mark,high_water_mark_reset,host_name,hostname_rewrite_policy,ignore_dhcp_option_list_request,ignore_id,ignore_mac_addresses,immediate_fa_configuration,ipv4addr,ipv6_ddns_domainname,ipv6_ddns_enable_option_fqdn,ipv6_ddns_hostname,ipv6_ddns_server_always_updates,ipv6_ddns_ttl,ipv6_dns_update_style,ipv6_domain_name,ipv6_domain_name_servers,ipv6_enable_ddns,ipv6_enable_gss_tsig,ipv6_enable_lease_scavenging,ipv6_enable_retry_updates,ipv6_generate_hostname,ipv6_gss_tsig_keys,ipv6_kdc_server,ipv6_lease_scavenging_time,ipv6_microsoft_code_page,ipv6_options,ipv6_recycle_leases,ipv6_remember_expired_client_association,ipv6_retry_updates_interval,ipv6_server_duid,ipv6_update_dns_on_lease_renewal,ipv6addr,kdc_server,lease_per_client_settings,lease_scavenge_time,log_lease_events,logic_filter_rules,low_water_mark,low_water_mark_reset,microsoft_code_page,nextserver,option60_match_rules,options,ping_count,ping_timeout,preferred_lifetime,prefix_length_mode,pxe_lease_time,recycle_leases,retry_ddns_updates,static_hosts,syslog_facility,total_hosts,update_dns_on_lease_renewal,use_authority,use_bootfile,use_bootserver,use_ddns_domainname,use_ddns_generate_hostname,use_ddns_ttl,use_ddns_update_fixed_addresses,use_ddns_use_option81,use_deny_bootp,use_dns_update_style,use_email_list,use_enable_ddns,use_enable_dhcp_thresholds,use_enable_fingerprint,use_enable_gss_tsig,use_enable_hostname_rewrite,use_enable_leasequery,use_enable_one_lease_per_client,use_gss_tsig_keys,use_ignore_dhcp_option_list_request,use_ignore_id,use_immediate_fa_configuration,use_ipv6_ddns_domainname,use_ipv6_ddns_enable_option_fqdn,use_ipv6_ddns_hostname,use_ipv6_ddns_ttl,use_ipv6_dns_update_style,use_ipv6_domain_name,use_ipv6_domain_name_servers,use_ipv6_enable_ddns,use_ipv6_enable_gss_tsig,use_ipv6_enable_retry_updates,use_ipv6_generate_hostname,use_ipv6_gss_tsig_keys,use_ipv6_lease_scavenging,use_ipv6_microsoft_code_page,use_ipv6_options,use_ipv6_recycle_leases,use_ipv6_update_dns_on_lease_renewal,use_lease_per_client_settings,use_lease_scavenge_time,use_log_lease_events,use_logic_filter_rules,use_microsoft_code_page,use_nextserver,use_options,use_ping_count,use_ping_timeout,use_preferred_lifetime,use_prefix_length_mode,use_pxe_lease_time,use_recycle_leases,use_retry_ddns_updates,use_syslog_facility,use_update_dns_on_lease_renewal,use_valid_lifetime,valid_lifetime"
+
+func TestAccMemberDhcppropertiesResource_basic(t *testing.T) {
+	var resourceName = "nios_grid_member_dhcp.test"
+	var v grid.MemberDhcpproperties
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDhcppropertiesBasicConfig(hostName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "host_name", hostName),
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttrSet(resourceName, "authority"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberDhcppropertiesResource_Authority(t *testing.T) {
+	var resourceName = "nios_grid_member_dhcp.test_authority"
+	var v grid.MemberDhcpproperties
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDhcppropertiesAuthority(hostName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authority", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberDhcppropertiesAuthority(hostName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "authority", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberDhcppropertiesResource_EmailList(t *testing.T) {
+	var resourceName = "nios_grid_member_dhcp.test_email_list"
+	var v grid.MemberDhcpproperties
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDhcppropertiesEmailList(hostName, `["admin@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "email_list.0", "admin@example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberDhcppropertiesEmailList(hostName, `["admin@example.com", "ops@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "email_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "email_list.0", "admin@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "email_list.1", "ops@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberDhcppropertiesResource_PingCount(t *testing.T) {
+	var resourceName = "nios_grid_member_dhcp.test_ping_count"
+	var v grid.MemberDhcpproperties
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDhcppropertiesPingCount(hostName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ping_count", "3"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberDhcppropertiesPingCount(hostName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ping_count", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberDhcppropertiesResource_Options(t *testing.T) {
+	var resourceName = "nios_grid_member_dhcp.test_options"
+	var v grid.MemberDhcpproperties
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDhcppropertiesOptions(hostName, "domain-name", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.name", "domain-name"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.value", "example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberDhcppropertiesOptions(hostName, "domain-name", "example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDhcppropertiesExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "options.0.value", "example.org"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckMemberDhcppropertiesExists(ctx context.Context, resourceName string, v *grid.MemberDhcpproperties) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			MemberDhcppropertiesAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForMemberDhcpproperties).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetMemberDhcppropertiesResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetMemberDhcppropertiesResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccMemberDhcppropertiesBasicConfig(hostName string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dhcp" "test" {
+  host_name = %q
+}
+`, hostName)
+}
+
+func testAccMemberDhcppropertiesAuthority(hostName string, authority bool) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dhcp" "test_authority" {
+  host_name     = %q
+  authority     = %t
+  use_authority = true
+}
+`, hostName, authority)
+}
+
+func testAccMemberDhcppropertiesEmailList(hostName string, emailList string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dhcp" "test_email_list" {
+  host_name      = %q
+  email_list     = %s
+  use_email_list = true
+}
+`, hostName, emailList)
+}
+
+func testAccMemberDhcppropertiesPingCount(hostName string, pingCount int) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dhcp" "test_ping_count" {
+  host_name      = %q
+  ping_count     = %d
+  use_ping_count = true
+}
+`, hostName, pingCount)
+}
+
+func testAccMemberDhcppropertiesOptions(hostName, name, value string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dhcp" "test_options" {
+  host_name = %q
+  options = [
+    {
+      name       = %q
+      value      = %q
+      use_option = true
+    }
+  ]
+  use_options = true
+}
+`, hostName, name, value)
+}
@@ -0,0 +1,85 @@
+package dhcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type DhcpStatisticsModel struct {
+	Ref                   types.String `tfsdk:"ref"`
+	DhcpUtilization       types.Int64  `tfsdk:"dhcp_utilization"`
+	DhcpUtilizationStatus types.String `tfsdk:"dhcp_utilization_status"`
+	DynamicHosts          types.Int64  `tfsdk:"dynamic_hosts"`
+	StaticHosts           types.Int64  `tfsdk:"static_hosts"`
+	TotalHosts            types.Int64  `tfsdk:"total_hosts"`
+}
+
+var DhcpStatisticsAttrTypes = map[string]attr.Type{
+	"ref":                     types.StringType,
+	"dhcp_utilization":        types.Int64Type,
+	"dhcp_utilization_status": types.StringType,
+	"dynamic_hosts":           types.Int64Type,
+	"static_hosts":            types.Int64Type,
+	"total_hosts":             types.Int64Type,
+}
+
+var DhcpStatisticsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"dhcp_utilization": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The percentage of the total DHCP utilization of DHCP objects multiplied by 1000. This is the percentage of the total number of available IP addresses belonging to the object versus the total number of all IP addresses in object.",
+	},
+	"dhcp_utilization_status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "A string describing the utilization level of the DHCP object.",
+	},
+	"dynamic_hosts": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The total number of DHCP leases issued for the DHCP object.",
+	},
+	"static_hosts": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of static DHCP addresses configured in the DHCP object.",
+	},
+	"total_hosts": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The total number of DHCP addresses configured in the DHCP object.",
+	},
+}
+
+func FlattenDhcpStatistics(ctx context.Context, from *dhcp.DhcpStatistics, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DhcpStatisticsAttrTypes)
+	}
+	m := DhcpStatisticsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DhcpStatisticsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DhcpStatisticsModel) Flatten(ctx context.Context, from *dhcp.DhcpStatistics, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DhcpStatisticsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.DhcpUtilization = flex.FlattenInt64Pointer(from.DhcpUtilization)
+	m.DhcpUtilizationStatus = flex.FlattenStringPointer(from.DhcpUtilizationStatus)
+	m.DynamicHosts = flex.FlattenInt64Pointer(from.DynamicHosts)
+	m.StaticHosts = flex.FlattenInt64Pointer(from.StaticHosts)
+	m.TotalHosts = flex.FlattenInt64Pointer(from.TotalHosts)
+}
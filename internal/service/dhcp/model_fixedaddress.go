@@ -237,6 +237,7 @@ var FixedaddressResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          FixedaddressCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -644,6 +645,7 @@ func (m *FixedaddressModel) Expand(ctx context.Context, diags *diag.Diagnostics,
 		Bootserver:                     flex.ExpandStringPointer(m.Bootserver),
 		CliCredentials:                 flex.ExpandFrameworkListNestedBlock(ctx, m.CliCredentials, diags, ExpandFixedaddressCliCredentials),
 		ClientIdentifierPrependZero:    flex.ExpandBoolPointer(m.ClientIdentifierPrependZero),
+		CloudInfo:                      ExpandFixedaddressCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:                        flex.ExpandStringPointer(m.Comment),
 		DdnsDomainname:                 flex.ExpandStringPointer(m.DdnsDomainname),
 		DdnsHostname:                   flex.ExpandStringPointer(m.DdnsHostname),
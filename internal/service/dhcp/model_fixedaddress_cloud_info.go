@@ -41,6 +41,7 @@ var FixedaddressCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          FixedaddresscloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *FixedaddressCloudInfoModel) Expand(ctx context.Context, diags *diag.Dia
 	if m == nil {
 		return nil
 	}
-	to := &dhcp.FixedaddressCloudInfo{}
+	to := &dhcp.FixedaddressCloudInfo{
+		DelegatedMember: ExpandFixedaddresscloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
@@ -26,24 +27,43 @@ var FixedaddresscloudinfoDelegatedMemberAttrTypes = map[string]attr.Type{
 
 var FixedaddresscloudinfoDelegatedMemberResourceSchemaAttributes = map[string]schema.Attribute{
 	"ipv4addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv4 Address of the Grid Member.",
 	},
 	"ipv6addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv6 Address of the Grid Member.",
 	},
 	"name": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The Grid member name",
 	},
 }
 
+func ExpandFixedaddresscloudinfoDelegatedMember(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dhcp.FixedaddresscloudinfoDelegatedMember {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m FixedaddresscloudinfoDelegatedMemberModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
 func (m *FixedaddresscloudinfoDelegatedMemberModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dhcp.FixedaddresscloudinfoDelegatedMember {
 	if m == nil {
 		return nil
 	}
-	to := &dhcp.FixedaddresscloudinfoDelegatedMember{}
+	to := &dhcp.FixedaddresscloudinfoDelegatedMember{
+		Ipv4addr: flex.ExpandStringPointer(m.Ipv4addr),
+		Ipv6addr: flex.ExpandStringPointer(m.Ipv6addr),
+		Name:     flex.ExpandStringPointer(m.Name),
+	}
 	return to
 }
 
@@ -170,6 +170,7 @@ var Ipv6fixedaddressResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          Ipv6fixedaddressCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -37,8 +37,10 @@ var Ipv6fixedaddressCloudInfoAttrTypes = map[string]attr.Type{
 
 var Ipv6fixedaddressCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 	"delegated_member": schema.SingleNestedAttribute{
-		Attributes: Ipv6fixedaddresscloudinfoDelegatedMemberResourceSchemaAttributes,
-		Computed:   true,
+		Attributes:          Ipv6fixedaddresscloudinfoDelegatedMemberResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -86,7 +88,9 @@ func (m *Ipv6fixedaddressCloudInfoModel) Expand(ctx context.Context, diags *diag
 	if m == nil {
 		return nil
 	}
-	to := &dhcp.Ipv6fixedaddressCloudInfo{}
+	to := &dhcp.Ipv6fixedaddressCloudInfo{
+		DelegatedMember: ExpandIpv6fixedaddresscloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -27,14 +27,17 @@ var Ipv6fixedaddresscloudinfoDelegatedMemberAttrTypes = map[string]attr.Type{
 
 var Ipv6fixedaddresscloudinfoDelegatedMemberResourceSchemaAttributes = map[string]schema.Attribute{
 	"ipv4addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv4 Address of the Grid Member.",
 	},
 	"ipv6addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv6 Address of the Grid Member.",
 	},
 	"name": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The Grid member name",
 	},
@@ -56,7 +59,11 @@ func (m *Ipv6fixedaddresscloudinfoDelegatedMemberModel) Expand(ctx context.Conte
 	if m == nil {
 		return nil
 	}
-	to := &dhcp.Ipv6fixedaddresscloudinfoDelegatedMember{}
+	to := &dhcp.Ipv6fixedaddresscloudinfoDelegatedMember{
+		Ipv4addr: flex.ExpandStringPointer(m.Ipv4addr),
+		Ipv6addr: flex.ExpandStringPointer(m.Ipv6addr),
+		Name:     flex.ExpandStringPointer(m.Name),
+	}
 	return to
 }
 
@@ -0,0 +1,297 @@
+package dhcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type LeaseModel struct {
+	Ref                   types.String `tfsdk:"ref"`
+	Address               types.String `tfsdk:"address"`
+	BillingClass          types.String `tfsdk:"billing_class"`
+	BindingState          types.String `tfsdk:"binding_state"`
+	ClientHostname        types.String `tfsdk:"client_hostname"`
+	Cltt                  types.Int64  `tfsdk:"cltt"`
+	DiscoveredData        types.Object `tfsdk:"discovered_data"`
+	Ends                  types.Int64  `tfsdk:"ends"`
+	Fingerprint           types.String `tfsdk:"fingerprint"`
+	Hardware              types.String `tfsdk:"hardware"`
+	Ipv6Duid              types.String `tfsdk:"ipv6_duid"`
+	Ipv6Iaid              types.String `tfsdk:"ipv6_iaid"`
+	Ipv6PreferredLifetime types.Int64  `tfsdk:"ipv6_preferred_lifetime"`
+	Ipv6PrefixBits        types.Int64  `tfsdk:"ipv6_prefix_bits"`
+	IsInvalidMac          types.Bool   `tfsdk:"is_invalid_mac"`
+	MsAdUserData          types.Object `tfsdk:"ms_ad_user_data"`
+	Network               types.String `tfsdk:"network"`
+	NetworkView           types.String `tfsdk:"network_view"`
+	NeverEnds             types.Bool   `tfsdk:"never_ends"`
+	NeverStarts           types.Bool   `tfsdk:"never_starts"`
+	NextBindingState      types.String `tfsdk:"next_binding_state"`
+	OnCommit              types.String `tfsdk:"on_commit"`
+	OnExpiry              types.String `tfsdk:"on_expiry"`
+	OnRelease             types.String `tfsdk:"on_release"`
+	Option                types.String `tfsdk:"option"`
+	Protocol              types.String `tfsdk:"protocol"`
+	RemoteId              types.String `tfsdk:"remote_id"`
+	RequestedOptions      types.String `tfsdk:"requested_options"`
+	ServedBy              types.String `tfsdk:"served_by"`
+	ServerHostName        types.String `tfsdk:"server_host_name"`
+	Starts                types.Int64  `tfsdk:"starts"`
+	Tsfp                  types.Int64  `tfsdk:"tsfp"`
+	Tstp                  types.Int64  `tfsdk:"tstp"`
+	Uid                   types.String `tfsdk:"uid"`
+	Username              types.String `tfsdk:"username"`
+	Variable              types.String `tfsdk:"variable"`
+}
+
+var LeaseAttrTypes = map[string]attr.Type{
+	"ref":                     types.StringType,
+	"address":                 types.StringType,
+	"billing_class":           types.StringType,
+	"binding_state":           types.StringType,
+	"client_hostname":         types.StringType,
+	"cltt":                    types.Int64Type,
+	"discovered_data":         types.ObjectType{AttrTypes: LeaseDiscoveredDataAttrTypes},
+	"ends":                    types.Int64Type,
+	"fingerprint":             types.StringType,
+	"hardware":                types.StringType,
+	"ipv6_duid":               types.StringType,
+	"ipv6_iaid":               types.StringType,
+	"ipv6_preferred_lifetime": types.Int64Type,
+	"ipv6_prefix_bits":        types.Int64Type,
+	"is_invalid_mac":          types.BoolType,
+	"ms_ad_user_data":         types.ObjectType{AttrTypes: LeaseMsAdUserDataAttrTypes},
+	"network":                 types.StringType,
+	"network_view":            types.StringType,
+	"never_ends":              types.BoolType,
+	"never_starts":            types.BoolType,
+	"next_binding_state":      types.StringType,
+	"on_commit":               types.StringType,
+	"on_expiry":               types.StringType,
+	"on_release":              types.StringType,
+	"option":                  types.StringType,
+	"protocol":                types.StringType,
+	"remote_id":               types.StringType,
+	"requested_options":       types.StringType,
+	"served_by":               types.StringType,
+	"server_host_name":        types.StringType,
+	"starts":                  types.Int64Type,
+	"tsfp":                    types.Int64Type,
+	"tstp":                    types.Int64Type,
+	"uid":                     types.StringType,
+	"username":                types.StringType,
+	"variable":                types.StringType,
+}
+
+var LeaseResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"address": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The IPv4 Address or IPv6 Address of the lease.",
+	},
+	"billing_class": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The billing_class value of a DHCP Lease object. This field specifies the class to which this lease is currently billed. This field is for IPv4 leases only.",
+	},
+	"binding_state": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The binding state for the current lease. Following are some of the values this field can be set to: * ABANDONED: The Infoblox appliance cannot lease this IP address because the appliance received a response when it pinged the address. * ACTIVE: The lease is currently in use by a DHCP client. * EXPIRED: The lease was in use, but the DHCP client never renewed it, so it is no longer valid. * FREE: The lease is available for clients to use. * RELEASED: The DHCP client returned the lease to the appliance.",
+	},
+	"client_hostname": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The client_hostname of a DHCP Lease object. This field specifies the host name that the DHCP client sends to the Infoblox appliance using DHCP option 12.",
+	},
+	"cltt": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The CLTT (Client Last Transaction Time) value of a DHCP Lease object. This field specifies the time of the last transaction with the DHCP client for this lease.",
+	},
+	"discovered_data": schema.SingleNestedAttribute{
+		Attributes:          LeaseDiscoveredDataResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The discovered data for this lease.",
+	},
+	"ends": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The end time value of a DHCP Lease object. This field specifies the time when a lease ended.",
+	},
+	"fingerprint": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "DHCP fingerprint for the lease.",
+	},
+	"hardware": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The hardware type of a DHCP Lease object. This field specifies the MAC address of the network interface on which the lease will be used. This field is supported for IPv4 leases, and from NIOS-9.0.6 onwards, also supported for IPv6 leases.",
+	},
+	"ipv6_duid": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The DUID value for this lease. This field is only applicable for IPv6 leases.",
+	},
+	"ipv6_iaid": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The interface ID of an IPv6 address that the Infoblox appliance leased to the DHCP client. This field is for IPv6 leases only.",
+	},
+	"ipv6_preferred_lifetime": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The preferred lifetime value of an IPv6 address that the Infoblox appliance leased to the DHCP client. This field is for IPv6 leases only.",
+	},
+	"ipv6_prefix_bits": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Prefix bits for this lease. This field is for IPv6 leases only.",
+	},
+	"is_invalid_mac": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "This flag reflects whether the MAC address for this lease is invalid.",
+	},
+	"ms_ad_user_data": schema.SingleNestedAttribute{
+		Attributes:          LeaseMsAdUserDataResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The Microsoft Active Directory user related information.",
+	},
+	"network": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The network, in \"network/netmask\" format, with which this lease is associated.",
+	},
+	"network_view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the network view in which this lease resides.",
+	},
+	"never_ends": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "If this field is set to True, the lease does not have an end time.",
+	},
+	"never_starts": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "If this field is set to True, the lease does not have a start time.",
+	},
+	"next_binding_state": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The subsequent binding state when the current lease expires. This field is for IPv4 leases only. Following are some of the values this field can be set to: * ABANDONED: The Infoblox appliance cannot lease this IP address because the appliance received a response when it pinged the address. * ACTIVE: The lease is currently in use by a DHCP client. * EXPIRED: The lease was in use, but the DHCP client never renewed it, so it is no longer valid. * FREE: The lease is available for clients to use. * RELEASED: The DHCP client returned the lease to the appliance.",
+	},
+	"on_commit": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The list of commands to be executed when the lease is granted.",
+	},
+	"on_expiry": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The list of commands to be executed when the lease expires.",
+	},
+	"on_release": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The list of commands to be executed when the lease is released.",
+	},
+	"option": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The option value of a DHCP Lease object. This field specifies the agent circuit ID and remote ID sent by a DHCP relay agent in DHCP option 82. This field is for IPv4 leases only.",
+	},
+	"protocol": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "This field determines whether the lease is an IPv4 or IPv6 address.",
+	},
+	"remote_id": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "This field represents the \"Remote ID\" sub-option of DHCP option 82. Remote ID can be in ASCII form (e.g. ``\"abcd\"``) or in colon-separated HEX form (e.g. ``1:2:ab:cd``). HEX representation is used only when the sub-option value contains unprintable characters. If a remote ID sub-option value is in ASCII form, it is always enclosed in quotes to prevent ambiguous values (e.g. ``\"10:20\"`` - ASCII 5-byte string; ``10:20`` - HEX 2-byte value). * ASCII representation is used if the remote ID sub-option contains only printable ASCII characters (ASCII characters in range ``x20-0x7E``). * The backslash symbol (``\\\\``) is used as an escape symbol to escape the quote symbol (``\"``) in an ASCII string. * Double backslashes (``\\\\\\\\``) are used to represent the backslash symbol (``\\\\``) in an ASCII string. * HEX representation is used only when the remote ID sub-option value contains unprintable characters and is normalized as follows: * starting zero is removed from digits: ``1``, ``a`` - Valid; ``01``, ``0a`` - Invalid; * lowercase characters are used for symbols: ``fa`` - Valid; ``FA`` - Invalid. NIOS does not support the convertion between HEX and ASCII formats. Searches are performed using the exact same format and value as the sub-option is represented. Query examples assume the following leases are stored in the database: .. tabularcolumns:: |p{1in}|p{3in}|p{2in}| ========= ========================== ============================ Number Option field Extracted remote ID field ========= ========================== ============================ Lease01 agent.remote-id= \"00152654358700\" \"00152654358700\" agent.circuit-id= \"BX1-PORT-003\" Lease02 agent.remote-id=\"Dhcp \"Dhcp Relay 10\" Relay 10\" agent.circuit-id=\"Port008\" Lease03 agent.remote-id=\"00:01:02\" \"00:01:02\" Lease04 agent.remote-id=0:1:2 0:1:2 Lease05 agent.remote-id=02:03 2:3 Lease06 agent.remote-id=10:20 10:20 Lease07 agent.circuit-id= \"no-remote-id\" ========= ========================== ============================ Expected results: .. tabularcolumns:: |p{1.5in}|p{1.5in}|p{3in}| ========================= ==================== ============================= Query Returned leases Comments ========================= ==================== ============================= remote_id=01:02 None EXACT query. No results are expected. remote_id=\"Dhcp Relay 10\" Lease02 EXACT query for an ASCII value. remote_id=0:1:2 Lease04 EXACT query for a HEX value. remote_id=00:01:02 None EXACT query for a HEX value. No results are expected as the search value is not normalized to the same format used in the database. remote_id~=10 Lease02, Lease06 REGEX query. remote_id~=^\".*1 Lease01, Lease02, REGEX query. Only ASCII Lease03 values are expected due to the starting quote (``\"``) in the search value. remote_id~=^[^\"]*2 Lease04, Lease05, REGEX query. Only HEX values Lease06 are expected as the starting quote (``\"``) is excluded from the search value. remote_id=\"\" None EXACT query. No results are expected as no leases that contain an empty remote ID value exist in the database. ID value in the database. remote_id~=\"\" Lease01, Lease02, REGEX query. This query is Lease03, Lease04, expected to match any Lease05, Lease06 lease that contain remote ID set to any value. ========================= ==================== ============================= **NOTE:** Lease07 is not expected to be returned when searching for the remote ID sub-option.",
+	},
+	"requested_options": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "This field contains the option request list received from the client. For DHCPv4, it includes \"Parameter Request List\" data and for DHCPv6, it includes \"Option Request Option\" data.",
+	},
+	"served_by": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The IP address of the server that sends an active lease to a client.",
+	},
+	"server_host_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The host name of the Grid member or Microsoft DHCP server that issues the lease.",
+	},
+	"starts": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The start time of a DHCP Lease object. This field specifies the time when the lease starts.",
+	},
+	"tsfp": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The TSFP (Time Sent From Partner) value of a DHCP Lease object. This field specifies the time that the current lease state ends, from the point of view of a remote DHCP failover peer. This field is for IPv4 leases only.",
+	},
+	"tstp": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The TSTP (Time Sent To Partner) value of a DHCP Lease object. This field specifies the time that the current lease state ends, from the point of view of a local DHCP failover peer. This field is for IPv4 leases only.",
+	},
+	"uid": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The UID (User ID) value of a DHCP Lease object. This field specifies the client identifier that the DHCP client sends the Infoblox appliance (in DHCP option 61) when it acquires the lease. Not all DHCP clients send a UID. This field is for IPv4 leases only.",
+	},
+	"username": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The user name that the server has associated with a DHCP Lease object.",
+	},
+	"variable": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The variable value of a DHCP Lease object. This field keeps all variables related to the DDNS update of the DHCP lease. The variables related to the DDNS updates of the DHCP lease. The variables can be one of the following: ddns-text: The ddns-text variable is used to record the value of the client's TXT identification record when the interim DDNS update style has been used to update the DNS service for a particular lease. ddns-fwd-name: When a DDNS update was successfully completed, the ddns-fwd-name variable records the value of the name used when the client's A record was updated. The server may have used this name when it updated the client's PTR record. ddns-client-fqdn: If the server is configured to use the interim DDNS update style and is also configured to allow clients to update their own FQDNs, the ddns-client-fqdn variable records the name that the client used when it updated its own FQDN. This is also the name that the server used to update the client's PTR record. ddns-rev-name: If the server successfully updates the client's PTR record, this variable will record the name that the DHCP server used for the PTR record. The name to which the PTR record points will be either the ddns-fwd-name or the ddns-client-fqdn.",
+	},
+}
+
+func FlattenLease(ctx context.Context, from *dhcp.Lease, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(LeaseAttrTypes)
+	}
+	m := LeaseModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, LeaseAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *LeaseModel) Flatten(ctx context.Context, from *dhcp.Lease, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = LeaseModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Address = flex.FlattenStringPointer(from.Address)
+	m.BillingClass = flex.FlattenStringPointer(from.BillingClass)
+	m.BindingState = flex.FlattenStringPointer(from.BindingState)
+	m.ClientHostname = flex.FlattenStringPointer(from.ClientHostname)
+	m.Cltt = flex.FlattenInt64Pointer(from.Cltt)
+	m.DiscoveredData = FlattenLeaseDiscoveredData(ctx, from.DiscoveredData, diags)
+	m.Ends = flex.FlattenInt64Pointer(from.Ends)
+	m.Fingerprint = flex.FlattenStringPointer(from.Fingerprint)
+	m.Hardware = flex.FlattenStringPointer(from.Hardware)
+	m.Ipv6Duid = flex.FlattenStringPointer(from.Ipv6Duid)
+	m.Ipv6Iaid = flex.FlattenStringPointer(from.Ipv6Iaid)
+	m.Ipv6PreferredLifetime = flex.FlattenInt64Pointer(from.Ipv6PreferredLifetime)
+	m.Ipv6PrefixBits = flex.FlattenInt64Pointer(from.Ipv6PrefixBits)
+	m.IsInvalidMac = types.BoolPointerValue(from.IsInvalidMac)
+	m.MsAdUserData = FlattenLeaseMsAdUserData(ctx, from.MsAdUserData, diags)
+	m.Network = flex.FlattenStringPointer(from.Network)
+	m.NetworkView = flex.FlattenStringPointer(from.NetworkView)
+	m.NeverEnds = types.BoolPointerValue(from.NeverEnds)
+	m.NeverStarts = types.BoolPointerValue(from.NeverStarts)
+	m.NextBindingState = flex.FlattenStringPointer(from.NextBindingState)
+	m.OnCommit = flex.FlattenStringPointer(from.OnCommit)
+	m.OnExpiry = flex.FlattenStringPointer(from.OnExpiry)
+	m.OnRelease = flex.FlattenStringPointer(from.OnRelease)
+	m.Option = flex.FlattenStringPointer(from.Option)
+	m.Protocol = flex.FlattenStringPointer(from.Protocol)
+	m.RemoteId = flex.FlattenStringPointer(from.RemoteId)
+	m.RequestedOptions = flex.FlattenStringPointer(from.RequestedOptions)
+	m.ServedBy = flex.FlattenStringPointer(from.ServedBy)
+	m.ServerHostName = flex.FlattenStringPointer(from.ServerHostName)
+	m.Starts = flex.FlattenInt64Pointer(from.Starts)
+	m.Tsfp = flex.FlattenInt64Pointer(from.Tsfp)
+	m.Tstp = flex.FlattenInt64Pointer(from.Tstp)
+	m.Uid = flex.FlattenStringPointer(from.Uid)
+	m.Username = flex.FlattenStringPointer(from.Username)
+	m.Variable = flex.FlattenStringPointer(from.Variable)
+}
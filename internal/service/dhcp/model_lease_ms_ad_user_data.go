@@ -0,0 +1,50 @@
+package dhcp
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type LeaseMsAdUserDataModel struct {
+	ActiveUsersCount types.Int64 `tfsdk:"active_users_count"`
+}
+
+var LeaseMsAdUserDataAttrTypes = map[string]attr.Type{
+	"active_users_count": types.Int64Type,
+}
+
+var LeaseMsAdUserDataResourceSchemaAttributes = map[string]schema.Attribute{
+	"active_users_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of active users.",
+	},
+}
+
+func FlattenLeaseMsAdUserData(ctx context.Context, from *dhcp.LeaseMsAdUserData, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(LeaseMsAdUserDataAttrTypes)
+	}
+	m := LeaseMsAdUserDataModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, LeaseMsAdUserDataAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *LeaseMsAdUserDataModel) Flatten(ctx context.Context, from *dhcp.LeaseMsAdUserData, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = LeaseMsAdUserDataModel{}
+	}
+	m.ActiveUsersCount = flex.FlattenInt64Pointer(from.ActiveUsersCount)
+}
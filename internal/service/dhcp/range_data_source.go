@@ -139,7 +139,7 @@ func (d *RangeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				ReturnFieldsPlus(readableAttributesForRange).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
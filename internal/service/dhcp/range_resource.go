@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type RangeResource struct {
 	client *niosclient.APIClient
 }
 
+// RangeResourceModel describes the resource data model, extending RangeModel with the operation timeouts.
+type RangeResourceModel struct {
+	RangeModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_range"
 }
@@ -41,6 +49,7 @@ func (r *RangeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Range.",
 		Attributes:          RangeResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *RangeResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 func (r *RangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RangeModel
+	var data RangeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *RangeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	var apiRes *dhcp.CreateRangeResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *RangeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *RangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RangeModel
+	var data RangeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *RangeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		apiRes  *dhcp.GetRangeResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			RangeAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRange).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *RangeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RangeResource) ReadByExtAttrs(ctx context.Context, data *RangeModel, resp *resource.ReadResponse) bool {
+func (r *RangeResource) ReadByExtAttrs(ctx context.Context, data *RangeResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *RangeResource) ReadByExtAttrs(ctx context.Context, data *RangeModel, re
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRange).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Range by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *RangeResource) ReadByExtAttrs(ctx context.Context, data *RangeModel, re
 
 func (r *RangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RangeModel
+	var data RangeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -326,7 +335,7 @@ func (r *RangeResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	var apiRes *dhcp.UpdateRangeResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *RangeResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *RangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RangeModel
+	var data RangeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *RangeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			RangeAPI.
 			Delete(ctx, resourceRef).
@@ -407,7 +416,7 @@ func (r *RangeResource) ImportState(ctx context.Context, req resource.ImportStat
 }
 
 func (r *RangeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data RangeModel
+	var data RangeResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -140,7 +140,7 @@ func (d *RangetemplateDataSource) Read(ctx context.Context, req datasource.ReadR
 				ReturnFieldsPlus(readableAttributesForRangetemplate).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type RangetemplateResource struct {
 	client *niosclient.APIClient
 }
 
+// RangetemplateResourceModel describes the resource data model, extending RangetemplateModel with the operation timeouts.
+type RangetemplateResourceModel struct {
+	RangetemplateModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RangetemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_range_template"
 }
@@ -41,6 +49,7 @@ func (r *RangetemplateResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Range Template.",
 		Attributes:          RangetemplateResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *RangetemplateResource) Configure(ctx context.Context, req resource.Conf
 
 func (r *RangetemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RangetemplateModel
+	var data RangetemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *RangetemplateResource) Create(ctx context.Context, req resource.CreateR
 
 	var apiRes *dhcp.CreateRangetemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *RangetemplateResource) Create(ctx context.Context, req resource.CreateR
 
 func (r *RangetemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RangetemplateModel
+	var data RangetemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *RangetemplateResource) Read(ctx context.Context, req resource.ReadReque
 		apiRes  *dhcp.GetRangetemplateResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			RangetemplateAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRangetemplate).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *RangetemplateResource) Read(ctx context.Context, req resource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RangetemplateResource) ReadByExtAttrs(ctx context.Context, data *RangetemplateModel, resp *resource.ReadResponse) bool {
+func (r *RangetemplateResource) ReadByExtAttrs(ctx context.Context, data *RangetemplateResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *RangetemplateResource) ReadByExtAttrs(ctx context.Context, data *Ranget
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRangetemplate).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Rangetemplate by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *RangetemplateResource) ReadByExtAttrs(ctx context.Context, data *Ranget
 
 func (r *RangetemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RangetemplateModel
+	var data RangetemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -326,7 +335,7 @@ func (r *RangetemplateResource) Update(ctx context.Context, req resource.UpdateR
 
 	var apiRes *dhcp.UpdateRangetemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *RangetemplateResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *RangetemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RangetemplateModel
+	var data RangetemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *RangetemplateResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			RangetemplateAPI.
 			Delete(ctx, resourceRef).
@@ -407,7 +416,7 @@ func (r *RangetemplateResource) ImportState(ctx context.Context, req resource.Im
 }
 
 func (r *RangetemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data RangetemplateModel
+	var data RangetemplateResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -140,7 +140,7 @@ func (d *RoaminghostDataSource) Read(ctx context.Context, req datasource.ReadReq
 				ReturnFieldsPlus(readableAttributesForRoaminghost).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dhcp"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type RoaminghostResource struct {
 	client *niosclient.APIClient
 }
 
+// RoaminghostResourceModel describes the resource data model, extending RoaminghostModel with the operation timeouts.
+type RoaminghostResourceModel struct {
+	RoaminghostModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RoaminghostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_roaminghost"
 }
@@ -43,6 +51,7 @@ func (r *RoaminghostResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Roaming Host.",
 		Attributes:          RoaminghostResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *RoaminghostResource) Configure(ctx context.Context, req resource.Config
 
 func (r *RoaminghostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RoaminghostModel
+	var data RoaminghostResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -91,7 +100,7 @@ func (r *RoaminghostResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *dhcp.CreateRoaminghostResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -139,7 +148,7 @@ func (r *RoaminghostResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *RoaminghostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RoaminghostModel
+	var data RoaminghostResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -161,14 +170,14 @@ func (r *RoaminghostResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *dhcp.GetRoaminghostResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			RoaminghostAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRoaminghost).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -224,7 +233,7 @@ func (r *RoaminghostResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RoaminghostResource) ReadByExtAttrs(ctx context.Context, data *RoaminghostModel, resp *resource.ReadResponse) bool {
+func (r *RoaminghostResource) ReadByExtAttrs(ctx context.Context, data *RoaminghostResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -251,7 +260,7 @@ func (r *RoaminghostResource) ReadByExtAttrs(ctx context.Context, data *Roamingh
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRoaminghost).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Roaminghost by extattrs, got error: %s", err))
@@ -283,7 +292,7 @@ func (r *RoaminghostResource) ReadByExtAttrs(ctx context.Context, data *Roamingh
 
 func (r *RoaminghostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RoaminghostModel
+	var data RoaminghostResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -334,7 +343,7 @@ func (r *RoaminghostResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *dhcp.UpdateRoaminghostResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -377,7 +386,7 @@ func (r *RoaminghostResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *RoaminghostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RoaminghostModel
+	var data RoaminghostResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -388,7 +397,7 @@ func (r *RoaminghostResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			RoaminghostAPI.
 			Delete(ctx, resourceRef).
@@ -410,7 +419,7 @@ func (r *RoaminghostResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r RoaminghostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data RoaminghostModel
+	var data RoaminghostResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
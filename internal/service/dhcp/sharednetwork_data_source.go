@@ -143,7 +143,7 @@ func (d *SharednetworkDataSource) Read(ctx context.Context, req datasource.ReadR
 				ReturnFieldsPlus(readableAttributesForSharednetworkForDataSource).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
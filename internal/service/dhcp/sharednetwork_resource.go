@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type SharednetworkResource struct {
 	client *niosclient.APIClient
 }
 
+// SharednetworkResourceModel describes the resource data model, extending SharednetworkModel with the operation timeouts.
+type SharednetworkResourceModel struct {
+	SharednetworkModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SharednetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dhcp_shared_network"
 }
@@ -42,6 +49,7 @@ func (r *SharednetworkResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DHCP Shared Network.",
 		Attributes:          SharednetworkResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +74,7 @@ func (r *SharednetworkResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *SharednetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data SharednetworkModel
+	var data SharednetworkResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -229,7 +237,7 @@ func (r *SharednetworkResource) ValidateConfig(ctx context.Context, req resource
 
 func (r *SharednetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SharednetworkModel
+	var data SharednetworkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -251,7 +259,7 @@ func (r *SharednetworkResource) Create(ctx context.Context, req resource.CreateR
 
 	var apiRes *dhcp.CreateSharednetworkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -298,7 +306,7 @@ func (r *SharednetworkResource) Create(ctx context.Context, req resource.CreateR
 
 func (r *SharednetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SharednetworkModel
+	var data SharednetworkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -320,14 +328,14 @@ func (r *SharednetworkResource) Read(ctx context.Context, req resource.ReadReque
 		apiRes  *dhcp.GetSharednetworkResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DHCPAPI.
 			SharednetworkAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSharednetwork).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -382,7 +390,7 @@ func (r *SharednetworkResource) Read(ctx context.Context, req resource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *SharednetworkResource) ReadByExtAttrs(ctx context.Context, data *SharednetworkModel, resp *resource.ReadResponse) bool {
+func (r *SharednetworkResource) ReadByExtAttrs(ctx context.Context, data *SharednetworkResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -409,7 +417,7 @@ func (r *SharednetworkResource) ReadByExtAttrs(ctx context.Context, data *Shared
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForSharednetwork).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Sharednetwork by extattrs, got error: %s", err))
@@ -440,7 +448,7 @@ func (r *SharednetworkResource) ReadByExtAttrs(ctx context.Context, data *Shared
 
 func (r *SharednetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SharednetworkModel
+	var data SharednetworkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -489,7 +497,7 @@ func (r *SharednetworkResource) Update(ctx context.Context, req resource.UpdateR
 
 	var apiRes *dhcp.UpdateSharednetworkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -532,7 +540,7 @@ func (r *SharednetworkResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *SharednetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SharednetworkModel
+	var data SharednetworkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -543,7 +551,7 @@ func (r *SharednetworkResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DHCPAPI.
 			SharednetworkAPI.
 			Delete(ctx, resourceRef).
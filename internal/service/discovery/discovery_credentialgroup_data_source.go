@@ -133,7 +133,7 @@ func (d *DiscoveryCredentialgroupDataSource) Read(ctx context.Context, req datas
 				ReturnFieldsPlus(readableAttributesForDiscoveryCredentialgroup).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
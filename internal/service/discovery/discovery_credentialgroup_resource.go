@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/discovery"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DiscoveryCredentialgroupResource struct {
 	client *niosclient.APIClient
 }
 
+// DiscoveryCredentialgroupResourceModel describes the resource data model, extending DiscoveryCredentialgroupModel with the operation timeouts.
+type DiscoveryCredentialgroupResourceModel struct {
+	DiscoveryCredentialgroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DiscoveryCredentialgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "discovery_credentialgroup"
 }
@@ -41,6 +49,7 @@ func (r *DiscoveryCredentialgroupResource) Schema(ctx context.Context, req resou
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Discovery Credential Group.",
 		Attributes:          DiscoveryCredentialgroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -65,7 +74,7 @@ func (r *DiscoveryCredentialgroupResource) Configure(ctx context.Context, req re
 }
 
 func (r *DiscoveryCredentialgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data DiscoveryCredentialgroupModel
+	var data DiscoveryCredentialgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -81,7 +90,7 @@ func (r *DiscoveryCredentialgroupResource) Create(ctx context.Context, req resou
 
 	var apiRes *discovery.CreateDiscoveryCredentialgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -123,7 +132,7 @@ func (r *DiscoveryCredentialgroupResource) Create(ctx context.Context, req resou
 
 func (r *DiscoveryCredentialgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	//var diags diag.Diagnostics
-	var data DiscoveryCredentialgroupModel
+	var data DiscoveryCredentialgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -139,14 +148,14 @@ func (r *DiscoveryCredentialgroupResource) Read(ctx context.Context, req resourc
 		apiRes  *discovery.GetDiscoveryCredentialgroupResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DiscoveryAPI.
 			DiscoveryCredentialgroupAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDiscoveryCredentialgroup).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -176,7 +185,7 @@ func (r *DiscoveryCredentialgroupResource) Read(ctx context.Context, req resourc
 
 func (r *DiscoveryCredentialgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DiscoveryCredentialgroupModel
+	var data DiscoveryCredentialgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -201,7 +210,7 @@ func (r *DiscoveryCredentialgroupResource) Update(ctx context.Context, req resou
 
 	var apiRes *discovery.UpdateDiscoveryCredentialgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -234,7 +243,7 @@ func (r *DiscoveryCredentialgroupResource) Update(ctx context.Context, req resou
 }
 
 func (r *DiscoveryCredentialgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DiscoveryCredentialgroupModel
+	var data DiscoveryCredentialgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -245,7 +254,7 @@ func (r *DiscoveryCredentialgroupResource) Delete(ctx context.Context, req resou
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DiscoveryAPI.
 			DiscoveryCredentialgroupAPI.
 			Delete(ctx, resourceRef).
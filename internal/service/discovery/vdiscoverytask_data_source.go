@@ -132,7 +132,7 @@ func (d *VdiscoverytaskDataSource) Read(ctx context.Context, req datasource.Read
 				ReturnFieldsPlus(readableAttributesForVdiscoverytask).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +37,12 @@ type VdiscoverytaskResource struct {
 	client *niosclient.APIClient
 }
 
+// VdiscoverytaskResourceModel describes the resource data model, extending VdiscoverytaskModel with the operation timeouts.
+type VdiscoverytaskResourceModel struct {
+	VdiscoverytaskModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *VdiscoverytaskResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "discovery_vdiscovery_task"
 }
@@ -44,6 +51,7 @@ func (r *VdiscoverytaskResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a vDiscovery Task.",
 		Attributes:          VdiscoverytaskResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +76,7 @@ func (r *VdiscoverytaskResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *VdiscoverytaskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data VdiscoverytaskModel
+	var data VdiscoverytaskResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -277,7 +285,7 @@ func (r *VdiscoverytaskResource) ValidateConfig(ctx context.Context, req resourc
 }
 
 func (r *VdiscoverytaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data VdiscoverytaskModel
+	var data VdiscoverytaskResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -306,7 +314,7 @@ func (r *VdiscoverytaskResource) Create(ctx context.Context, req resource.Create
 
 	var apiRes *discovery.CreateVdiscoverytaskResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -347,7 +355,7 @@ func (r *VdiscoverytaskResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *VdiscoverytaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data VdiscoverytaskModel
+	var data VdiscoverytaskResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -363,14 +371,14 @@ func (r *VdiscoverytaskResource) Read(ctx context.Context, req resource.ReadRequ
 		apiRes  *discovery.GetVdiscoverytaskResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DiscoveryAPI.
 			VdiscoverytaskAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForVdiscoverytask).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -400,7 +408,7 @@ func (r *VdiscoverytaskResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (r *VdiscoverytaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data VdiscoverytaskModel
+	var data VdiscoverytaskResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -438,7 +446,7 @@ func (r *VdiscoverytaskResource) Update(ctx context.Context, req resource.Update
 
 	var apiRes *discovery.UpdateVdiscoverytaskResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -471,7 +479,7 @@ func (r *VdiscoverytaskResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *VdiscoverytaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data VdiscoverytaskModel
+	var data VdiscoverytaskResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -482,7 +490,7 @@ func (r *VdiscoverytaskResource) Delete(ctx context.Context, req resource.Delete
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DiscoveryAPI.
 			VdiscoverytaskAPI.
 			Delete(ctx, resourceRef).
@@ -508,22 +516,20 @@ func (r *VdiscoverytaskResource) ImportState(ctx context.Context, req resource.I
 }
 
 // function that will process your GCP service account file and return the token
-func (r *VdiscoverytaskResource) processGCPServiceAccountFile(ctx context.Context, data *VdiscoverytaskModel, diags *diag.Diagnostics) bool {
+func (r *VdiscoverytaskResource) processGCPServiceAccountFile(ctx context.Context, data *VdiscoverytaskResourceModel, diags *diag.Diagnostics) bool {
 	// Check if service_account_file is provided
 	if data.ServiceAccountFile.IsNull() || data.ServiceAccountFile.IsUnknown() {
 		return true // No file to process, continue
 	}
 
 	// Get connection details from client configuration
-	baseUrl := r.client.SecurityAPI.Cfg.NIOSHostURL
-	username := r.client.SecurityAPI.Cfg.NIOSUsername
-	password := r.client.SecurityAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.SecurityAPI.Cfg)
 
 	// Get the file path from the model
 	filePath := data.ServiceAccountFile.ValueString()
 
 	// Upload the GCP service account file and get the token
-	token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+	token, err := utils.UploadFileWithToken(ctx, r.client.SecurityAPI.Cfg.HTTPClient, baseUrl, filePath)
 	if err != nil {
 		diags.AddError(
 			"Client Error",
@@ -538,22 +544,20 @@ func (r *VdiscoverytaskResource) processGCPServiceAccountFile(ctx context.Contex
 }
 
 // function that will process your CDiscovery file and return the token
-func (r *VdiscoverytaskResource) processCDiscoveryFile(ctx context.Context, data *VdiscoverytaskModel, diags *diag.Diagnostics) bool {
+func (r *VdiscoverytaskResource) processCDiscoveryFile(ctx context.Context, data *VdiscoverytaskResourceModel, diags *diag.Diagnostics) bool {
 	// Check if cdiscovery_file is provided
 	if data.CdiscoveryFile.IsNull() || data.CdiscoveryFile.IsUnknown() {
 		return true // No file to process, continue
 	}
 
 	// Get connection details from client configuration
-	baseUrl := r.client.SecurityAPI.Cfg.NIOSHostURL
-	username := r.client.SecurityAPI.Cfg.NIOSUsername
-	password := r.client.SecurityAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.SecurityAPI.Cfg)
 
 	// Get the file path from the model
 	filePath := data.CdiscoveryFile.ValueString()
 
 	// Upload the CDiscovery file and get the token
-	token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+	token, err := utils.UploadFileWithToken(ctx, r.client.SecurityAPI.Cfg.HTTPClient, baseUrl, filePath)
 	if err != nil {
 		diags.AddError(
 			"Client Error",
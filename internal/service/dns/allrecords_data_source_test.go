@@ -0,0 +1,150 @@
+package dns_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccAllrecordsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_allrecords.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllrecordsDataSourceConfigFilters(zoneFqdn, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "result.*", map[string]string{
+						"name":    "a",
+						"type":    "record:a",
+						"address": "10.0.0.30",
+						"zone":    zoneFqdn,
+						"view":    "default",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "result.*", map[string]string{
+						"name": "cname",
+						"type": "record:cname",
+						"zone": zoneFqdn,
+						"view": "default",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAllrecordsDataSource_TypeFilter(t *testing.T) {
+	dataSourceName := "data.nios_dns_allrecords.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllrecordsDataSourceConfigFilters(zoneFqdn, "record:cname"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", "cname"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "record:cname"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.record", "nios_dns_record_cname.test", "ref"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAllrecordsDataSource_Paging(t *testing.T) {
+	dataSourceName := "data.nios_dns_allrecords.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllrecordsDataSourceConfigPaging(zoneFqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "result.*", map[string]string{
+						"name": "a",
+						"type": "record:a",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "result.*", map[string]string{
+						"name": "cname",
+						"type": "record:cname",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccAllrecordsDataSourceZoneConfig(zoneFqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test" {
+	fqdn = %q
+	view = "default"
+	grid_primary = [
+		{
+			name = %q
+			stealth = false
+		}
+	]
+}
+
+resource "nios_dns_record_a" "test" {
+	name = "a.${nios_dns_zone_auth.test.fqdn}"
+	ipv4addr = "10.0.0.30"
+	view = "default"
+}
+
+resource "nios_dns_record_cname" "test" {
+	name = "cname.${nios_dns_zone_auth.test.fqdn}"
+	canonical = nios_dns_record_a.test.name
+	view = "default"
+}
+`, zoneFqdn, utils.GetNIOSGridMasterHostName())
+}
+
+func testAccAllrecordsDataSourceConfigFilters(zoneFqdn, recordType string) string {
+	typeFilter := ""
+	if recordType != "" {
+		typeFilter = fmt.Sprintf("type = %q", recordType)
+	}
+	config := fmt.Sprintf(`
+data "nios_dns_allrecords" "test" {
+	filters = {
+		zone = nios_dns_zone_auth.test.fqdn
+		view = "default"
+		%s
+	}
+	depends_on = [nios_dns_record_a.test, nios_dns_record_cname.test]
+}
+`, typeFilter)
+	return strings.Join([]string{testAccAllrecordsDataSourceZoneConfig(zoneFqdn), config}, "")
+}
+
+func testAccAllrecordsDataSourceConfigPaging(zoneFqdn string) string {
+	// A page size of one makes the data source follow the next page ID
+	config := `
+data "nios_dns_allrecords" "test" {
+	filters = {
+		zone = nios_dns_zone_auth.test.fqdn
+		view = "default"
+	}
+	max_results = 1
+	depends_on = [nios_dns_record_a.test, nios_dns_record_cname.test]
+}
+`
+	return strings.Join([]string{testAccAllrecordsDataSourceZoneConfig(zoneFqdn), config}, "")
+}
@@ -0,0 +1,61 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDdnsPrincipalclusterDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_ddns_principalcluster.test"
+	resourceName := "nios_dns_ddns_principalcluster.test"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDdnsPrincipalclusterDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdnsPrincipalclusterDataSourceConfigFilters(name),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					}, testAccCheckDdnsPrincipalclusterResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckDdnsPrincipalclusterResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "group", dataSourceName, "result.0.group"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "principals", dataSourceName, "result.0.principals"),
+	}
+}
+
+func testAccDdnsPrincipalclusterDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test" {
+  name = %q
+  principals = ["host/dhcp1.example.com@EXAMPLE.COM"]
+}
+
+data "nios_dns_ddns_principalcluster" "test" {
+  filters = {
+	 name = nios_dns_ddns_principalcluster.test.name
+  }
+}
+`, name)
+}
@@ -0,0 +1,59 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDdnsPrincipalclusterGroupDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_ddns_principalcluster_group.test"
+	resourceName := "nios_dns_ddns_principalcluster_group.test"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDdnsPrincipalclusterGroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdnsPrincipalclusterGroupDataSourceConfigFilters(name),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					}, testAccCheckDdnsPrincipalclusterGroupResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckDdnsPrincipalclusterGroupResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "clusters", dataSourceName, "result.0.clusters"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+	}
+}
+
+func testAccDdnsPrincipalclusterGroupDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test" {
+  name = %q
+}
+
+data "nios_dns_ddns_principalcluster_group" "test" {
+  filters = {
+	 name = nios_dns_ddns_principalcluster_group.test.name
+  }
+}
+`, name)
+}
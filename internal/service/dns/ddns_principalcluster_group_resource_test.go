@@ -0,0 +1,262 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDdnsPrincipalclusterGroup = "clusters,comment,name"
+
+func TestAccDdnsPrincipalclusterGroupResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "clusters.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_ddns_principalcluster_group.test"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDdnsPrincipalclusterGroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdnsPrincipalclusterGroupBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					testAccCheckDdnsPrincipalclusterGroupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_Clusters(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test_clusters"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	clusterName := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupClusters(name, clusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "clusters.#", "0"),
+				),
+			},
+			// Add a cluster to the group
+			{
+				Config: testAccDdnsPrincipalclusterGroupClusters(name, clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrPair("nios_dns_ddns_principalcluster.test_clusters", "group", resourceName, "name"),
+				),
+			},
+			// Read the group again, as it was refreshed before the cluster was created
+			{
+				Config: testAccDdnsPrincipalclusterGroupClusters(name, clusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "clusters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "clusters.0", clusterName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test_comment"
+	var v dns.DdnsPrincipalclusterGroup
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupComment(name, "This is a comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a comment"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupComment(name, "This is an updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterGroupResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster_group.test_name"
+	var v dns.DdnsPrincipalclusterGroup
+	name1 := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	name2 := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupName(name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroupName(name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterGroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckDdnsPrincipalclusterGroupExists(ctx context.Context, resourceName string, v *dns.DdnsPrincipalclusterGroup) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterGroupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalclusterGroup).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDdnsPrincipalclusterGroupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDdnsPrincipalclusterGroupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterGroupDestroy(ctx context.Context, v *dns.DdnsPrincipalclusterGroup) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterGroupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalclusterGroup).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterGroupDisappears(ctx context.Context, v *dns.DdnsPrincipalclusterGroup) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterGroupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccDdnsPrincipalclusterGroupBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test" {
+    name = %q
+}
+`, name)
+}
+
+func testAccDdnsPrincipalclusterGroupClusters(name, clusterName string, withCluster bool) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test_clusters" {
+    name = %q
+}
+`, name)
+	if !withCluster {
+		return config
+	}
+	return config + fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test_clusters" {
+    name = %q
+    principals = ["host/dhcp1.example.com@EXAMPLE.COM"]
+    group = nios_dns_ddns_principalcluster_group.test_clusters.name
+}
+`, clusterName)
+}
+
+func testAccDdnsPrincipalclusterGroupComment(name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test_comment" {
+    name = %q
+    comment = %q
+}
+`, name, comment)
+}
+
+func testAccDdnsPrincipalclusterGroupName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "test_name" {
+    name = %q
+}
+`, name)
+}
@@ -0,0 +1,301 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDdnsPrincipalcluster = "comment,group,name,principals"
+
+func TestAccDdnsPrincipalclusterResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterBasicConfig(name, []string{"host/dhcp1.example.com@EXAMPLE.COM"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principals.0", "host/dhcp1.example.com@EXAMPLE.COM"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_ddns_principalcluster.test"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDdnsPrincipalclusterDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDdnsPrincipalclusterBasicConfig(name, []string{"host/dhcp1.example.com@EXAMPLE.COM"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					testAccCheckDdnsPrincipalclusterDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_comment"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterComment(name, "This is a comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a comment"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterComment(name, "This is an updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Group(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_group"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	groupName1 := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	groupName2 := acctest.RandomNameWithPrefix("ddns-principalcluster-group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroup(name, groupName1, groupName2, "group1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "group", groupName1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterGroup(name, groupName1, groupName2, "group2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "group", groupName2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_name"
+	var v dns.DdnsPrincipalcluster
+	name1 := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	name2 := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterName(name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterName(name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDdnsPrincipalclusterResource_Principals(t *testing.T) {
+	var resourceName = "nios_dns_ddns_principalcluster.test_principals"
+	var v dns.DdnsPrincipalcluster
+	name := acctest.RandomNameWithPrefix("ddns-principalcluster")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDdnsPrincipalclusterPrincipals(name, []string{"host/dhcp1.example.com@EXAMPLE.COM"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principals.0", "host/dhcp1.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDdnsPrincipalclusterPrincipals(name, []string{"host/dhcp2.example.com@EXAMPLE.COM", "host/dhcp3.example.com@EXAMPLE.COM"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDdnsPrincipalclusterExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "principals.0", "host/dhcp2.example.com@EXAMPLE.COM"),
+					resource.TestCheckResourceAttr(resourceName, "principals.1", "host/dhcp3.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckDdnsPrincipalclusterExists(ctx context.Context, resourceName string, v *dns.DdnsPrincipalcluster) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalcluster).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDdnsPrincipalclusterResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDdnsPrincipalclusterResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterDestroy(ctx context.Context, v *dns.DdnsPrincipalcluster) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForDdnsPrincipalcluster).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckDdnsPrincipalclusterDisappears(ctx context.Context, v *dns.DdnsPrincipalcluster) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			DdnsPrincipalclusterAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccDdnsPrincipalclusterBasicConfig(name string, principals []string) string {
+	principalsStr := utils.ConvertStringSliceToHCL(principals)
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test" {
+    name = %q
+    principals = %s
+}
+`, name, principalsStr)
+}
+
+func testAccDdnsPrincipalclusterComment(name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test_comment" {
+    name = %q
+    principals = ["host/dhcp1.example.com@EXAMPLE.COM"]
+    comment = %q
+}
+`, name, comment)
+}
+
+func testAccDdnsPrincipalclusterGroup(name, groupName1, groupName2, group string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster_group" "group1" {
+    name = %q
+}
+
+resource "nios_dns_ddns_principalcluster_group" "group2" {
+    name = %q
+}
+
+resource "nios_dns_ddns_principalcluster" "test_group" {
+    name = %q
+    principals = ["host/dhcp1.example.com@EXAMPLE.COM"]
+    group = nios_dns_ddns_principalcluster_group.%s.name
+}
+`, groupName1, groupName2, name, group)
+}
+
+func testAccDdnsPrincipalclusterName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test_name" {
+    name = %q
+    principals = ["host/dhcp1.example.com@EXAMPLE.COM"]
+}
+`, name)
+}
+
+func testAccDdnsPrincipalclusterPrincipals(name string, principals []string) string {
+	principalsStr := utils.ConvertStringSliceToHCL(principals)
+	return fmt.Sprintf(`
+resource "nios_dns_ddns_principalcluster" "test_principals" {
+    name = %q
+    principals = %s
+}
+`, name, principalsStr)
+}
@@ -0,0 +1,535 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDns64group = "clients,comment,disable,enable_dnssec_dns64,exclude,extattrs,mapped,name,prefix"
+
+func TestAccDns64groupResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_dnssec_dns64", "false"),
+					resource.TestCheckResourceAttr(resourceName, "prefix", "64:ff9b::/96"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_dns64group.test"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDns64groupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDns64groupBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					testAccCheckDns64groupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDns64groupResource_Clients(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_clients"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	clients1 := []map[string]any{
+		{
+			"address":    "10.0.0.0/8",
+			"permission": "ALLOW",
+		},
+	}
+	clients2 := []map[string]any{
+		{
+			"address":    "192.168.1.0/24",
+			"permission": "DENY",
+		},
+		{
+			"address":    "Any",
+			"permission": "ALLOW",
+		},
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupClients(name, clients1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "clients.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "clients.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "clients.0.permission", "ALLOW"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupClients(name, clients2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "clients.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "clients.0.address", "192.168.1.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "clients.0.permission", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "clients.1.address", "Any"),
+					resource.TestCheckResourceAttr(resourceName, "clients.1.permission", "ALLOW"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_comment"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupComment(name, "This is a comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a comment"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupComment(name, "This is an updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_disable"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupDisable(name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupDisable(name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_EnableDnssecDns64(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_enable_dnssec_dns64"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupEnableDnssecDns64(name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_dnssec_dns64", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupEnableDnssecDns64(name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_dnssec_dns64", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_Exclude(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_exclude"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	exclude1 := []map[string]any{
+		{
+			"address":    "::ffff:0:0/96",
+			"permission": "ALLOW",
+		},
+	}
+	exclude2 := []map[string]any{
+		{
+			"address":    "2001:db8::/32",
+			"permission": "ALLOW",
+		},
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupExclude(name, exclude1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude.0.address", "::ffff:0:0/96"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupExclude(name, exclude2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude.0.address", "2001:db8::/32"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_ExtAttrs(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_extattrs"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupExtAttrs(name, map[string]any{
+					"Site": extAttrValue1,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupExtAttrs(name, map[string]any{
+					"Site": extAttrValue2,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_Mapped(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_mapped"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	mapped1 := []map[string]any{
+		{
+			"address":    "Any",
+			"permission": "ALLOW",
+		},
+	}
+	mapped2 := []map[string]any{
+		{
+			"address":    "10.10.0.0/16",
+			"permission": "DENY",
+		},
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupMapped(name, mapped1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "mapped.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mapped.0.address", "Any"),
+					resource.TestCheckResourceAttr(resourceName, "mapped.0.permission", "ALLOW"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupMapped(name, mapped2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "mapped.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mapped.0.address", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "mapped.0.permission", "DENY"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_name"
+	var v dns.Dns64group
+	name1 := acctest.RandomNameWithPrefix("dns64group")
+	name2 := acctest.RandomNameWithPrefix("dns64group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupName(name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupName(name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDns64groupResource_Prefix(t *testing.T) {
+	var resourceName = "nios_dns_dns64group.test_prefix"
+	var v dns.Dns64group
+	name := acctest.RandomNameWithPrefix("dns64group")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDns64groupPrefix(name, "2001:db8:64::/96"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prefix", "2001:db8:64::/96"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDns64groupPrefix(name, "2001:db8::/32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDns64groupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "prefix", "2001:db8::/32"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckDns64groupExists(ctx context.Context, resourceName string, v *dns.Dns64group) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			Dns64groupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDns64group).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDns64groupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDns64groupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckDns64groupDestroy(ctx context.Context, v *dns.Dns64group) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			Dns64groupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForDns64group).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckDns64groupDisappears(ctx context.Context, v *dns.Dns64group) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			Dns64groupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccDns64groupBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test" {
+    name = %q
+}
+`, name)
+}
+
+func testAccDns64groupClients(name string, clients []map[string]any) string {
+	clientsStr := utils.ConvertSliceOfMapsToHCL(clients)
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_clients" {
+    name = %q
+    clients = %s
+}
+`, name, clientsStr)
+}
+
+func testAccDns64groupComment(name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_comment" {
+    name = %q
+    comment = %q
+}
+`, name, comment)
+}
+
+func testAccDns64groupDisable(name, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_disable" {
+    name = %q
+    disable = %q
+}
+`, name, disable)
+}
+
+func testAccDns64groupEnableDnssecDns64(name, enableDnssecDns64 string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_enable_dnssec_dns64" {
+    name = %q
+    enable_dnssec_dns64 = %q
+}
+`, name, enableDnssecDns64)
+}
+
+func testAccDns64groupExclude(name string, exclude []map[string]any) string {
+	excludeStr := utils.ConvertSliceOfMapsToHCL(exclude)
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_exclude" {
+    name = %q
+    exclude = %s
+}
+`, name, excludeStr)
+}
+
+func testAccDns64groupExtAttrs(name string, extAttrs map[string]any) string {
+	extAttrsStr := utils.ConvertMapToHCL(extAttrs)
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_extattrs" {
+    name = %q
+    extattrs = %s
+}
+`, name, extAttrsStr)
+}
+
+func testAccDns64groupMapped(name string, mapped []map[string]any) string {
+	mappedStr := utils.ConvertSliceOfMapsToHCL(mapped)
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_mapped" {
+    name = %q
+    mapped = %s
+}
+`, name, mappedStr)
+}
+
+func testAccDns64groupName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_name" {
+    name = %q
+}
+`, name)
+}
+
+func testAccDns64groupPrefix(name, prefix string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dns64group" "test_prefix" {
+    name = %q
+    prefix = %q
+}
+`, name, prefix)
+}
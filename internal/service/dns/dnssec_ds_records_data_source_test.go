@@ -0,0 +1,71 @@
+package dns_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDnssecDsRecordsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_dnssec_ds_records.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnssecDsRecordsDataSourceConfigFilters(zoneFqdn, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.ref", "nios_dns_zone_auth.test", "ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.fqdn", zoneFqdn),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.is_dnssec_signed", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.dnskey_records.0.public_key"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.dnskey_records.0.protocol", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ds_records.0.digest"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.ds_records.0.digest_type", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnssecDsRecordsDataSource_DigestTypes(t *testing.T) {
+	dataSourceName := "data.nios_dns_dnssec_ds_records.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnssecDsRecordsDataSourceConfigFilters(zoneFqdn, "digest_types = [2, 4]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.ds_records.0.digest_type", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.ds_records.1.digest_type", "4"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccDnssecDsRecordsDataSourceConfigFilters(zoneFqdn, digestTypes string) string {
+	config := fmt.Sprintf(`
+data "nios_dns_dnssec_ds_records" "test" {
+	filters = {
+		fqdn = %q
+	}
+	%s
+	depends_on = [nios_dns_dnssec_operation.test]
+}
+`, zoneFqdn, digestTypes)
+	return strings.Join([]string{testAccDnssecOperationBasicConfig(zoneFqdn, "SIGN"), config}, "")
+}
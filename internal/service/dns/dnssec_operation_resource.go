@@ -0,0 +1,182 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDnssecOperation = "dnssec_ksk_rollover_date,dnssec_zsk_rollover_date,is_dnssec_signed"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DnssecOperationResource{}
+
+func NewDnssecOperationResource() resource.Resource {
+	return &DnssecOperationResource{}
+}
+
+// DnssecOperationResource runs a DNSSEC operation on an authoritative zone when it is created.
+// The operation is run again whenever the zone, the operation or the triggers change.
+type DnssecOperationResource struct {
+	client *niosclient.APIClient
+}
+
+// DnssecOperationResourceModel describes the resource data model, extending DnssecOperationModel with the operation timeouts.
+type DnssecOperationResourceModel struct {
+	DnssecOperationModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *DnssecOperationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_dnssec_operation"
+}
+
+func (r *DnssecOperationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a DNSSEC operation (sign, unsign or key signing key rollover) on an authoritative zone. Destroying the resource only removes it from the Terraform state and does not revert the operation.",
+		Attributes:          DnssecOperationResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *DnssecOperationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DnssecOperationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DnssecOperationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneRef := utils.ExtractResourceRef(data.Zone.ValueString())
+	baseUrl := utils.WAPIBaseURL(r.client.DNSAPI.Cfg)
+	body := map[string]string{"operation": data.Operation.ValueString()}
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		_, httpRes, callErr := utils.CallWAPIFunction(ctx, r.client.DNSAPI.Cfg.HTTPClient, baseUrl, zoneRef, "dnssec_operation", body)
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run DNSSEC operation %s on zone %s, got error: %s", data.Operation.ValueString(), zoneRef, err))
+		return
+	}
+
+	zone, _, err := r.readZone(ctx, zoneRef)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ZoneAuth after DNSSEC operation, got error: %s", err))
+		return
+	}
+
+	data.Flatten(ctx, zone, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnssecOperationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DnssecOperationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, httpRes, err := r.readZone(ctx, utils.ExtractResourceRef(data.Zone.ValueString()))
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ZoneAuth, got error: %s", err))
+		return
+	}
+
+	data.Flatten(ctx, zone, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnssecOperationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DnssecOperationResourceModel
+
+	// Every input requires replacement, so only the timeouts can change here
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnssecOperationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The operation cannot be undone, removing the resource from the state is sufficient
+}
+
+func (r *DnssecOperationResource) readZone(ctx context.Context, zoneRef string) (*dns.ZoneAuth, *http.Response, error) {
+	var (
+		httpRes *http.Response
+		apiRes  *dns.GetZoneAuthResponse
+	)
+
+	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.DNSAPI.
+			ZoneAuthAPI.
+			Read(ctx, zoneRef).
+			ReturnFieldsPlus(readableAttributesForDnssecOperation).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+	if err != nil {
+		return nil, httpRes, err
+	}
+
+	zone := apiRes.GetZoneAuthResponseObjectAsResult.GetResult()
+	return &zone, httpRes, nil
+}
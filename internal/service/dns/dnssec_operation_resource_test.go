@@ -0,0 +1,171 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDnssecOperation = "dnssec_ksk_rollover_date,dnssec_zsk_rollover_date,is_dnssec_signed"
+
+func TestAccDnssecOperationResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_dnssec_operation.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDnssecOperationBasicConfig(zoneFqdn, "SIGN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnssecOperationZoneSigned(context.Background(), resourceName, true),
+					resource.TestCheckResourceAttrPair(resourceName, "zone", "nios_dns_zone_auth.test", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "operation", "SIGN"),
+					resource.TestCheckResourceAttr(resourceName, "is_dnssec_signed", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "dnssec_ksk_rollover_date"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnssecOperationResource_Operation(t *testing.T) {
+	var resourceName = "nios_dns_dnssec_operation.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDnssecOperationBasicConfig(zoneFqdn, "SIGN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnssecOperationZoneSigned(context.Background(), resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "is_dnssec_signed", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDnssecOperationBasicConfig(zoneFqdn, "UNSIGN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnssecOperationZoneSigned(context.Background(), resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "operation", "UNSIGN"),
+					resource.TestCheckResourceAttr(resourceName, "is_dnssec_signed", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnssecOperationResource_Triggers(t *testing.T) {
+	var resourceName = "nios_dns_dnssec_operation.test_rollover"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDnssecOperationTriggers(zoneFqdn, "2026-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnssecOperationZoneSigned(context.Background(), resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "operation", "ROLLOVER_KSK"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rollover", "2026-01"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDnssecOperationTriggers(zoneFqdn, "2026-07"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDnssecOperationZoneSigned(context.Background(), resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "triggers.rollover", "2026-07"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckDnssecOperationZoneSigned(ctx context.Context, resourceName string, signed bool) resource.TestCheckFunc {
+	// Verify the signing state of the zone in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["zone"])).
+			ReturnFieldsPlus(readableAttributesForDnssecOperation).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetZoneAuthResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		zone := apiRes.GetZoneAuthResponseObjectAsResult.GetResult()
+		if zone.GetIsDnssecSigned() != signed {
+			return fmt.Errorf("expected is_dnssec_signed to be %t, got %t", signed, zone.GetIsDnssecSigned())
+		}
+		return nil
+	}
+}
+
+func testAccDnssecOperationZoneConfig(zoneFqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test" {
+	fqdn = %q
+	view = "default"
+	grid_primary = [
+		{
+			name = %q
+			stealth = false
+		}
+	]
+}
+`, zoneFqdn, utils.GetNIOSGridMasterHostName())
+}
+
+func testAccDnssecOperationBasicConfig(zoneFqdn, operation string) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_dnssec_operation" "test" {
+	zone = nios_dns_zone_auth.test.ref
+	operation = %q
+}
+`, operation)
+	return strings.Join([]string{testAccDnssecOperationZoneConfig(zoneFqdn), config}, "")
+}
+
+func testAccDnssecOperationTriggers(zoneFqdn, rollover string) string {
+	config := fmt.Sprintf(`
+resource "nios_dns_dnssec_operation" "test_sign" {
+	zone = nios_dns_zone_auth.test.ref
+	operation = "SIGN"
+}
+
+resource "nios_dns_dnssec_operation" "test_rollover" {
+	zone = nios_dns_zone_auth.test.ref
+	operation = "ROLLOVER_KSK"
+	triggers = {
+		rollover = %q
+	}
+	depends_on = [nios_dns_dnssec_operation.test_sign]
+}
+`, rollover)
+	return strings.Join([]string{testAccDnssecOperationZoneConfig(zoneFqdn), config}, "")
+}
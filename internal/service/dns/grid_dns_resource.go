@@ -0,0 +1,271 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForGridDns = "add_client_ip_mac_options,allow_bulkhost_ddns,allow_gss_tsig_zone_updates,allow_query,allow_recursive_query,allow_transfer,allow_update,anonymize_response_logging,attack_mitigation,auto_blackhole,bind_check_names_policy,bind_hostname_directive,blackhole_list,blacklist_action,blacklist_log_query,blacklist_redirect_addresses,blacklist_redirect_ttl,blacklist_rulesets,bulk_host_name_templates,capture_dns_queries_on_all_domains,check_names_for_ddns_and_zone_transfer,client_subnet_domains,client_subnet_ipv4_prefix_length,client_subnet_ipv6_prefix_length,copy_client_ip_mac_options,copy_xfer_to_notify,custom_root_name_servers,ddns_force_creation_timestamp_update,ddns_principal_group,ddns_principal_tracking,ddns_restrict_patterns,ddns_restrict_patterns_list,ddns_restrict_protected,ddns_restrict_secure,ddns_restrict_static,default_bulk_host_name_template,default_ttl,disable_edns,dns64_groups,dns_cache_acceleration_ttl,dns_health_check_anycast_control,dns_health_check_domain_list,dns_health_check_interval,dns_health_check_recursion_flag,dns_health_check_retries,dns_health_check_timeout,dns_query_capture_file_time_limit,dnssec_blacklist_enabled,dnssec_dns64_enabled,dnssec_enabled,dnssec_expired_signatures_enabled,dnssec_key_params,dnssec_negative_trust_anchors,dnssec_nxdomain_enabled,dnssec_rpz_enabled,dnssec_trusted_keys,dnssec_validation_enabled,dnstap_setting,domains_to_capture_dns_queries,dtc_dns_queries_specific_behavior,dtc_dnssec_mode,dtc_edns_prefer_client_subnet,dtc_topology_ea_list,edns_udp_size,email,enable_blackhole,enable_blacklist,enable_capture_dns_queries,enable_capture_dns_responses,enable_client_subnet_forwarding,enable_client_subnet_recursive,enable_delete_associated_ptr,enable_dns64,enable_dns_health_check,enable_dnstap_queries,enable_dnstap_responses,enable_dnstap_violations_tls,enable_excluded_domain_names,enable_fixed_rrset_order_fqdns,enable_ftc,enable_gss_tsig,enable_host_rrset_order,enable_hsm_signing,enable_notify_source_port,enable_query_rewrite,enable_query_source_port,excluded_domain_names,expire_after,filter_aaaa,filter_aaaa_list,fixed_rrset_order_fqdns,forward_only,forward_updates,forwarders,ftc_expired_record_timeout,ftc_expired_record_ttl,gen_eadb_from_hosts,gen_eadb_from_network_containers,gen_eadb_from_networks,gen_eadb_from_ranges,gss_tsig_keys,last_queried_acl,logging_categories,max_cache_ttl,max_cached_lifetime,max_ncache_ttl,max_udp_size,member_secondary_notify,negative_ttl,notify_delay,notify_source_port,nsgroup_default,nsgroups,nxdomain_log_query,nxdomain_redirect,nxdomain_redirect_addresses,nxdomain_redirect_addresses_v6,nxdomain_redirect_ttl,nxdomain_rulesets,preserve_host_rrset_order_on_secondaries,protocol_record_name_policies,query_rewrite_domain_names,query_rewrite_prefix,query_source_port,recursive_query_list,refresh_timer,resolver_query_timeout,response_rate_limiting,restart_setting,retry_timer,root_name_server_type,rpz_disable_nsdname_nsip,rpz_drop_ip_rule_enabled,rpz_drop_ip_rule_min_prefix_length_ipv4,rpz_drop_ip_rule_min_prefix_length_ipv6,rpz_qname_wait_recurse,scavenging_settings,serial_query_rate,server_id_directive,sortlist,store_locally,syslog_facility,transfer_excluded_servers,transfer_format,transfers_in,transfers_out,transfers_per_ns,zone_deletion_double_confirm"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GridDnsResource{}
+var _ resource.ResourceWithImportState = &GridDnsResource{}
+
+func NewGridDnsResource() resource.Resource {
+	return &GridDnsResource{}
+}
+
+// GridDnsResource defines the resource implementation.
+type GridDnsResource struct {
+	client *niosclient.APIClient
+}
+
+// GridDnsResourceModel describes the resource data model, extending GridDnsModel with the operation timeouts.
+type GridDnsResourceModel struct {
+	GridDnsModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *GridDnsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "grid_dns_properties"
+}
+
+func (r *GridDnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DNS properties of the Grid.",
+		Attributes:          GridDnsResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *GridDnsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GridDnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GridDnsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listRes, _, err := r.client.GridAPI.
+		GridDnsAPI.
+		List(ctx).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForGridDns).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list GridDns, got error: %s", err))
+		return
+	}
+
+	list := listRes.ListGridDnsResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", "No DNS properties exist in this Grid")
+		return
+	}
+
+	// Extract the singleton ref
+	listObj := list[0]
+
+	// Update it with desired plan
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *grid.UpdateGridDnsResponse
+
+	err = retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			GridDnsAPI.
+			Update(ctx, utils.ExtractResourceRef(listObj.GetRef())).
+			GridDns(*payload).
+			ReturnFieldsPlus(readableAttributesForGridDns).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if retry.IsAlreadyExistsErr(err) {
+			// Resource already exists, import required
+			resp.Diagnostics.AddError(
+				"Resource Already Exists",
+				fmt.Sprintf("Resource already exists, error: %s.\nPlease import the existing resource into terraform state.", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create GridDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateGridDnsResponseAsObject.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridDnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GridDnsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *grid.GetGridDnsResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			GridDnsAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForGridDns).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GridDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetGridDnsResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridDnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data GridDnsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var apiRes *grid.UpdateGridDnsResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			GridDnsAPI.
+			Update(ctx, resourceRef).
+			GridDns(*payload).
+			ReturnFieldsPlus(readableAttributesForGridDns).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update GridDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateGridDnsResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GridDnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The Grid DNS properties cannot be deleted, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *GridDnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
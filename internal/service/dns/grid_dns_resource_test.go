@@ -0,0 +1,183 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForGridDns = "add_client_ip_mac_options,allow_bulkhost_ddns,allow_gss_tsig_zone_updates,allow_query,allow_recursive_query,allow_transfer,allow_update,anonymize_response_logging,attack_mitigation,auto_blackhole,bind_check_names_policy,bind_hostname_directive,blackhole_list,blacklist_action,blacklist_log_query,blacklist_redirect_addresses,blacklist_redirect_ttl,blacklist_rulesets,bulk_host_name_templates,capture_dns_queries_on_all_domains,check_names_for_ddns_and_zone_transfer,client_subnet_domains,client_subnet_ipv4_prefix_length,client_subnet_ipv6_prefix_length,copy_client_ip_mac_options,copy_xfer_to_notify,custom_root_name_servers,ddns_force_creation_timestamp_update,ddns_principal_group,ddns_principal_tracking,ddns_restrict_patterns,ddns_restrict_patterns_list,ddns_restrict_protected,ddns_restrict_secure,ddns_restrict_static,default_bulk_host_name_template,default_ttl,disable_edns,dns64_groups,dns_cache_acceleration_ttl,dns_health_check_anycast_control,dns_health_check_domain_list,dns_health_check_interval,dns_health_check_recursion_flag,dns_health_check_retries,dns_health_check_timeout,dns_query_capture_file_time_limit,dnssec_blacklist_enabled,dnssec_dns64_enabled,dnssec_enabled,dnssec_expired_signatures_enabled,dnssec_key_params,dnssec_negative_trust_anchors,dnssec_nxdomain_enabled,dnssec_rpz_enabled,dnssec_trusted_keys,dnssec_validation_enabled,dnstap_setting,domains_to_capture_dns_queries,dtc_dns_queries_specific_behavior,dtc_dnssec_mode,dtc_edns_prefer_client_subnet,dtc_topology_ea_list,edns_udp_size,email,enable_blackhole,enable_blacklist,enable_capture_dns_queries,enable_capture_dns_responses,enable_client_subnet_forwarding,enable_client_subnet_recursive,enable_delete_associated_ptr,enable_dns64,enable_dns_health_check,enable_dnstap_queries,enable_dnstap_responses,enable_dnstap_violations_tls,enable_excluded_domain_names,enable_fixed_rrset_order_fqdns,enable_ftc,enable_gss_tsig,enable_host_rrset_order,enable_hsm_signing,enable_notify_source_port,enable_query_rewrite,enable_query_source_port,excluded_domain_names,expire_after,filter_aaaa,filter_aaaa_list,fixed_rrset_order_fqdns,forward_only,forward_updates,forwarders,ftc_expired_record_timeout,ftc_expired_record_ttl,gen_eadb_from_hosts,gen_eadb_from_network_containers,gen_eadb_from_networks,gen_eadb_from_ranges,gss_tsig_keys,last_queried_acl,logging_categories,max_cache_ttl,max_cached_lifetime,max_ncache_ttl,max_udp_size,member_secondary_notify,negative_ttl,notify_delay,notify_source_port,nsgroup_default,nsgroups,nxdomain_log_query,nxdomain_redirect,nxdomain_redirect_addresses,nxdomain_redirect_addresses_v6,nxdomain_redirect_ttl,nxdomain_rulesets,preserve_host_rrset_order_on_secondaries,protocol_record_name_policies,query_rewrite_domain_names,query_rewrite_prefix,query_source_port,recursive_query_list,refresh_timer,resolver_query_timeout,response_rate_limiting,restart_setting,retry_timer,root_name_server_type,rpz_disable_nsdname_nsip,rpz_drop_ip_rule_enabled,rpz_drop_ip_rule_min_prefix_length_ipv4,rpz_drop_ip_rule_min_prefix_length_ipv6,rpz_qname_wait_recurse,scavenging_settings,serial_query_rate,server_id_directive,sortlist,store_locally,syslog_facility,transfer_excluded_servers,transfer_format,transfers_in,transfers_out,transfers_per_ns,zone_deletion_double_confirm"
+
+func TestAccGridDnsResource_basic(t *testing.T) {
+	var resourceName = "nios_grid_dns_properties.test"
+	var v grid.GridDns
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDnsBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttrSet(resourceName, "allow_recursive_query"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridDnsResource_AllowRecursiveQuery(t *testing.T) {
+	var resourceName = "nios_grid_dns_properties.test_allow_recursive_query"
+	var v grid.GridDns
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDnsAllowRecursiveQuery(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_recursive_query", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridDnsAllowRecursiveQuery(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_recursive_query", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridDnsResource_Forwarders(t *testing.T) {
+	var resourceName = "nios_grid_dns_properties.test_forwarders"
+	var v grid.GridDns
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDnsForwarders(`["10.0.0.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.0", "10.0.0.1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridDnsForwarders(`["10.0.0.2", "10.0.0.3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.0", "10.0.0.2"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.1", "10.0.0.3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridDnsResource_NotifyDelay(t *testing.T) {
+	var resourceName = "nios_grid_dns_properties.test_notify_delay"
+	var v grid.GridDns
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridDnsNotifyDelay(10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "notify_delay", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridDnsNotifyDelay(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "notify_delay", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckGridDnsExists(ctx context.Context, resourceName string, v *grid.GridDns) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			GridDnsAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForGridDns).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetGridDnsResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetGridDnsResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccGridDnsBasicConfig() string {
+	return `
+resource "nios_grid_dns_properties" "test" {
+}
+`
+}
+
+func testAccGridDnsAllowRecursiveQuery(allowRecursiveQuery bool) string {
+	return fmt.Sprintf(`
+resource "nios_grid_dns_properties" "test_allow_recursive_query" {
+  allow_recursive_query = %t
+}
+`, allowRecursiveQuery)
+}
+
+func testAccGridDnsForwarders(forwarders string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_dns_properties" "test_forwarders" {
+  forwarders = %s
+}
+`, forwarders)
+}
+
+func testAccGridDnsNotifyDelay(notifyDelay int) string {
+	return fmt.Sprintf(`
+resource "nios_grid_dns_properties" "test_notify_delay" {
+  notify_delay = %d
+}
+`, notifyDelay)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -40,6 +41,15 @@ func NewIPAllocationResource() resource.Resource {
 // IPAllocationResource defines the resource implementation.
 type IPAllocationResource struct {
 	client *niosclient.APIClient
+	// managesDHCP is set when the DHCP settings of the addresses are managed by this resource
+	// instead of being left to nios_ip_association.
+	managesDHCP bool
+}
+
+// IPAllocationResourceModel describes the resource data model, extending IPAllocationModel with the operation timeouts.
+type IPAllocationResourceModel struct {
+	IPAllocationModel
+	Timeouts types.Object `tfsdk:"timeouts"`
 }
 
 type secretsHashState struct {
@@ -56,6 +66,7 @@ func (r *IPAllocationResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages IP Allocation for a DNS HOST Record",
 		Attributes:          IPAllocationResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -264,7 +275,7 @@ func (r *IPAllocationResource) ModifyPlan(ctx context.Context, req resource.Modi
 }
 
 func (r *IPAllocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data IPAllocationModel
+	var data IPAllocationResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -284,6 +295,10 @@ func (r *IPAllocationResource) ValidateConfig(ctx context.Context, req resource.
 		}
 	}
 
+	if r.managesDHCP {
+		return
+	}
+
 	if len(data.Ipv4addrs.Elements()) > 1 {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
@@ -354,7 +369,7 @@ func marshalSecretsEnvelope(state secretsHashState) ([]byte, error) {
 
 func (r *IPAllocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data IPAllocationModel
+	var data IPAllocationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -427,7 +442,11 @@ func (r *IPAllocationResource) Create(ctx context.Context, req resource.CreateRe
 
 	var apiRes *dns.CreateRecordHostResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network are serialized so that parallel resources get distinct addresses
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -504,7 +523,7 @@ func (r *IPAllocationResource) Create(ctx context.Context, req resource.CreateRe
 
 func (r *IPAllocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data IPAllocationModel
+	var data IPAllocationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -532,14 +551,14 @@ func (r *IPAllocationResource) Read(ctx context.Context, req resource.ReadReques
 		apiRes  *dns.GetRecordHostResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			RecordHostAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIPAllocation).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -604,7 +623,7 @@ func (r *IPAllocationResource) Read(ctx context.Context, req resource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *IPAllocationResource) ReadByExtAttrs(ctx context.Context, data *IPAllocationModel, resp *resource.ReadResponse) bool {
+func (r *IPAllocationResource) ReadByExtAttrs(ctx context.Context, data *IPAllocationResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -631,7 +650,7 @@ func (r *IPAllocationResource) ReadByExtAttrs(ctx context.Context, data *IPAlloc
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForIPAllocation).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordHost by extattrs, got error: %s", err))
@@ -662,7 +681,7 @@ func (r *IPAllocationResource) ReadByExtAttrs(ctx context.Context, data *IPAlloc
 
 func (r *IPAllocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data IPAllocationModel
+	var data IPAllocationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -717,7 +736,7 @@ func (r *IPAllocationResource) Update(ctx context.Context, req resource.UpdateRe
 	)
 
 	// Read current state from backend to preserve DHCP settings
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var callErr error
 
 		currentApiRes, httpRes, callErr = r.client.DNSAPI.
@@ -761,8 +780,10 @@ func (r *IPAllocationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	updateReq := data.Expand(ctx, &resp.Diagnostics)
-	// Preserve other settings
-	preserveDHCPSettings(updateReq, &currentHost)
+	// Preserve DHCP settings owned by nios_ip_association
+	if !r.managesDHCP {
+		preserveDHCPSettings(updateReq, &currentHost)
+	}
 
 	var (
 		authPwd   types.String
@@ -796,7 +817,11 @@ func (r *IPAllocationResource) Update(ctx context.Context, req resource.UpdateRe
 
 	var apiRes *dns.UpdateRecordHostResponse
 
-	err = retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network are serialized so that parallel resources get distinct addresses
+	unlock := utils.LockNextAvailable(updateReq)
+	defer unlock()
+
+	err = retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -895,7 +920,7 @@ func preserveDHCPSettings(updateReq *dns.RecordHost, currentHost *dns.RecordHost
 }
 
 func (r *IPAllocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data IPAllocationModel
+	var data IPAllocationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -908,7 +933,7 @@ func (r *IPAllocationResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	var httpRes *http.Response
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var callErr error
 		httpRes, callErr = r.client.DNSAPI.
 			RecordHostAPI.
@@ -936,7 +961,7 @@ func (r *IPAllocationResource) Delete(ctx context.Context, req resource.DeleteRe
 
 			resourceRef := utils.ExtractResourceRef(foundRef)
 			// Attempt delete using the foundRef
-			errDel := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+			errDel := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 				httpResDel, callErr := r.client.DNSAPI.
 					RecordHostAPI.
 					Delete(ctx, resourceRef).
@@ -967,7 +992,7 @@ func (r *IPAllocationResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "associate_internal_id", []byte("true"))...)
 }
 
-func (r *IPAllocationResource) findHostByInternalID(ctx context.Context, data *IPAllocationModel) (*dns.RecordHost, string, *http.Response, error) {
+func (r *IPAllocationResource) findHostByInternalID(ctx context.Context, data *IPAllocationResourceModel) (*dns.RecordHost, string, *http.Response, error) {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,6 +18,7 @@ import (
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	internaltypes "github.com/infobloxopen/terraform-provider-nios/internal/types"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
@@ -37,6 +39,12 @@ type IPAssociationResource struct {
 	client *niosclient.APIClient
 }
 
+// IPAssociationResourceModel describes the resource data model, extending IPAssociationModel with the operation timeouts.
+type IPAssociationResourceModel struct {
+	IPAssociationModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *IPAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ip_association"
 }
@@ -45,6 +53,7 @@ func (r *IPAssociationResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages IP Association for a DNS HOST Record",
 		Attributes:          IpAssociationResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -69,7 +78,7 @@ func (r *IPAssociationResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *IPAssociationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data IPAssociationModel
+	var data IPAssociationResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -90,7 +99,7 @@ func (r *IPAssociationResource) ValidateConfig(ctx context.Context, req resource
 }
 
 func (r *IPAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data IPAssociationModel
+	var data IPAssociationResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -107,7 +116,7 @@ func (r *IPAssociationResource) Create(ctx context.Context, req resource.CreateR
 	data.InternalID = types.StringValue(internalID)
 
 	// Update the host record with DHCP settings
-	updatedHostRec, err := r.updateHostRecord(ctx, hostRecord, &data)
+	updatedHostRec, err := r.updateHostRecord(ctx, hostRecord, &data, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to associate host record with DHCP settings, got error: %s", err))
 		return
@@ -120,7 +129,7 @@ func (r *IPAssociationResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *IPAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data IPAssociationModel
+	var data IPAssociationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,7 +154,7 @@ func (r *IPAssociationResource) Read(ctx context.Context, req resource.ReadReque
 
 func (r *IPAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data IPAssociationModel
+	var data IPAssociationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -175,7 +184,7 @@ func (r *IPAssociationResource) Update(ctx context.Context, req resource.UpdateR
 	data.Ref = types.StringValue(ref)
 	data.InternalID = types.StringValue(internalID)
 
-	updatedHostRec, err := r.updateHostRecord(ctx, hostRecord, &data)
+	updatedHostRec, err := r.updateHostRecord(ctx, hostRecord, &data, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DHCP settings, got error: %s", err))
 		return
@@ -188,7 +197,7 @@ func (r *IPAssociationResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *IPAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data IPAssociationModel
+	var data IPAssociationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -209,13 +218,15 @@ func (r *IPAssociationResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Clear DHCP settings (reset to defaults) but keep the host record
-	clearData := IPAssociationModel{
-		MacAddr:          internaltypes.NewMACAddressValue(""),
-		Duid:             internaltypes.NewDUIDValue(""),
-		ConfigureForDhcp: types.BoolValue(false),
+	clearData := IPAssociationResourceModel{
+		IPAssociationModel: IPAssociationModel{
+			MacAddr:          internaltypes.NewMACAddressValue(""),
+			Duid:             internaltypes.NewDUIDValue(""),
+			ConfigureForDhcp: types.BoolValue(false),
+		},
 	}
 
-	_, err = r.updateHostRecord(ctx, hostRecord, &clearData)
+	_, err = r.updateHostRecord(ctx, hostRecord, &clearData, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear DHCP settings, got error: %s", err))
 		return
@@ -226,7 +237,7 @@ func (r *IPAssociationResource) ImportState(ctx context.Context, req resource.Im
 	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
 }
 
-func (r *IPAssociationResource) getOrFindHostRecord(ctx context.Context, data *IPAssociationModel) (*dns.RecordHost, string, string, bool, error) {
+func (r *IPAssociationResource) getOrFindHostRecord(ctx context.Context, data *IPAssociationResourceModel) (*dns.RecordHost, string, string, bool, error) {
 	// Always try ref first if it exists
 	if !data.Ref.IsNull() && !data.Ref.IsUnknown() && data.Ref.ValueString() != "" {
 		hostRecord, notFound, err := r.getHostRecordByRef(ctx, data.Ref.ValueString())
@@ -284,7 +295,7 @@ func (r *IPAssociationResource) getHostRecordByRef(ctx context.Context, ref stri
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIPAssociation).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -345,7 +356,7 @@ func (r *IPAssociationResource) getHostRecordByInternalID(ctx context.Context, i
 	return &results[0], false, nil
 }
 
-func (r *IPAssociationResource) updateHostRecord(ctx context.Context, hostRec *dns.RecordHost, data *IPAssociationModel) (*dns.RecordHost, error) {
+func (r *IPAssociationResource) updateHostRecord(ctx context.Context, hostRec *dns.RecordHost, data *IPAssociationResourceModel, timeout time.Duration) (*dns.RecordHost, error) {
 	// Build update request preserving all existing settings except DHCP
 	updateReq := *hostRec
 
@@ -400,7 +411,7 @@ func (r *IPAssociationResource) updateHostRecord(ctx context.Context, hostRec *d
 		apiRes  *dns.UpdateRecordHostResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeout, nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			RecordHostAPI.
@@ -424,7 +435,7 @@ func (r *IPAssociationResource) updateHostRecord(ctx context.Context, hostRec *d
 	return &result, nil
 }
 
-func (r *IPAssociationResource) flattenDHCPData(hostRec *dns.RecordHost, data IPAssociationModel) IPAssociationModel {
+func (r *IPAssociationResource) flattenDHCPData(hostRec *dns.RecordHost, data IPAssociationResourceModel) IPAssociationResourceModel {
 	// Extract DHCP settings from IPv4 addresses
 	if hostRec != nil && len(hostRec.Ipv4addrs) > 0 {
 		ipv4 := hostRec.Ipv4addrs[0]
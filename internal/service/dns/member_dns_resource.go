@@ -0,0 +1,274 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMemberDns = "add_client_ip_mac_options,additional_ip_list_struct,allow_gss_tsig_zone_updates,allow_query,allow_recursive_query,allow_transfer,allow_update,anonymize_response_logging,atc_fwd_enable,attack_mitigation,auto_blackhole,auto_create_a_and_ptr_for_lan2,auto_create_aaaa_and_ipv6ptr_for_lan2,auto_sort_views,bind_check_names_policy,bind_hostname_directive,bind_hostname_directive_fqdn,blackhole_list,blacklist_action,blacklist_log_query,blacklist_redirect_addresses,blacklist_redirect_ttl,blacklist_rulesets,capture_dns_queries_on_all_domains,check_names_for_ddns_and_zone_transfer,copy_client_ip_mac_options,copy_xfer_to_notify,custom_root_name_servers,disable_edns,dns64_groups,dns_cache_acceleration_status,dns_cache_acceleration_ttl,dns_health_check_anycast_control,dns_health_check_domain_list,dns_health_check_interval,dns_health_check_recursion_flag,dns_health_check_retries,dns_health_check_timeout,dns_notify_transfer_source,dns_notify_transfer_source_address,dns_over_tls_service,dns_query_capture_file_time_limit,dns_query_source_address,dns_query_source_interface,dns_view_address_settings,dnssec_blacklist_enabled,dnssec_dns64_enabled,dnssec_enabled,dnssec_expired_signatures_enabled,dnssec_negative_trust_anchors,dnssec_nxdomain_enabled,dnssec_rpz_enabled,dnssec_trusted_keys,dnssec_validation_enabled,dnstap_setting,doh_https_session_duration,doh_service,domains_to_capture_dns_queries,dtc_dns_queries_specific_behavior,dtc_edns_prefer_client_subnet,dtc_health_source,dtc_health_source_address,edns_udp_size,enable_blackhole,enable_blacklist,enable_capture_dns_queries,enable_capture_dns_responses,enable_dns,enable_dns64,enable_dns_cache_acceleration,enable_dns_health_check,enable_dnstap_queries,enable_dnstap_responses,enable_dnstap_violations_tls,enable_excluded_domain_names,enable_fixed_rrset_order_fqdns,enable_ftc,enable_gss_tsig,enable_notify_source_port,enable_query_rewrite,enable_query_source_port,excluded_domain_names,filter_aaaa,filter_aaaa_list,fixed_rrset_order_fqdns,forward_only,forward_updates,forwarders,ftc_expired_record_timeout,ftc_expired_record_ttl,glue_record_addresses,gss_tsig_keys,host_name,ipv4addr,ipv6_glue_record_addresses,ipv6addr,logging_categories,max_cache_ttl,max_cached_lifetime,max_ncache_ttl,max_udp_size,minimal_resp,notify_delay,notify_source_port,nxdomain_log_query,nxdomain_redirect,nxdomain_redirect_addresses,nxdomain_redirect_addresses_v6,nxdomain_redirect_ttl,nxdomain_rulesets,query_source_port,record_name_policy,recursive_client_limit,recursive_query_list,recursive_resolver,resolver_query_timeout,response_rate_limiting,root_name_server_type,rpz_disable_nsdname_nsip,rpz_drop_ip_rule_enabled,rpz_drop_ip_rule_min_prefix_length_ipv4,rpz_drop_ip_rule_min_prefix_length_ipv6,rpz_qname_wait_recurse,serial_query_rate,server_id_directive,server_id_directive_string,skip_in_grid_rpz_queries,sortlist,store_locally,syslog_facility,tcp_idle_timeout,tls_session_duration,transfer_excluded_servers,transfer_format,transfers_in,transfers_out,transfers_per_ns,upstream_address_family_preference,use_add_client_ip_mac_options,use_allow_query,use_allow_transfer,use_attack_mitigation,use_auto_blackhole,use_bind_hostname_directive,use_blackhole,use_blacklist,use_capture_dns_queries_on_all_domains,use_copy_client_ip_mac_options,use_copy_xfer_to_notify,use_disable_edns,use_dns64,use_dns_cache_acceleration_ttl,use_dns_health_check,use_dnssec,use_dnstap_setting,use_dtc_dns_queries_specific_behavior,use_dtc_edns_prefer_client_subnet,use_edns_udp_size,use_enable_capture_dns,use_enable_excluded_domain_names,use_enable_gss_tsig,use_enable_query_rewrite,use_filter_aaaa,use_fixed_rrset_order_fqdns,use_forward_updates,use_forwarders,use_ftc,use_gss_tsig_keys,use_lan2_ipv6_port,use_lan2_port,use_lan_ipv6_port,use_lan_port,use_logging_categories,use_max_cache_ttl,use_max_cached_lifetime,use_max_ncache_ttl,use_max_udp_size,use_mgmt_ipv6_port,use_mgmt_port,use_notify_delay,use_nxdomain_redirect,use_record_name_policy,use_recursive_client_limit,use_recursive_query_setting,use_resolver_query_timeout,use_response_rate_limiting,use_root_name_server,use_root_server_for_all_views,use_rpz_disable_nsdname_nsip,use_rpz_drop_ip_rule,use_rpz_qname_wait_recurse,use_serial_query_rate,use_server_id_directive,use_sortlist,use_source_ports,use_syslog_facility,use_transfers_in,use_transfers_out,use_transfers_per_ns,use_update_setting,use_zone_transfer_format,views"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MemberDnsResource{}
+var _ resource.ResourceWithImportState = &MemberDnsResource{}
+
+func NewMemberDnsResource() resource.Resource {
+	return &MemberDnsResource{}
+}
+
+// MemberDnsResource defines the resource implementation.
+type MemberDnsResource struct {
+	client *niosclient.APIClient
+}
+
+// MemberDnsResourceModel describes the resource data model, extending MemberDnsModel with the operation timeouts.
+type MemberDnsResourceModel struct {
+	MemberDnsModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *MemberDnsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "grid_member_dns"
+}
+
+func (r *MemberDnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DNS properties of a Grid member.",
+		Attributes:          MemberDnsResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *MemberDnsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MemberDnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MemberDnsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listRes, _, err := r.client.GridAPI.
+		MemberDnsAPI.
+		List(ctx).
+		Filters(map[string]interface{}{
+			"host_name": data.HostName.ValueString(),
+		}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForMemberDns).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list MemberDns, got error: %s", err))
+		return
+	}
+
+	list := listRes.ListMemberDnsResponseObject.GetResult()
+
+	if len(list) == 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No DNS properties exist for the member %s", data.HostName.ValueString()))
+		return
+	}
+
+	// Extract the singleton ref
+	listObj := list[0]
+
+	// Update it with desired plan
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *grid.UpdateMemberDnsResponse
+
+	err = retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			MemberDnsAPI.
+			Update(ctx, utils.ExtractResourceRef(listObj.GetRef())).
+			MemberDns(*payload).
+			ReturnFieldsPlus(readableAttributesForMemberDns).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if retry.IsAlreadyExistsErr(err) {
+			// Resource already exists, import required
+			resp.Diagnostics.AddError(
+				"Resource Already Exists",
+				fmt.Sprintf("Resource already exists, error: %s.\nPlease import the existing resource into terraform state.", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MemberDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMemberDnsResponseAsObject.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberDnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MemberDnsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *grid.GetMemberDnsResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			MemberDnsAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForMemberDns).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MemberDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetMemberDnsResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberDnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data MemberDnsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var apiRes *grid.UpdateMemberDnsResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.GridAPI.
+			MemberDnsAPI.
+			Update(ctx, resourceRef).
+			MemberDns(*payload).
+			ReturnFieldsPlus(readableAttributesForMemberDns).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MemberDns, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateMemberDnsResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MemberDnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The member DNS properties cannot be deleted, so just clear state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *MemberDnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
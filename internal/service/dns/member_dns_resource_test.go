@@ -0,0 +1,195 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Grid Members: the Grid Master
+
+var readableAttributesForMemberDns = "add_client_ip_mac_options,additional_ip_list_struct,allow_gss_tsig_zone_updates,allow_query,allow_recursive_query,allow_transfer,allow_update,anonymize_response_logging,atc_fwd_enable,attack_mitigation,auto_blackhole,auto_create_a_and_ptr_for_lan2,auto_create_aaaa_and_ipv6ptr_for_lan2,auto_sort_views,bind_check_names_policy,bind_hostname_directive,bind_hostname_directive_fqdn,blackhole_list,blacklist_action,blacklist_log_query,blacklist_redirect_addresses,blacklist_redirect_ttl,blacklist_rulesets,capture_dns_queries_on_all_domains,check_names_for_ddns_and_zone_transfer,copy_client_ip_mac_options,copy_xfer_to_notify,custom_root_name_servers,disable_edns,dns64_groups,dns_cache_acceleration_status,dns_cache_acceleration_ttl,dns_health_check_anycast_control,dns_health_check_domain_list,dns_health_check_interval,dns_health_check_recursion_flag,dns_health_check_retries,dns_health_check_timeout,dns_notify_transfer_source,dns_notify_transfer_source_address,dns_over_tls_service,dns_query_capture_file_time_limit,dns_query_source_address,dns_query_source_interface,dns_view_address_settings,dnssec_blacklist_enabled,dnssec_dns64_enabled,dnssec_enabled,dnssec_expired_signatures_enabled,dnssec_negative_trust_anchors,dnssec_nxdomain_enabled,dnssec_rpz_enabled,dnssec_trusted_keys,dnssec_validation_enabled,dnstap_setting,doh_https_session_duration,doh_service,domains_to_capture_dns_queries,dtc_dns_queries_specific_behavior,dtc_edns_prefer_client_subnet,dtc_health_source,dtc_health_source_address,edns_udp_size,enable_blackhole,enable_blacklist,enable_capture_dns_queries,enable_capture_dns_responses,enable_dns,enable_dns64,enable_dns_cache_acceleration,enable_dns_health_check,enable_dnstap_queries,enable_dnstap_responses,enable_dnstap_violations_tls,enable_excluded_domain_names,enable_fixed_rrset_order_fqdns,enable_ftc,enable_gss_tsig,enable_notify_source_port,enable_query_rewrite,enable_query_source_port,excluded_domain_names,filter_aaaa,filter_aaaa_list,fixed_rrset_order_fqdns,forward_only,forward_updates,forwarders,ftc_expired_record_timeout,ftc_expired_record_ttl,glue_record_addresses,gss_tsig_keys,host_name,ipv4addr,ipv6_glue_record_addresses,ipv6addr,logging_categories,max_cache_ttl,max_cached_lifetime,max_ncache_ttl,max_udp_size,minimal_resp,notify_delay,notify_source_port,nxdomain_log_query,nxdomain_redirect,nxdomain_redirect_addresses,nxdomain_redirect_addresses_v6,nxdomain_redirect_ttl,nxdomain_rulesets,query_source_port,record_name_policy,recursive_client_limit,recursive_query_list,recursive_resolver,resolver_query_timeout,response_rate_limiting,root_name_server_type,rpz_disable_nsdname_nsip,rpz_drop_ip_rule_enabled,rpz_drop_ip_rule_min_prefix_length_ipv4,rpz_drop_ip_rule_min_prefix_length_ipv6,rpz_qname_wait_recurse,serial_query_rate,server_id_directive,server_id_directive_string,skip_in_grid_rpz_queries,sortlist,store_locally,syslog_facility,tcp_idle_timeout,tls_session_duration,transfer_excluded_servers,transfer_format,transfers_in,transfers_out,transfers_per_ns,upstream_address_family_preference,use_add_client_ip_mac_options,use_allow_query,use_allow_transfer,use_attack_mitigation,use_auto_blackhole,use_bind_hostname_directive,use_blackhole,use_blacklist,use_capture_dns_queries_on_all_domains,use_copy_client_ip_mac_options,use_copy_xfer_to_notify,use_disable_edns,use_dns64,use_dns_cache_acceleration_ttl,use_dns_health_check,use_dnssec,use_dnstap_setting,use_dtc_dns_queries_specific_behavior,use_dtc_edns_prefer_client_subnet,use_edns_udp_size,use_enable_capture_dns,use_enable_excluded_domain_names,use_enable_gss_tsig,use_enable_query_rewrite,use_filter_aaaa,use_fixed_rrset_order_fqdns,use_forward_updates,use_forwarders,use_ftc,use_gss_tsig_keys,use_lan2_ipv6_port,use_lan2_port,use_lan_ipv6_port,use_lan_port,use_logging_categories,use_max_cache_ttl,use_max_cached_lifetime,use_max_ncache_ttl,use_max_udp_size,use_mgmt_ipv6_port,use_mgmt_port,use_notify_delay,use_nxdomain_redirect,use_record_name_policy,use_recursive_client_limit,use_recursive_query_setting,use_resolver_query_timeout,use_response_rate_limiting,use_root_name_server,use_root_server_for_all_views,use_rpz_disable_nsdname_nsip,use_rpz_drop_ip_rule,use_rpz_qname_wait_recurse,use_serial_query_rate,use_server_id_directive,use_sortlist,use_source_ports,use_syslog_facility,use_transfers_in,use_transfers_out,use_transfers_per_ns,use_update_setting,use_zone_transfer_format,views"
+
+func TestAccMemberDnsResource_basic(t *testing.T) {
+	var resourceName = "nios_grid_member_dns.test"
+	var v grid.MemberDns
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDnsBasicConfig(hostName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "host_name", hostName),
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttrSet(resourceName, "allow_recursive_query"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberDnsResource_AllowRecursiveQuery(t *testing.T) {
+	var resourceName = "nios_grid_member_dns.test_allow_recursive_query"
+	var v grid.MemberDns
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDnsAllowRecursiveQuery(hostName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_recursive_query", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberDnsAllowRecursiveQuery(hostName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_recursive_query", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberDnsResource_Forwarders(t *testing.T) {
+	var resourceName = "nios_grid_member_dns.test_forwarders"
+	var v grid.MemberDns
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDnsForwarders(hostName, `["10.0.0.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.0", "10.0.0.1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberDnsForwarders(hostName, `["10.0.0.2", "10.0.0.3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.0", "10.0.0.2"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.1", "10.0.0.3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberDnsResource_NotifyDelay(t *testing.T) {
+	var resourceName = "nios_grid_member_dns.test_notify_delay"
+	var v grid.MemberDns
+	hostName := utils.GetNIOSGridMasterHostName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberDnsNotifyDelay(hostName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "notify_delay", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberDnsNotifyDelay(hostName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "notify_delay", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckMemberDnsExists(ctx context.Context, resourceName string, v *grid.MemberDns) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			MemberDnsAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForMemberDns).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetMemberDnsResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetMemberDnsResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccMemberDnsBasicConfig(hostName string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dns" "test" {
+  host_name = %q
+}
+`, hostName)
+}
+
+func testAccMemberDnsAllowRecursiveQuery(hostName string, allowRecursiveQuery bool) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dns" "test_allow_recursive_query" {
+  host_name             = %q
+  allow_recursive_query = %t
+}
+`, hostName, allowRecursiveQuery)
+}
+
+func testAccMemberDnsForwarders(hostName string, forwarders string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dns" "test_forwarders" {
+  host_name  = %q
+  forwarders = %s
+}
+`, hostName, forwarders)
+}
+
+func testAccMemberDnsNotifyDelay(hostName string, notifyDelay int) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_dns" "test_notify_delay" {
+  host_name    = %q
+  notify_delay = %d
+}
+`, hostName, notifyDelay)
+}
@@ -0,0 +1,148 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type AllrecordsModel struct {
+	Ref           types.String `tfsdk:"ref"`
+	Address       types.String `tfsdk:"address"`
+	Comment       types.String `tfsdk:"comment"`
+	Creator       types.String `tfsdk:"creator"`
+	DdnsPrincipal types.String `tfsdk:"ddns_principal"`
+	DdnsProtected types.Bool   `tfsdk:"ddns_protected"`
+	Disable       types.Bool   `tfsdk:"disable"`
+	DtcObscured   types.String `tfsdk:"dtc_obscured"`
+	Name          types.String `tfsdk:"name"`
+	Reclaimable   types.Bool   `tfsdk:"reclaimable"`
+	Record        types.String `tfsdk:"record"`
+	Ttl           types.Int64  `tfsdk:"ttl"`
+	Type          types.String `tfsdk:"type"`
+	View          types.String `tfsdk:"view"`
+	Zone          types.String `tfsdk:"zone"`
+}
+
+var AllrecordsAttrTypes = map[string]attr.Type{
+	"ref":            types.StringType,
+	"address":        types.StringType,
+	"comment":        types.StringType,
+	"creator":        types.StringType,
+	"ddns_principal": types.StringType,
+	"ddns_protected": types.BoolType,
+	"disable":        types.BoolType,
+	"dtc_obscured":   types.StringType,
+	"name":           types.StringType,
+	"reclaimable":    types.BoolType,
+	"record":         types.StringType,
+	"ttl":            types.Int64Type,
+	"type":           types.StringType,
+	"view":           types.StringType,
+	"zone":           types.StringType,
+}
+
+var AllrecordsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"address": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record address.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record comment.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record creator.",
+	},
+	"ddns_principal": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The GSS-TSIG principal that owns this record.",
+	},
+	"ddns_protected": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the DDNS updates for this record are allowed or not.",
+	},
+	"disable": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "The disable value determines if the record is disabled or not. \"False\" means the record is enabled.",
+	},
+	"dtc_obscured": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The specific LBDN record.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the record.",
+	},
+	"reclaimable": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the record is reclaimable or not.",
+	},
+	"record": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record object, if supported by the WAPI. Otherwise, the value is \"None\".",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for which the record is valid or being cached. The 32-bit unsigned integer represents the duration in seconds. Zero indicates that the record should not be cached.",
+	},
+	"type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record type. When searching for an unspecified record type, the search is performed for all records. On retrieval, the appliance returns \"UNSUPPORTED\" for unsupported records.",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name of the DNS View in which the record resides.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name of the zone in which the record resides.",
+	},
+}
+
+func FlattenAllrecords(ctx context.Context, from *dns.Allrecords, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(AllrecordsAttrTypes)
+	}
+	m := AllrecordsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, AllrecordsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *AllrecordsModel) Flatten(ctx context.Context, from *dns.Allrecords, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = AllrecordsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Address = flex.FlattenStringPointer(from.Address)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DdnsPrincipal = flex.FlattenStringPointer(from.DdnsPrincipal)
+	m.DdnsProtected = types.BoolPointerValue(from.DdnsProtected)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DtcObscured = flex.FlattenStringPointer(from.DtcObscured)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Reclaimable = types.BoolPointerValue(from.Reclaimable)
+	m.Record = flex.FlattenStringPointer(from.Record)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.Type = flex.FlattenStringPointer(from.Type)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
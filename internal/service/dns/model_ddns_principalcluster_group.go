@@ -0,0 +1,97 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	internaltypes "github.com/infobloxopen/terraform-provider-nios/internal/types"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type DdnsPrincipalclusterGroupModel struct {
+	Ref      types.String                     `tfsdk:"ref"`
+	Clusters internaltypes.UnorderedListValue `tfsdk:"clusters"`
+	Comment  types.String                     `tfsdk:"comment"`
+	Name     types.String                     `tfsdk:"name"`
+}
+
+var DdnsPrincipalclusterGroupAttrTypes = map[string]attr.Type{
+	"ref":      types.StringType,
+	"clusters": internaltypes.UnorderedListOfStringType,
+	"comment":  types.StringType,
+	"name":     types.StringType,
+}
+
+var DdnsPrincipalclusterGroupResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"clusters": schema.ListAttribute{
+		CustomType:          internaltypes.UnorderedListOfStringType,
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The list of equivalent DDNS principal clusters. Clusters are added to the group through the `group` attribute of `nios_dns_ddns_principalcluster`.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(0, 256),
+		},
+		MarkdownDescription: "Comment for the DDNS Principal Cluster Group.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of this DDNS Principal Cluster Group.",
+	},
+}
+
+func (m *DdnsPrincipalclusterGroupModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.DdnsPrincipalclusterGroup {
+	if m == nil {
+		return nil
+	}
+	to := &dns.DdnsPrincipalclusterGroup{
+		Comment: flex.ExpandStringPointer(m.Comment),
+		Name:    flex.ExpandStringPointer(m.Name),
+	}
+	return to
+}
+
+func FlattenDdnsPrincipalclusterGroup(ctx context.Context, from *dns.DdnsPrincipalclusterGroup, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DdnsPrincipalclusterGroupAttrTypes)
+	}
+	m := DdnsPrincipalclusterGroupModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DdnsPrincipalclusterGroupAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DdnsPrincipalclusterGroupModel) Flatten(ctx context.Context, from *dns.DdnsPrincipalclusterGroup, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DdnsPrincipalclusterGroupModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Clusters = flex.FlattenFrameworkUnorderedList(ctx, types.StringType, from.Clusters, diags)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Name = flex.FlattenStringPointer(from.Name)
+}
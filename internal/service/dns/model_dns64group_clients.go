@@ -0,0 +1,93 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type Dns64groupClientsModel struct {
+	Address    types.String `tfsdk:"address"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+var Dns64groupClientsAttrTypes = map[string]attr.Type{
+	"address":    types.StringType,
+	"permission": types.StringType,
+}
+
+var Dns64groupClientsResourceSchemaAttributes = map[string]schema.Attribute{
+	"address": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The address this rule applies to or \"Any\".",
+	},
+	"permission": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("ALLOW", "DENY"),
+		},
+		Default:             stringdefault.StaticString("ALLOW"),
+		MarkdownDescription: "The permission to use for this address.",
+	},
+}
+
+func ExpandDns64groupClients(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.Dns64groupClients {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m Dns64groupClientsModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *Dns64groupClientsModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.Dns64groupClients {
+	if m == nil {
+		return nil
+	}
+	to := &dns.Dns64groupClients{
+		Address:    flex.ExpandStringPointer(m.Address),
+		Permission: flex.ExpandStringPointer(m.Permission),
+	}
+	return to
+}
+
+func FlattenDns64groupClients(ctx context.Context, from *dns.Dns64groupClients, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(Dns64groupClientsAttrTypes)
+	}
+	m := Dns64groupClientsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, Dns64groupClientsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *Dns64groupClientsModel) Flatten(ctx context.Context, from *dns.Dns64groupClients, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = Dns64groupClientsModel{}
+	}
+	m.Address = flex.FlattenStringPointer(from.Address)
+	m.Permission = flex.FlattenStringPointer(from.Permission)
+}
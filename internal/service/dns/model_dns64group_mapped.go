@@ -0,0 +1,93 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type Dns64groupMappedModel struct {
+	Address    types.String `tfsdk:"address"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+var Dns64groupMappedAttrTypes = map[string]attr.Type{
+	"address":    types.StringType,
+	"permission": types.StringType,
+}
+
+var Dns64groupMappedResourceSchemaAttributes = map[string]schema.Attribute{
+	"address": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The address this rule applies to or \"Any\".",
+	},
+	"permission": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("ALLOW", "DENY"),
+		},
+		Default:             stringdefault.StaticString("ALLOW"),
+		MarkdownDescription: "The permission to use for this address.",
+	},
+}
+
+func ExpandDns64groupMapped(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.Dns64groupMapped {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m Dns64groupMappedModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *Dns64groupMappedModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.Dns64groupMapped {
+	if m == nil {
+		return nil
+	}
+	to := &dns.Dns64groupMapped{
+		Address:    flex.ExpandStringPointer(m.Address),
+		Permission: flex.ExpandStringPointer(m.Permission),
+	}
+	return to
+}
+
+func FlattenDns64groupMapped(ctx context.Context, from *dns.Dns64groupMapped, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(Dns64groupMappedAttrTypes)
+	}
+	m := Dns64groupMappedModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, Dns64groupMappedAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *Dns64groupMappedModel) Flatten(ctx context.Context, from *dns.Dns64groupMapped, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = Dns64groupMappedModel{}
+	}
+	m.Address = flex.FlattenStringPointer(from.Address)
+	m.Permission = flex.FlattenStringPointer(from.Permission)
+}
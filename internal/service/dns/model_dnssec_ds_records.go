@@ -0,0 +1,159 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+type DnssecDsRecordsModel struct {
+	Ref                   types.String `tfsdk:"ref"`
+	Fqdn                  types.String `tfsdk:"fqdn"`
+	View                  types.String `tfsdk:"view"`
+	IsDnssecSigned        types.Bool   `tfsdk:"is_dnssec_signed"`
+	DnssecKskRolloverDate types.Int64  `tfsdk:"dnssec_ksk_rollover_date"`
+	DnssecZskRolloverDate types.Int64  `tfsdk:"dnssec_zsk_rollover_date"`
+	DnskeyRecords         types.List   `tfsdk:"dnskey_records"`
+	DsRecords             types.List   `tfsdk:"ds_records"`
+}
+
+var DnssecDsRecordsAttrTypes = map[string]attr.Type{
+	"ref":                      types.StringType,
+	"fqdn":                     types.StringType,
+	"view":                     types.StringType,
+	"is_dnssec_signed":         types.BoolType,
+	"dnssec_ksk_rollover_date": types.Int64Type,
+	"dnssec_zsk_rollover_date": types.Int64Type,
+	"dnskey_records":           types.ListType{ElemType: types.ObjectType{AttrTypes: DnssecDsRecordsDnskeyRecordsAttrTypes}},
+	"ds_records":               types.ListType{ElemType: types.ObjectType{AttrTypes: DnssecDsRecordsDsRecordsAttrTypes}},
+}
+
+var DnssecDsRecordsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the zone.",
+	},
+	"fqdn": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in FQDN format.",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS view in which the zone resides.",
+	},
+	"is_dnssec_signed": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the zone is DNSSEC signed.",
+	},
+	"dnssec_ksk_rollover_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "This field gives the date in Epoch seconds format when the next key signing key rollover for the zone is due.",
+	},
+	"dnssec_zsk_rollover_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "This field gives the date in Epoch seconds format when the next zone signing key rollover for the zone is due.",
+	},
+	"dnskey_records": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: DnssecDsRecordsDnskeyRecordsResourceSchemaAttributes,
+		},
+		Computed:            true,
+		MarkdownDescription: "The DNSKEY set of the zone.",
+	},
+	"ds_records": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: DnssecDsRecordsDsRecordsResourceSchemaAttributes,
+		},
+		Computed:            true,
+		MarkdownDescription: "The DS records to publish in the parent zone, one per key signing key and digest type.",
+	},
+}
+
+// FlattenDnssecDsRecords returns a flatten function computing the DS records with the given digest types
+func FlattenDnssecDsRecords(digestTypes []int64) func(ctx context.Context, from *dns.ZoneAuth, diags *diag.Diagnostics) types.Object {
+	return func(ctx context.Context, from *dns.ZoneAuth, diags *diag.Diagnostics) types.Object {
+		if from == nil {
+			return types.ObjectNull(DnssecDsRecordsAttrTypes)
+		}
+		m := DnssecDsRecordsModel{}
+		m.Flatten(ctx, from, digestTypes, diags)
+		t, d := types.ObjectValueFrom(ctx, DnssecDsRecordsAttrTypes, m)
+		diags.Append(d...)
+		return t
+	}
+}
+
+func (m *DnssecDsRecordsModel) Flatten(ctx context.Context, from *dns.ZoneAuth, digestTypes []int64, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DnssecDsRecordsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Fqdn = flex.FlattenStringPointer(from.Fqdn)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.IsDnssecSigned = types.BoolPointerValue(from.IsDnssecSigned)
+	m.DnssecKskRolloverDate = flex.FlattenInt64Pointer(from.DnssecKskRolloverDate)
+	m.DnssecZskRolloverDate = flex.FlattenInt64Pointer(from.DnssecZskRolloverDate)
+
+	// Reverse zones are returned in address/cidr format, the records are owned by their arpa name
+	owner, err := utils.ZoneOwnerName(from.GetFqdn(), from.GetPrefix())
+	if err != nil {
+		diags.AddError("Invalid Zone Name", fmt.Sprintf("Unable to compute the owner name of zone %s, got error: %s", from.GetFqdn(), err))
+		return
+	}
+	var dnskeys []DnssecDsRecordsDnskeyRecordsModel
+	var dsRecords []DnssecDsRecordsDsRecordsModel
+	for _, key := range from.DnssecKeys {
+		algorithm, err := strconv.ParseInt(key.GetAlgorithm(), 10, 64)
+		if err != nil {
+			diags.AddError("Invalid DNSSEC Key", fmt.Sprintf("Unable to parse algorithm %q of key %d in zone %s", key.GetAlgorithm(), key.GetTag(), owner))
+			return
+		}
+		flags := utils.DNSKEYFlags(key.GetType())
+		dnskeys = append(dnskeys, DnssecDsRecordsDnskeyRecordsModel{
+			KeyTag:        flex.FlattenInt64Pointer(key.Tag),
+			Type:          flex.FlattenStringPointer(key.Type),
+			Status:        flex.FlattenStringPointer(key.Status),
+			Flags:         types.Int64Value(flags),
+			Protocol:      types.Int64Value(utils.DNSKEYProtocol),
+			Algorithm:     types.Int64Value(algorithm),
+			PublicKey:     flex.FlattenStringPointer(key.PublicKey),
+			NextEventDate: flex.FlattenInt64Pointer(key.NextEventDate),
+			Record:        types.StringValue(fmt.Sprintf("%s. IN DNSKEY %d %d %d %s", owner, flags, utils.DNSKEYProtocol, algorithm, key.GetPublicKey())),
+		})
+
+		// Only key signing keys are referenced from the parent zone
+		if flags != utils.DNSKEYFlags("KSK") {
+			continue
+		}
+		for _, digestType := range digestTypes {
+			digest, err := utils.DSDigest(owner, flags, utils.DNSKEYProtocol, algorithm, key.GetPublicKey(), digestType)
+			if err != nil {
+				diags.AddError("Invalid DNSSEC Key", fmt.Sprintf("Unable to compute the DS digest of key %d in zone %s, got error: %s", key.GetTag(), owner, err))
+				return
+			}
+			dsRecords = append(dsRecords, DnssecDsRecordsDsRecordsModel{
+				KeyTag:     flex.FlattenInt64Pointer(key.Tag),
+				Status:     flex.FlattenStringPointer(key.Status),
+				Algorithm:  types.Int64Value(algorithm),
+				DigestType: types.Int64Value(digestType),
+				Digest:     types.StringValue(digest),
+				Record:     types.StringValue(fmt.Sprintf("%s. IN DS %d %d %d %s", owner, key.GetTag(), algorithm, digestType, digest)),
+			})
+		}
+	}
+	m.DnskeyRecords = flex.FlattenFrameworkListNestedBlock(ctx, dnskeys, DnssecDsRecordsDnskeyRecordsAttrTypes, diags, FlattenDnssecDsRecordsDnskeyRecords)
+	m.DsRecords = flex.FlattenFrameworkListNestedBlock(ctx, dsRecords, DnssecDsRecordsDsRecordsAttrTypes, diags, FlattenDnssecDsRecordsDsRecords)
+}
@@ -0,0 +1,82 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DnssecDsRecordsDnskeyRecordsModel struct {
+	KeyTag        types.Int64  `tfsdk:"key_tag"`
+	Type          types.String `tfsdk:"type"`
+	Status        types.String `tfsdk:"status"`
+	Flags         types.Int64  `tfsdk:"flags"`
+	Protocol      types.Int64  `tfsdk:"protocol"`
+	Algorithm     types.Int64  `tfsdk:"algorithm"`
+	PublicKey     types.String `tfsdk:"public_key"`
+	NextEventDate types.Int64  `tfsdk:"next_event_date"`
+	Record        types.String `tfsdk:"record"`
+}
+
+var DnssecDsRecordsDnskeyRecordsAttrTypes = map[string]attr.Type{
+	"key_tag":         types.Int64Type,
+	"type":            types.StringType,
+	"status":          types.StringType,
+	"flags":           types.Int64Type,
+	"protocol":        types.Int64Type,
+	"algorithm":       types.Int64Type,
+	"public_key":      types.StringType,
+	"next_event_date": types.Int64Type,
+	"record":          types.StringType,
+}
+
+var DnssecDsRecordsDnskeyRecordsResourceSchemaAttributes = map[string]schema.Attribute{
+	"key_tag": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The tag of the key.",
+	},
+	"type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The key type, KSK or ZSK.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status of the key.",
+	},
+	"flags": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The flags of the DNSKEY record, 257 for a key signing key and 256 for a zone signing key.",
+	},
+	"protocol": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The protocol of the DNSKEY record, which is always 3.",
+	},
+	"algorithm": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The public-key encryption algorithm number.",
+	},
+	"public_key": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Base-64 encoding of the public key.",
+	},
+	"next_event_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The next event date for the key, the rollover date for an active key or the removal date for an already rolled one.",
+	},
+	"record": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The DNSKEY record in zone file presentation format.",
+	},
+}
+
+func FlattenDnssecDsRecordsDnskeyRecords(ctx context.Context, from *DnssecDsRecordsDnskeyRecordsModel, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DnssecDsRecordsDnskeyRecordsAttrTypes)
+	}
+	t, d := types.ObjectValueFrom(ctx, DnssecDsRecordsDnskeyRecordsAttrTypes, from)
+	diags.Append(d...)
+	return t
+}
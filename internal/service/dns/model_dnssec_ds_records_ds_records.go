@@ -0,0 +1,64 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DnssecDsRecordsDsRecordsModel struct {
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Status     types.String `tfsdk:"status"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+	Record     types.String `tfsdk:"record"`
+}
+
+var DnssecDsRecordsDsRecordsAttrTypes = map[string]attr.Type{
+	"key_tag":     types.Int64Type,
+	"status":      types.StringType,
+	"algorithm":   types.Int64Type,
+	"digest_type": types.Int64Type,
+	"digest":      types.StringType,
+	"record":      types.StringType,
+}
+
+var DnssecDsRecordsDsRecordsResourceSchemaAttributes = map[string]schema.Attribute{
+	"key_tag": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The tag of the key signing key the DS record refers to.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status of the key signing key the DS record refers to.",
+	},
+	"algorithm": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The algorithm number of the key signing key.",
+	},
+	"digest_type": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The digest type number: 1 for SHA-1, 2 for SHA-256 and 4 for SHA-384.",
+	},
+	"digest": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The digest of the key signing key in hexadecimal format.",
+	},
+	"record": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The DS record in zone file presentation format.",
+	},
+}
+
+func FlattenDnssecDsRecordsDsRecords(ctx context.Context, from *DnssecDsRecordsDsRecordsModel, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DnssecDsRecordsDsRecordsAttrTypes)
+	}
+	t, d := types.ObjectValueFrom(ctx, DnssecDsRecordsDsRecordsAttrTypes, from)
+	diags.Append(d...)
+	return t
+}
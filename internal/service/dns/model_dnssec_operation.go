@@ -0,0 +1,87 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type DnssecOperationModel struct {
+	Zone                  types.String `tfsdk:"zone"`
+	Operation             types.String `tfsdk:"operation"`
+	Triggers              types.Map    `tfsdk:"triggers"`
+	IsDnssecSigned        types.Bool   `tfsdk:"is_dnssec_signed"`
+	DnssecKskRolloverDate types.Int64  `tfsdk:"dnssec_ksk_rollover_date"`
+	DnssecZskRolloverDate types.Int64  `tfsdk:"dnssec_zsk_rollover_date"`
+}
+
+var DnssecOperationAttrTypes = map[string]attr.Type{
+	"zone":                     types.StringType,
+	"operation":                types.StringType,
+	"triggers":                 types.MapType{ElemType: types.StringType},
+	"is_dnssec_signed":         types.BoolType,
+	"dnssec_ksk_rollover_date": types.Int64Type,
+	"dnssec_zsk_rollover_date": types.Int64Type,
+}
+
+var DnssecOperationResourceSchemaAttributes = map[string]schema.Attribute{
+	"zone": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The reference to the authoritative zone the operation is run on.",
+	},
+	"operation": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("SIGN", "UNSIGN", "ROLLOVER_KSK"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNSSEC operation to run. Valid values are: \"SIGN\" signs the zone, \"UNSIGN\" removes the signatures and keys of the zone and \"ROLLOVER_KSK\" starts a key signing key rollover of a signed zone.",
+	},
+	"triggers": schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "Arbitrary values that run the operation again when they change, for example a date to schedule a key rollover.",
+	},
+	"is_dnssec_signed": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the zone is DNSSEC signed.",
+	},
+	"dnssec_ksk_rollover_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "This field gives the date in Epoch seconds format when the next key signing key rollover for the zone is due.",
+	},
+	"dnssec_zsk_rollover_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "This field gives the date in Epoch seconds format when the next zone signing key rollover for the zone is due.",
+	},
+}
+
+// Flatten copies the DNSSEC state of the zone, the inputs of the operation are kept as planned
+func (m *DnssecOperationModel) Flatten(ctx context.Context, from *dns.ZoneAuth, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	m.IsDnssecSigned = types.BoolPointerValue(from.IsDnssecSigned)
+	m.DnssecKskRolloverDate = flex.FlattenInt64Pointer(from.DnssecKskRolloverDate)
+	m.DnssecZskRolloverDate = flex.FlattenInt64Pointer(from.DnssecZskRolloverDate)
+}
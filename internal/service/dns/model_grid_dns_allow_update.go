@@ -0,0 +1,147 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type GridDnsAllowUpdateModel struct {
+	Address        types.String `tfsdk:"address"`
+	Permission     types.String `tfsdk:"permission"`
+	TsigKey        types.String `tfsdk:"tsig_key"`
+	TsigKeyAlg     types.String `tfsdk:"tsig_key_alg"`
+	TsigKeyName    types.String `tfsdk:"tsig_key_name"`
+	UseTsigKeyName types.Bool   `tfsdk:"use_tsig_key_name"`
+}
+
+var GridDnsAllowUpdateAttrTypes = map[string]attr.Type{
+	"address":           types.StringType,
+	"permission":        types.StringType,
+	"tsig_key":          types.StringType,
+	"tsig_key_alg":      types.StringType,
+	"tsig_key_name":     types.StringType,
+	"use_tsig_key_name": types.BoolType,
+}
+
+var GridDnsAllowUpdateResourceSchemaAttributes = map[string]schema.Attribute{
+	"address": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The address this rule applies to or \"Any\".",
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(
+				path.MatchRelative().AtParent().AtName("tsig_key"),
+				path.MatchRelative().AtParent().AtName("tsig_key_alg"),
+				path.MatchRelative().AtParent().AtName("tsig_key_name"),
+			),
+			customvalidator.ValidateTrimmedString(),
+		},
+	},
+	"permission": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The permission to use for this address.",
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(
+				path.MatchRelative().AtParent().AtName("tsig_key"),
+				path.MatchRelative().AtParent().AtName("tsig_key_alg"),
+				path.MatchRelative().AtParent().AtName("tsig_key_name"),
+			),
+		},
+	},
+	"tsig_key": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "A generated TSIG key. If the external primary server is a NIOS appliance running DNS One 2.x code, this can be set to :2xCOMPAT.",
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+	},
+	"tsig_key_alg": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("HMAC-MD5", "HMAC-SHA256"),
+		},
+		MarkdownDescription: "The TSIG key algorithm.",
+	},
+	"tsig_key_name": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The name of the TSIG key. If 2.x TSIG compatibility is used, this is set to 'tsig_xfer' on retrieval, and ignored on insert or update.",
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+	},
+	"use_tsig_key_name": schema.BoolAttribute{
+		Computed:            true,
+		Optional:            true,
+		MarkdownDescription: "Use flag for: tsig_key_name",
+	},
+}
+
+func ExpandGridDnsAllowUpdate(ctx context.Context, o types.Object, diags *diag.Diagnostics) *grid.GridDnsAllowUpdate {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m GridDnsAllowUpdateModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *GridDnsAllowUpdateModel) Expand(ctx context.Context, diags *diag.Diagnostics) *grid.GridDnsAllowUpdate {
+	if m == nil {
+		return nil
+	}
+	to := &grid.GridDnsAllowUpdate{
+		Address:        flex.ExpandStringPointer(m.Address),
+		Permission:     flex.ExpandStringPointer(m.Permission),
+		TsigKey:        flex.ExpandStringPointer(m.TsigKey),
+		TsigKeyAlg:     flex.ExpandStringPointer(m.TsigKeyAlg),
+		TsigKeyName:    flex.ExpandStringPointer(m.TsigKeyName),
+		UseTsigKeyName: flex.ExpandBoolPointer(m.UseTsigKeyName),
+	}
+	return to
+}
+
+func FlattenGridDnsAllowUpdate(ctx context.Context, from *grid.GridDnsAllowUpdate, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(GridDnsAllowUpdateAttrTypes)
+	}
+	m := GridDnsAllowUpdateModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, GridDnsAllowUpdateAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *GridDnsAllowUpdateModel) Flatten(ctx context.Context, from *grid.GridDnsAllowUpdate, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = GridDnsAllowUpdateModel{}
+	}
+	m.Address = flex.FlattenStringPointer(from.Address)
+	m.Permission = flex.FlattenStringPointer(from.Permission)
+	m.TsigKey = flex.FlattenStringPointer(from.TsigKey)
+	m.TsigKeyAlg = flex.FlattenStringPointer(from.TsigKeyAlg)
+	m.TsigKeyName = flex.FlattenStringPointer(from.TsigKeyName)
+	m.UseTsigKeyName = types.BoolPointerValue(from.UseTsigKeyName)
+}
@@ -152,6 +152,7 @@ var IPAllocationResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordHostCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional:            true,
@@ -411,6 +412,7 @@ func (m *IPAllocationModel) Expand(ctx context.Context, diags *diag.Diagnostics)
 		Ref:                      flex.ExpandStringPointer(m.Ref),
 		Aliases:                  flex.ExpandFrameworkListString(ctx, m.Aliases, diags),
 		AllowTelnet:              flex.ExpandBoolPointer(m.AllowTelnet),
+		CloudInfo:                ExpandRecordHostCloudInfo(ctx, m.CloudInfo, diags),
 		CliCredentials:           flex.ExpandFrameworkListNestedBlock(ctx, m.CliCredentials, diags, ExpandRecordHostCliCredentials),
 		Comment:                  flex.ExpandStringPointer(m.Comment),
 		ConfigureForDns:          flex.ExpandBoolPointer(m.ConfigureForDns),
@@ -95,6 +95,7 @@ var RecordAResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordACloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -247,6 +248,7 @@ func (m *RecordAModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCr
 		return nil
 	}
 	to := &dns.RecordA{
+		CloudInfo:         ExpandRecordACloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
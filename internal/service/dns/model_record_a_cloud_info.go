@@ -37,8 +37,10 @@ var RecordACloudInfoAttrTypes = map[string]attr.Type{
 
 var RecordACloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 	"delegated_member": schema.SingleNestedAttribute{
-		Attributes: RecordacloudinfoDelegatedMemberResourceSchemaAttributes,
-		Computed:   true,
+		Attributes:          RecordacloudinfoDelegatedMemberResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -86,7 +88,9 @@ func (m *RecordACloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnost
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordACloudInfo{}
+	to := &dns.RecordACloudInfo{
+		DelegatedMember: ExpandRecordacloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
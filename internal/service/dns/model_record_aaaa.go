@@ -95,6 +95,7 @@ var RecordAaaaResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordAaaaCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -245,6 +246,7 @@ func (m *RecordAaaaModel) Expand(ctx context.Context, diags *diag.Diagnostics, i
 		return nil
 	}
 	to := &dns.RecordAaaa{
+		CloudInfo:         ExpandRecordAaaaCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
@@ -41,6 +41,7 @@ var RecordAaaaCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordaaaacloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordAaaaCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagn
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordAaaaCloudInfo{}
+	to := &dns.RecordAaaaCloudInfo{
+		DelegatedMember: ExpandRecordaaaacloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
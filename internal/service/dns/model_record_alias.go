@@ -79,6 +79,7 @@ var RecordAliasResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordAliasCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional:            true,
@@ -181,6 +182,7 @@ func (m *RecordAliasModel) Expand(ctx context.Context, diags *diag.Diagnostics)
 		return nil
 	}
 	to := &dns.RecordAlias{
+		CloudInfo:  ExpandRecordAliasCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:    flex.ExpandStringPointer(m.Comment),
 		Creator:    flex.ExpandStringPointer(m.Creator),
 		Disable:    flex.ExpandBoolPointer(m.Disable),
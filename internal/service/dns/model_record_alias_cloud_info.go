@@ -41,6 +41,7 @@ var RecordAliasCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordaliascloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordAliasCloudInfoModel) Expand(ctx context.Context, diags *diag.Diag
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordAliasCloudInfo{}
+	to := &dns.RecordAliasCloudInfo{
+		DelegatedMember: ExpandRecordaliascloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
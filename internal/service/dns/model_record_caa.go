@@ -99,6 +99,7 @@ var RecordCaaResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordCaaCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -221,6 +222,7 @@ func (m *RecordCaaModel) Expand(ctx context.Context, diags *diag.Diagnostics) *d
 		CaFlag:            flex.ExpandInt64Pointer(m.CaFlag),
 		CaTag:             flex.ExpandStringPointer(m.CaTag),
 		CaValue:           flex.ExpandStringPointer(m.CaValue),
+		CloudInfo:         ExpandRecordCaaCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
@@ -40,6 +41,7 @@ var RecordCaaCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordcaacloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -71,11 +73,25 @@ var RecordCaaCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 }
 
+func ExpandRecordCaaCloudInfo(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordCaaCloudInfo {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordCaaCloudInfoModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
 func (m *RecordCaaCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordCaaCloudInfo {
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordCaaCloudInfo{}
+	to := &dns.RecordCaaCloudInfo{
+		DelegatedMember: ExpandRecordcaacloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -97,6 +97,7 @@ var RecordCnameResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordCnameCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -222,6 +223,7 @@ func (m *RecordCnameModel) Expand(ctx context.Context, diags *diag.Diagnostics,
 	}
 	to := &dns.RecordCname{
 		Canonical:         flex.ExpandStringPointer(m.Canonical),
+		CloudInfo:         ExpandRecordCnameCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
@@ -40,6 +40,7 @@ var RecordCnameCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordcnamecloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -87,7 +88,9 @@ func (m *RecordCnameCloudInfoModel) Expand(ctx context.Context, diags *diag.Diag
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordCnameCloudInfo{}
+	to := &dns.RecordCnameCloudInfo{
+		DelegatedMember: ExpandRecordcnamecloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
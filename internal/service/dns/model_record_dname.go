@@ -84,6 +84,7 @@ var RecordDnameResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordDnameCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -218,6 +219,7 @@ func (m *RecordDnameModel) Expand(ctx context.Context, diags *diag.Diagnostics,
 		return nil
 	}
 	to := &dns.RecordDname{
+		CloudInfo:         ExpandRecordDnameCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
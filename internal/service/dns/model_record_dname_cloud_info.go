@@ -41,6 +41,7 @@ var RecordDnameCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecorddnamecloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordDnameCloudInfoModel) Expand(ctx context.Context, diags *diag.Diag
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordDnameCloudInfo{}
+	to := &dns.RecordDnameCloudInfo{
+		DelegatedMember: ExpandRecorddnamecloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -0,0 +1,148 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RecordDnskeyModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	Algorithm    types.String `tfsdk:"algorithm"`
+	Comment      types.String `tfsdk:"comment"`
+	CreationTime types.Int64  `tfsdk:"creation_time"`
+	Creator      types.String `tfsdk:"creator"`
+	DnsName      types.String `tfsdk:"dns_name"`
+	Flags        types.Int64  `tfsdk:"flags"`
+	KeyTag       types.Int64  `tfsdk:"key_tag"`
+	LastQueried  types.Int64  `tfsdk:"last_queried"`
+	Name         types.String `tfsdk:"name"`
+	PublicKey    types.String `tfsdk:"public_key"`
+	Ttl          types.Int64  `tfsdk:"ttl"`
+	UseTtl       types.Bool   `tfsdk:"use_ttl"`
+	View         types.String `tfsdk:"view"`
+	Zone         types.String `tfsdk:"zone"`
+}
+
+var RecordDnskeyAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"algorithm":     types.StringType,
+	"comment":       types.StringType,
+	"creation_time": types.Int64Type,
+	"creator":       types.StringType,
+	"dns_name":      types.StringType,
+	"flags":         types.Int64Type,
+	"key_tag":       types.Int64Type,
+	"last_queried":  types.Int64Type,
+	"name":          types.StringType,
+	"public_key":    types.StringType,
+	"ttl":           types.Int64Type,
+	"use_ttl":       types.BoolType,
+	"view":          types.StringType,
+	"zone":          types.StringType,
+}
+
+var RecordDnskeyResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"algorithm": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The public key encryption algorithm of a DNSKEY Record object.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The comment for the record.",
+	},
+	"creation_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The creation time of the record.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record creator.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name of a DNSKEY record in punycode format.",
+	},
+	"flags": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The flags field is a 16-bit unsigned integer. Currently, only two bits of this value are used: the least significant bit and bit 7. The other bits are reserved for future use and must be zero. If bit 7 is set to 1, the key is a DNS zone key. Otherwise, the key is not a zone key and cannot be used to verify zone data. The least significant bit indicates \"secure entry point property\". If it is not zero, the key is a key signing key (KSK type). Otherwise, the key type is ZSK.",
+	},
+	"key_tag": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The key tag identifying the public key of a DNSKEY Record object.",
+	},
+	"last_queried": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNSKEY record in FQDN format. It has to be the same as the zone, where the record resides.",
+	},
+	"public_key": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The public key. The format of the returned value depends on the key algorithm.",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for the record. A 32-bit unsigned integer that represents the duration, in seconds, for which the record is valid (cached). Zero indicates that the record should not be cached.",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS View in which the record resides. Example: \"external\".",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in which the record resides. Example: \"zone.com\". If a view is not specified when searching by zone, the default view is used.",
+	},
+}
+
+func FlattenRecordDnskey(ctx context.Context, from *dns.RecordDnskey, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordDnskeyAttrTypes)
+	}
+	m := RecordDnskeyModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordDnskeyAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordDnskeyModel) Flatten(ctx context.Context, from *dns.RecordDnskey, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordDnskeyModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Algorithm = flex.FlattenStringPointer(from.Algorithm)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.CreationTime = flex.FlattenInt64Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.Flags = flex.FlattenInt64Pointer(from.Flags)
+	m.KeyTag = flex.FlattenInt64Pointer(from.KeyTag)
+	m.LastQueried = flex.FlattenInt64Pointer(from.LastQueried)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.PublicKey = flex.FlattenStringPointer(from.PublicKey)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,108 @@
+package dns
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+// RecordHostResourceSchemaAttributes reuses the IP allocation schema, which already covers the full host object,
+// and makes the DHCP settings of the addresses configurable since no separate association resource is involved.
+var RecordHostResourceSchemaAttributes = recordHostResourceSchemaAttributes()
+
+var RecordHostIpv4addrNativeResourceSchemaAttributes = recordHostIpv4addrNativeResourceSchemaAttributes()
+
+var RecordHostIpv6addrNativeResourceSchemaAttributes = recordHostIpv6addrNativeResourceSchemaAttributes()
+
+func recordHostResourceSchemaAttributes() map[string]schema.Attribute {
+	attributes := maps.Clone(IPAllocationResourceSchemaAttributes)
+	attributes["ipv4addrs"] = schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RecordHostIpv4addrNativeResourceSchemaAttributes,
+		},
+		Optional:            true,
+		MarkdownDescription: "This is a list of IPv4 Addresses for the host.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+	attributes["ipv6addrs"] = schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RecordHostIpv6addrNativeResourceSchemaAttributes,
+		},
+		Optional:            true,
+		MarkdownDescription: "This is a list of IPv6 Addresses for the host.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+	return attributes
+}
+
+func recordHostIpv4addrNativeResourceSchemaAttributes() map[string]schema.Attribute {
+	attributes := maps.Clone(RecordHostIpv4addrResourceSchemaAttributes)
+	attributes["configure_for_dhcp"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Set this to True to enable the DHCP configuration for this host address.",
+	}
+	attributes["mac"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			customvalidator.IsValidMacAddress(),
+		},
+		MarkdownDescription: "The MAC address for this host address.",
+	}
+	attributes["match_client"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("MAC_ADDRESS", "CLIENT_ID", "RESERVED", "CIRCUIT_ID", "REMOTE_ID"),
+		},
+		MarkdownDescription: "Set this to 'MAC_ADDRESS' to assign the IP address to the selected host, provided that the MAC address of the requesting host matches the MAC address that you specify in the field. Set this to 'RESERVED' to reserve this particular IP address for future use, or if the IP address is statically configured on a system (the Infoblox server does not assign the address from a DHCP request).",
+	}
+	return attributes
+}
+
+func recordHostIpv6addrNativeResourceSchemaAttributes() map[string]schema.Attribute {
+	attributes := maps.Clone(RecordHostIpv6addrResourceSchemaAttributes)
+	attributes["configure_for_dhcp"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Set this to True to enable the DHCP configuration for this IPv6 host address.",
+	}
+	attributes["duid"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			customvalidator.IsValidDUID(),
+		},
+		MarkdownDescription: "DHCPv6 Unique Identifier (DUID) of the address object.",
+	}
+	attributes["mac"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			customvalidator.IsValidMacAddress(),
+		},
+		MarkdownDescription: "The MAC address for this host address.",
+	}
+	attributes["match_client"] = schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("DUID", "MAC_ADDRESS"),
+		},
+		MarkdownDescription: "The match_client value for this fixed address. Valid values are: \"DUID\": The host IP address is leased to the matching DUID. \"MAC_ADDRESS\": The host IP address is leased to the matching MAC address.",
+	}
+	return attributes
+}
@@ -38,8 +38,10 @@ var RecordHostCloudInfoAttrTypes = map[string]attr.Type{
 
 var RecordHostCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 	"delegated_member": schema.SingleNestedAttribute{
-		Attributes: RecordhostcloudinfoDelegatedMemberResourceSchemaAttributes,
-		Optional:   true,
+		Attributes:          RecordhostcloudinfoDelegatedMemberResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -92,6 +92,7 @@ var RecordMxResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordMxCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -229,6 +230,7 @@ func (m *RecordMxModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dn
 		return nil
 	}
 	to := &dns.RecordMx{
+		CloudInfo:         ExpandRecordMxCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
@@ -41,6 +41,7 @@ var RecordMxCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordmxcloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordMxCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnos
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordMxCloudInfo{}
+	to := &dns.RecordMxCloudInfo{
+		DelegatedMember: ExpandRecordmxcloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -92,6 +92,7 @@ var RecordNaptrResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordNaptrCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -263,6 +264,7 @@ func (m *RecordNaptrModel) Expand(ctx context.Context, diags *diag.Diagnostics,
 		return nil
 	}
 	to := &dns.RecordNaptr{
+		CloudInfo:         ExpandRecordNaptrCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
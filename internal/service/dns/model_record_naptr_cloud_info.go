@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
@@ -40,6 +41,7 @@ var RecordNaptrCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordnaptrcloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -71,11 +73,25 @@ var RecordNaptrCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 }
 
+func ExpandRecordNaptrCloudInfo(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordNaptrCloudInfo {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordNaptrCloudInfoModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
 func (m *RecordNaptrCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordNaptrCloudInfo {
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordNaptrCloudInfo{}
+	to := &dns.RecordNaptrCloudInfo{
+		DelegatedMember: ExpandRecordnaptrcloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -68,6 +68,7 @@ var RecordNsResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordNsCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"creator": schema.StringAttribute{
 		Computed:            true,
@@ -128,6 +129,7 @@ func (m *RecordNsModel) Expand(ctx context.Context, diags *diag.Diagnostics, isC
 	}
 	to := &dns.RecordNs{
 		Addresses:        flex.ExpandFrameworkListNestedBlock(ctx, m.Addresses, diags, ExpandRecordNsAddresses),
+		CloudInfo:        ExpandRecordNsCloudInfo(ctx, m.CloudInfo, diags),
 		MsDelegationName: flex.ExpandStringPointer(m.MsDelegationName),
 		Nameserver:       flex.ExpandStringPointer(m.Nameserver),
 	}
@@ -41,6 +41,7 @@ var RecordNsCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordnscloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordNsCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnos
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordNsCloudInfo{}
+	to := &dns.RecordNsCloudInfo{
+		DelegatedMember: ExpandRecordnscloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -0,0 +1,143 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RecordNsecModel struct {
+	Ref              types.String `tfsdk:"ref"`
+	CloudInfo        types.Object `tfsdk:"cloud_info"`
+	CreationTime     types.Int64  `tfsdk:"creation_time"`
+	Creator          types.String `tfsdk:"creator"`
+	DnsName          types.String `tfsdk:"dns_name"`
+	DnsNextOwnerName types.String `tfsdk:"dns_next_owner_name"`
+	LastQueried      types.Int64  `tfsdk:"last_queried"`
+	Name             types.String `tfsdk:"name"`
+	NextOwnerName    types.String `tfsdk:"next_owner_name"`
+	RrsetTypes       types.List   `tfsdk:"rrset_types"`
+	Ttl              types.Int64  `tfsdk:"ttl"`
+	UseTtl           types.Bool   `tfsdk:"use_ttl"`
+	View             types.String `tfsdk:"view"`
+	Zone             types.String `tfsdk:"zone"`
+}
+
+var RecordNsecAttrTypes = map[string]attr.Type{
+	"ref":                 types.StringType,
+	"cloud_info":          types.ObjectType{AttrTypes: RecordNsecCloudInfoAttrTypes},
+	"creation_time":       types.Int64Type,
+	"creator":             types.StringType,
+	"dns_name":            types.StringType,
+	"dns_next_owner_name": types.StringType,
+	"last_queried":        types.Int64Type,
+	"name":                types.StringType,
+	"next_owner_name":     types.StringType,
+	"rrset_types":         types.ListType{ElemType: types.StringType},
+	"ttl":                 types.Int64Type,
+	"use_ttl":             types.BoolType,
+	"view":                types.StringType,
+	"zone":                types.StringType,
+}
+
+var RecordNsecResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"cloud_info": schema.SingleNestedAttribute{
+		Attributes:          RecordNsecCloudInfoResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The cloud information associated with the record.",
+	},
+	"creation_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Time that the record was created.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Creator of the record.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name for an NSEC record in punycode format.",
+	},
+	"dns_next_owner_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name of the next owner in punycode format.",
+	},
+	"last_queried": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the NSEC record in FQDN format.",
+	},
+	"next_owner_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name of the next owner that has authoritative data or that contains a delegation point NS record.",
+	},
+	"rrset_types": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The RRSet types that exist at the original owner name of the NSEC RR.",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for the record. A 32-bit unsigned integer that represents the duration, in seconds, for which the record is valid (cached). Zero indicates that the record should not be cached.",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS View in which the record resides. Example: \"external\".",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in which the record resides. Example: \"zone.com\". If a view is not specified when searching by zone, the default view is used.",
+	},
+}
+
+func FlattenRecordNsec(ctx context.Context, from *dns.RecordNsec, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordNsecAttrTypes)
+	}
+	m := RecordNsecModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordNsecAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordNsecModel) Flatten(ctx context.Context, from *dns.RecordNsec, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordNsecModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CloudInfo = FlattenRecordNsecCloudInfo(ctx, from.CloudInfo, diags)
+	m.CreationTime = flex.FlattenInt64Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.DnsNextOwnerName = flex.FlattenStringPointer(from.DnsNextOwnerName)
+	m.LastQueried = flex.FlattenInt64Pointer(from.LastQueried)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.NextOwnerName = flex.FlattenStringPointer(from.NextOwnerName)
+	m.RrsetTypes = flex.FlattenFrameworkListString(ctx, from.RrsetTypes, diags)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,149 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RecordNsec3paramModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	Algorithm    types.String `tfsdk:"algorithm"`
+	CloudInfo    types.Object `tfsdk:"cloud_info"`
+	CreationTime types.Int64  `tfsdk:"creation_time"`
+	Creator      types.String `tfsdk:"creator"`
+	DnsName      types.String `tfsdk:"dns_name"`
+	Flags        types.Int64  `tfsdk:"flags"`
+	Iterations   types.Int64  `tfsdk:"iterations"`
+	LastQueried  types.Int64  `tfsdk:"last_queried"`
+	Name         types.String `tfsdk:"name"`
+	Salt         types.String `tfsdk:"salt"`
+	Ttl          types.Int64  `tfsdk:"ttl"`
+	UseTtl       types.Bool   `tfsdk:"use_ttl"`
+	View         types.String `tfsdk:"view"`
+	Zone         types.String `tfsdk:"zone"`
+}
+
+var RecordNsec3paramAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"algorithm":     types.StringType,
+	"cloud_info":    types.ObjectType{AttrTypes: RecordNsec3paramCloudInfoAttrTypes},
+	"creation_time": types.Int64Type,
+	"creator":       types.StringType,
+	"dns_name":      types.StringType,
+	"flags":         types.Int64Type,
+	"iterations":    types.Int64Type,
+	"last_queried":  types.Int64Type,
+	"name":          types.StringType,
+	"salt":          types.StringType,
+	"ttl":           types.Int64Type,
+	"use_ttl":       types.BoolType,
+	"view":          types.StringType,
+	"zone":          types.StringType,
+}
+
+var RecordNsec3paramResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"algorithm": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The hash algorithm that was used.",
+	},
+	"cloud_info": schema.SingleNestedAttribute{
+		Attributes:          RecordNsec3paramCloudInfoResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The cloud information associated with the record.",
+	},
+	"creation_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The creation time of the record.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Creator of the record.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name for an NSEC3PARAM record in punycode format.",
+	},
+	"flags": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The set of 8 one-bit flags, of which only one flag, the Opt-Out flag, is defined by RFC 5155. The Opt-Out flag indicates whether the NSEC3 record covers unsigned delegations.",
+	},
+	"iterations": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of times the hash function was performed.",
+	},
+	"last_queried": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the NSEC3PARAM record in FQDN format. It has to be the same as the zone, where the record resides.",
+	},
+	"salt": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "A series of case-insensitive hexadecimal digits. It is appended to the original owner name as protection against pre-calculated dictionary attacks. A new salt value is generated when the ZSK rolls over, for which the user can control the period. For a random salt value, the selected length is between one and 15 octets.",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for the record. A 32-bit unsigned integer that represents the duration, in seconds, for which the record is valid (cached). Zero indicates that the record should not be cached.",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS View in which the record resides. Example: \"external\".",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in which the record resides. Example: \"zone.com\". If a view is not specified when searching by zone, the default view is used.",
+	},
+}
+
+func FlattenRecordNsec3param(ctx context.Context, from *dns.RecordNsec3param, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordNsec3paramAttrTypes)
+	}
+	m := RecordNsec3paramModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordNsec3paramAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordNsec3paramModel) Flatten(ctx context.Context, from *dns.RecordNsec3param, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordNsec3paramModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Algorithm = flex.FlattenStringPointer(from.Algorithm)
+	m.CloudInfo = FlattenRecordNsec3paramCloudInfo(ctx, from.CloudInfo, diags)
+	m.CreationTime = flex.FlattenInt64Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.Flags = flex.FlattenInt64Pointer(from.Flags)
+	m.Iterations = flex.FlattenInt64Pointer(from.Iterations)
+	m.LastQueried = flex.FlattenInt64Pointer(from.LastQueried)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Salt = flex.FlattenStringPointer(from.Salt)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -102,6 +102,7 @@ var RecordPtrResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordPtrCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -278,6 +279,7 @@ func (m *RecordPtrModel) Expand(ctx context.Context, diags *diag.Diagnostics, is
 		return nil
 	}
 	to := &dns.RecordPtr{
+		CloudInfo:         ExpandRecordPtrCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
@@ -41,6 +41,7 @@ var RecordPtrCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordptrcloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordPtrCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagno
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordPtrCloudInfo{}
+	to := &dns.RecordPtrCloudInfo{
+		DelegatedMember: ExpandRecordptrcloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -0,0 +1,191 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type RecordRrsigModel struct {
+	Ref            types.String `tfsdk:"ref"`
+	Algorithm      types.String `tfsdk:"algorithm"`
+	CloudInfo      types.Object `tfsdk:"cloud_info"`
+	CreationTime   types.Int64  `tfsdk:"creation_time"`
+	Creator        types.String `tfsdk:"creator"`
+	DnsName        types.String `tfsdk:"dns_name"`
+	DnsSignerName  types.String `tfsdk:"dns_signer_name"`
+	ExpirationTime types.Int64  `tfsdk:"expiration_time"`
+	InceptionTime  types.Int64  `tfsdk:"inception_time"`
+	KeyTag         types.Int64  `tfsdk:"key_tag"`
+	Labels         types.Int64  `tfsdk:"labels"`
+	LastQueried    types.Int64  `tfsdk:"last_queried"`
+	Name           types.String `tfsdk:"name"`
+	OriginalTtl    types.Int64  `tfsdk:"original_ttl"`
+	Signature      types.String `tfsdk:"signature"`
+	SignerName     types.String `tfsdk:"signer_name"`
+	Ttl            types.Int64  `tfsdk:"ttl"`
+	TypeCovered    types.String `tfsdk:"type_covered"`
+	UseTtl         types.Bool   `tfsdk:"use_ttl"`
+	View           types.String `tfsdk:"view"`
+	Zone           types.String `tfsdk:"zone"`
+}
+
+var RecordRrsigAttrTypes = map[string]attr.Type{
+	"ref":             types.StringType,
+	"algorithm":       types.StringType,
+	"cloud_info":      types.ObjectType{AttrTypes: RecordRrsigCloudInfoAttrTypes},
+	"creation_time":   types.Int64Type,
+	"creator":         types.StringType,
+	"dns_name":        types.StringType,
+	"dns_signer_name": types.StringType,
+	"expiration_time": types.Int64Type,
+	"inception_time":  types.Int64Type,
+	"key_tag":         types.Int64Type,
+	"labels":          types.Int64Type,
+	"last_queried":    types.Int64Type,
+	"name":            types.StringType,
+	"original_ttl":    types.Int64Type,
+	"signature":       types.StringType,
+	"signer_name":     types.StringType,
+	"ttl":             types.Int64Type,
+	"type_covered":    types.StringType,
+	"use_ttl":         types.BoolType,
+	"view":            types.StringType,
+	"zone":            types.StringType,
+}
+
+var RecordRrsigResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"algorithm": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The cryptographic algorithm that was used to create the signature. It uses the same algorithm types as the DNSKEY record indicated in the key tag field.",
+	},
+	"cloud_info": schema.SingleNestedAttribute{
+		Attributes:          RecordRrsigCloudInfoResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The cloud information associated with the record.",
+	},
+	"creation_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The creation time of the record.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record creator.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name for an RRSIG record in punycode format.",
+	},
+	"dns_signer_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The domain name, in punycode format, of the zone that contains the signed RRset.",
+	},
+	"expiration_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The expiry time of an RRSIG record in Epoch seconds format.",
+	},
+	"inception_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The inception time of an RRSIG record in Epoch seconds format.",
+	},
+	"key_tag": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The key tag value of the DNSKEY RR that validates the signature.",
+	},
+	"labels": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of labels in the name of the RRset signed with the RRSIG object.",
+	},
+	"last_queried": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the RRSIG record in FQDN format.",
+	},
+	"original_ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The TTL value of the RRset covered by the RRSIG record.",
+	},
+	"signature": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Base64 encoded cryptographic signature that covers the RRSIG RDATA of the RRSIG Record object.",
+	},
+	"signer_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The domain name of the zone in FQDN format that contains the signed RRset.",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The Time To Live (TTL) value for the record. A 32-bit unsigned integer that represents the duration, in seconds, for which the record is valid (cached). Zero indicates that the record should not be cached.",
+	},
+	"type_covered": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The RR type covered by the RRSIG record.",
+	},
+	"use_ttl": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use flag for: ttl",
+	},
+	"view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DNS View in which the record resides. Example: \"external\".",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in which the record resides. Example: \"zone.com\". If a view is not specified when searching by zone, the default view is used.",
+	},
+}
+
+func FlattenRecordRrsig(ctx context.Context, from *dns.RecordRrsig, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordRrsigAttrTypes)
+	}
+	m := RecordRrsigModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordRrsigAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordRrsigModel) Flatten(ctx context.Context, from *dns.RecordRrsig, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordRrsigModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Algorithm = flex.FlattenStringPointer(from.Algorithm)
+	m.CloudInfo = FlattenRecordRrsigCloudInfo(ctx, from.CloudInfo, diags)
+	m.CreationTime = flex.FlattenInt64Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.DnsSignerName = flex.FlattenStringPointer(from.DnsSignerName)
+	m.ExpirationTime = flex.FlattenInt64Pointer(from.ExpirationTime)
+	m.InceptionTime = flex.FlattenInt64Pointer(from.InceptionTime)
+	m.KeyTag = flex.FlattenInt64Pointer(from.KeyTag)
+	m.Labels = flex.FlattenInt64Pointer(from.Labels)
+	m.LastQueried = flex.FlattenInt64Pointer(from.LastQueried)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.OriginalTtl = flex.FlattenInt64Pointer(from.OriginalTtl)
+	m.Signature = flex.FlattenStringPointer(from.Signature)
+	m.SignerName = flex.FlattenStringPointer(from.SignerName)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.TypeCovered = flex.FlattenStringPointer(from.TypeCovered)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -97,6 +97,7 @@ var RecordSrvResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordSrvCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -254,6 +255,7 @@ func (m *RecordSrvModel) Expand(ctx context.Context, diags *diag.Diagnostics, is
 		return nil
 	}
 	to := &dns.RecordSrv{
+		CloudInfo:         ExpandRecordSrvCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
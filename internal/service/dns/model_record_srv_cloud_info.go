@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
@@ -39,6 +40,7 @@ var RecordSrvCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordsrvcloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -70,11 +72,25 @@ var RecordSrvCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 	},
 }
 
+func ExpandRecordSrvCloudInfo(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordSrvCloudInfo {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordSrvCloudInfoModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
 func (m *RecordSrvCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordSrvCloudInfo {
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordSrvCloudInfo{}
+	to := &dns.RecordSrvCloudInfo{
+		DelegatedMember: ExpandRecordsrvcloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
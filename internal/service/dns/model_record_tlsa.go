@@ -92,6 +92,7 @@ var RecordTlsaResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordTlsaCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -195,6 +196,7 @@ func (m *RecordTlsaModel) Expand(ctx context.Context, diags *diag.Diagnostics) *
 	to := &dns.RecordTlsa{
 		CertificateData:  flex.ExpandStringPointer(m.CertificateData.StringValue),
 		CertificateUsage: flex.ExpandInt64Pointer(m.CertificateUsage),
+		CloudInfo:        ExpandRecordTlsaCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:          flex.ExpandStringPointer(m.Comment),
 		Creator:          flex.ExpandStringPointer(m.Creator),
 		Disable:          flex.ExpandBoolPointer(m.Disable),
@@ -41,6 +41,7 @@ var RecordTlsaCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordtlsacloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordTlsaCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagn
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordTlsaCloudInfo{}
+	to := &dns.RecordTlsaCloudInfo{
+		DelegatedMember: ExpandRecordtlsacloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -89,6 +89,7 @@ var RecordTxtResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordTxtCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -217,6 +218,7 @@ func (m *RecordTxtModel) Expand(ctx context.Context, diags *diag.Diagnostics, is
 		return nil
 	}
 	to := &dns.RecordTxt{
+		CloudInfo:         ExpandRecordTxtCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:           flex.ExpandStringPointer(m.Comment),
 		Creator:           flex.ExpandStringPointer(m.Creator),
 		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
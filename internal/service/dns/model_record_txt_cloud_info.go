@@ -41,6 +41,7 @@ var RecordTxtCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordtxtcloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordTxtCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagno
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordTxtCloudInfo{}
+	to := &dns.RecordTxtCloudInfo{
+		DelegatedMember: ExpandRecordtxtcloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -79,6 +79,7 @@ var RecordUnknownResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          RecordUnknownCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the record.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -203,6 +204,7 @@ func (m *RecordUnknownModel) Expand(ctx context.Context, diags *diag.Diagnostics
 		return nil
 	}
 	to := &dns.RecordUnknown{
+		CloudInfo:            ExpandRecordUnknownCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:              flex.ExpandStringPointer(m.Comment),
 		Creator:              flex.ExpandStringPointer(m.Creator),
 		Disable:              flex.ExpandBoolPointer(m.Disable),
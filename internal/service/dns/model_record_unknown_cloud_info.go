@@ -41,6 +41,7 @@ var RecordUnknownCloudInfoResourceSchemaAttributes = map[string]schema.Attribute
 		Attributes:          RecordunknowncloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *RecordUnknownCloudInfoModel) Expand(ctx context.Context, diags *diag.Di
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordUnknownCloudInfo{}
+	to := &dns.RecordUnknownCloudInfo{
+		DelegatedMember: ExpandRecordunknowncloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
@@ -26,24 +27,43 @@ var RecordaaaacloudinfoDelegatedMemberAttrTypes = map[string]attr.Type{
 
 var RecordaaaacloudinfoDelegatedMemberResourceSchemaAttributes = map[string]schema.Attribute{
 	"ipv4addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv4 Address of the Grid Member.",
 	},
 	"ipv6addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv6 Address of the Grid Member.",
 	},
 	"name": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The Grid member name",
 	},
 }
 
+func ExpandRecordaaaacloudinfoDelegatedMember(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordaaaacloudinfoDelegatedMember {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordaaaacloudinfoDelegatedMemberModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
 func (m *RecordaaaacloudinfoDelegatedMemberModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordaaaacloudinfoDelegatedMember {
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordaaaacloudinfoDelegatedMember{}
+	to := &dns.RecordaaaacloudinfoDelegatedMember{
+		Ipv4addr: flex.ExpandStringPointer(m.Ipv4addr),
+		Ipv6addr: flex.ExpandStringPointer(m.Ipv6addr),
+		Name:     flex.ExpandStringPointer(m.Name),
+	}
 	return to
 }
 
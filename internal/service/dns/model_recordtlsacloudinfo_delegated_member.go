@@ -28,14 +28,17 @@ var RecordtlsacloudinfoDelegatedMemberAttrTypes = map[string]attr.Type{
 
 var RecordtlsacloudinfoDelegatedMemberResourceSchemaAttributes = map[string]schema.Attribute{
 	"ipv4addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv4 Address of the Grid Member.",
 	},
 	"ipv6addr": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The IPv6 Address of the Grid Member.",
 	},
 	"name": schema.StringAttribute{
+		Optional:            true,
 		Computed:            true,
 		MarkdownDescription: "The Grid member name",
 	},
@@ -57,7 +60,11 @@ func (m *RecordtlsacloudinfoDelegatedMemberModel) Expand(ctx context.Context, di
 	if m == nil {
 		return nil
 	}
-	to := &dns.RecordtlsacloudinfoDelegatedMember{}
+	to := &dns.RecordtlsacloudinfoDelegatedMember{
+		Ipv4addr: flex.ExpandStringPointer(m.Ipv4addr),
+		Ipv6addr: flex.ExpandStringPointer(m.Ipv6addr),
+		Name:     flex.ExpandStringPointer(m.Name),
+	}
 	return to
 }
 
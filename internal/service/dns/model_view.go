@@ -257,6 +257,7 @@ var ViewResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          ViewCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional: true,
@@ -890,6 +891,7 @@ func (m *ViewModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.Vi
 		BlacklistRedirectAddresses:          flex.ExpandFrameworkListString(ctx, m.BlacklistRedirectAddresses, diags),
 		BlacklistRedirectTtl:                flex.ExpandInt64Pointer(m.BlacklistRedirectTtl),
 		BlacklistRulesets:                   flex.ExpandFrameworkListString(ctx, m.BlacklistRulesets, diags),
+		CloudInfo:                           ExpandViewCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:                             flex.ExpandStringPointer(m.Comment),
 		CustomRootNameServers:               flex.ExpandFrameworkListNestedBlock(ctx, m.CustomRootNameServers, diags, ExpandViewCustomRootNameServers),
 		DdnsForceCreationTimestampUpdate:    flex.ExpandBoolPointer(m.DdnsForceCreationTimestampUpdate),
@@ -41,6 +41,7 @@ var ViewCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          ViewcloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "Information about the delegated member.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -88,7 +89,9 @@ func (m *ViewCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnostics
 	if m == nil {
 		return nil
 	}
-	to := &dns.ViewCloudInfo{}
+	to := &dns.ViewCloudInfo{
+		DelegatedMember: ExpandViewcloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -381,6 +381,7 @@ var ZoneAuthResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          ZoneAuthCloudInfoResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The cloud information associated with the zone.",
+		Optional:            true,
 	},
 	"comment": schema.StringAttribute{
 		Optional:            true,
@@ -1164,6 +1165,7 @@ func (m *ZoneAuthModel) Expand(ctx context.Context, diags *diag.Diagnostics, isC
 		AllowTransfer:                       flex.ExpandFrameworkListNestedBlock(ctx, m.AllowTransfer, diags, ExpandZoneAuthAllowTransfer),
 		AllowUpdate:                         flex.ExpandFrameworkListNestedBlock(ctx, m.AllowUpdate, diags, ExpandZoneAuthAllowUpdate),
 		AllowUpdateForwarding:               flex.ExpandBoolPointer(m.AllowUpdateForwarding),
+		CloudInfo:                           ExpandZoneAuthCloudInfo(ctx, m.CloudInfo, diags),
 		Comment:                             flex.ExpandStringPointer(m.Comment),
 		CopyXferToNotify:                    flex.ExpandBoolPointer(m.CopyXferToNotify),
 		CreatePtrForBulkHosts:               flex.ExpandBoolPointer(m.CreatePtrForBulkHosts),
@@ -40,6 +40,7 @@ var ZoneAuthCloudInfoResourceSchemaAttributes = map[string]schema.Attribute{
 		Attributes:          ZoneauthcloudinfoDelegatedMemberResourceSchemaAttributes,
 		Computed:            true,
 		MarkdownDescription: "The Cloud Platform Appliance to which authority of the object is delegated.",
+		Optional:            true,
 	},
 	"delegated_scope": schema.StringAttribute{
 		Computed:            true,
@@ -87,7 +88,9 @@ func (m *ZoneAuthCloudInfoModel) Expand(ctx context.Context, diags *diag.Diagnos
 	if m == nil {
 		return nil
 	}
-	to := &dns.ZoneAuthCloudInfo{}
+	to := &dns.ZoneAuthCloudInfo{
+		DelegatedMember: ExpandZoneauthcloudinfoDelegatedMember(ctx, m.DelegatedMember, diags),
+	}
 	return to
 }
 
@@ -139,7 +139,7 @@ func (d *NsgroupDelegationDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForNsgroupDelegation).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -139,7 +139,7 @@ func (d *NsgroupForwardingmemberDataSource) Read(ctx context.Context, req dataso
 				ReturnFieldsPlus(readableAttributesForNsgroupForwardingmember).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
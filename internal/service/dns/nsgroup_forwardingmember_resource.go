@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type NsgroupForwardingmemberResource struct {
 	client *niosclient.APIClient
 }
 
+// NsgroupForwardingmemberResourceModel describes the resource data model, extending NsgroupForwardingmemberModel with the operation timeouts.
+type NsgroupForwardingmemberResourceModel struct {
+	NsgroupForwardingmemberModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NsgroupForwardingmemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_nsgroup_forwardingmember"
 }
@@ -41,6 +49,7 @@ func (r *NsgroupForwardingmemberResource) Schema(ctx context.Context, req resour
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an NS Group Forwarding Member.",
 		Attributes:          NsgroupForwardingmemberResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *NsgroupForwardingmemberResource) Configure(ctx context.Context, req res
 
 func (r *NsgroupForwardingmemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupForwardingmemberModel
+	var data NsgroupForwardingmemberResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *NsgroupForwardingmemberResource) Create(ctx context.Context, req resour
 
 	var apiRes *dns.CreateNsgroupForwardingmemberResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *NsgroupForwardingmemberResource) Create(ctx context.Context, req resour
 
 func (r *NsgroupForwardingmemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupForwardingmemberModel
+	var data NsgroupForwardingmemberResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *NsgroupForwardingmemberResource) Read(ctx context.Context, req resource
 		apiRes  *dns.GetNsgroupForwardingmemberResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			NsgroupForwardingmemberAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNsgroupForwardingmember).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *NsgroupForwardingmemberResource) Read(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NsgroupForwardingmemberResource) ReadByExtAttrs(ctx context.Context, data *NsgroupForwardingmemberModel, resp *resource.ReadResponse) bool {
+func (r *NsgroupForwardingmemberResource) ReadByExtAttrs(ctx context.Context, data *NsgroupForwardingmemberResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *NsgroupForwardingmemberResource) ReadByExtAttrs(ctx context.Context, da
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNsgroupForwardingmember).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NsgroupForwardingmember by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *NsgroupForwardingmemberResource) ReadByExtAttrs(ctx context.Context, da
 
 func (r *NsgroupForwardingmemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupForwardingmemberModel
+	var data NsgroupForwardingmemberResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -326,7 +335,7 @@ func (r *NsgroupForwardingmemberResource) Update(ctx context.Context, req resour
 
 	var apiRes *dns.UpdateNsgroupForwardingmemberResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *NsgroupForwardingmemberResource) Update(ctx context.Context, req resour
 }
 
 func (r *NsgroupForwardingmemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NsgroupForwardingmemberModel
+	var data NsgroupForwardingmemberResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *NsgroupForwardingmemberResource) Delete(ctx context.Context, req resour
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			NsgroupForwardingmemberAPI.
 			Delete(ctx, resourceRef).
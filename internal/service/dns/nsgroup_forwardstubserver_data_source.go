@@ -139,7 +139,7 @@ func (d *NsgroupForwardstubserverDataSource) Read(ctx context.Context, req datas
 				ReturnFieldsPlus(readableAttributesForNsgroupForwardstubserver).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type NsgroupResource struct {
 	client *niosclient.APIClient
 }
 
+// NsgroupResourceModel describes the resource data model, extending NsgroupModel with the operation timeouts.
+type NsgroupResourceModel struct {
+	NsgroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NsgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_nsgroup"
 }
@@ -42,6 +50,7 @@ func (r *NsgroupResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a NS group.",
 		Attributes:          NsgroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *NsgroupResource) Configure(ctx context.Context, req resource.ConfigureR
 
 func (r *NsgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupModel
+	var data NsgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *NsgroupResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var apiRes *dns.CreateNsgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -136,7 +145,7 @@ func (r *NsgroupResource) Create(ctx context.Context, req resource.CreateRequest
 
 func (r *NsgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupModel
+	var data NsgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -158,14 +167,14 @@ func (r *NsgroupResource) Read(ctx context.Context, req resource.ReadRequest, re
 		apiRes  *dns.GetNsgroupResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			NsgroupAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNsgroup).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -220,7 +229,7 @@ func (r *NsgroupResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NsgroupResource) ReadByExtAttrs(ctx context.Context, data *NsgroupModel, resp *resource.ReadResponse) bool {
+func (r *NsgroupResource) ReadByExtAttrs(ctx context.Context, data *NsgroupResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -247,7 +256,7 @@ func (r *NsgroupResource) ReadByExtAttrs(ctx context.Context, data *NsgroupModel
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNsgroup).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Nsgroup by extattrs, got error: %s", err))
@@ -278,7 +287,7 @@ func (r *NsgroupResource) ReadByExtAttrs(ctx context.Context, data *NsgroupModel
 
 func (r *NsgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupModel
+	var data NsgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -327,7 +336,7 @@ func (r *NsgroupResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var apiRes *dns.UpdateNsgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -370,7 +379,7 @@ func (r *NsgroupResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *NsgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NsgroupModel
+	var data NsgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -381,7 +390,7 @@ func (r *NsgroupResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			NsgroupAPI.
 			Delete(ctx, resourceRef).
@@ -408,7 +417,7 @@ func (r *NsgroupResource) ImportState(ctx context.Context, req resource.ImportSt
 }
 
 func (r *NsgroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data NsgroupModel
+	var data NsgroupResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
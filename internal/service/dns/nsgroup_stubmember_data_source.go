@@ -139,7 +139,7 @@ func (d *NsgroupStubmemberDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForNsgroupStubmember).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
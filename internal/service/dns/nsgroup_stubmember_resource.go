@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type NsgroupStubmemberResource struct {
 	client *niosclient.APIClient
 }
 
+// NsgroupStubmemberResourceModel describes the resource data model, extending NsgroupStubmemberModel with the operation timeouts.
+type NsgroupStubmemberResourceModel struct {
+	NsgroupStubmemberModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NsgroupStubmemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_nsgroup_stubmember"
 }
@@ -41,6 +49,7 @@ func (r *NsgroupStubmemberResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an NS Group Stub Member.",
 		Attributes:          NsgroupStubmemberResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *NsgroupStubmemberResource) Configure(ctx context.Context, req resource.
 
 func (r *NsgroupStubmemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupStubmemberModel
+	var data NsgroupStubmemberResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *NsgroupStubmemberResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *dns.CreateNsgroupStubmemberResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *NsgroupStubmemberResource) Create(ctx context.Context, req resource.Cre
 
 func (r *NsgroupStubmemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupStubmemberModel
+	var data NsgroupStubmemberResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *NsgroupStubmemberResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *dns.GetNsgroupStubmemberResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			NsgroupStubmemberAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNsgroupStubmember).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *NsgroupStubmemberResource) Read(ctx context.Context, req resource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NsgroupStubmemberResource) ReadByExtAttrs(ctx context.Context, data *NsgroupStubmemberModel, resp *resource.ReadResponse) bool {
+func (r *NsgroupStubmemberResource) ReadByExtAttrs(ctx context.Context, data *NsgroupStubmemberResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *NsgroupStubmemberResource) ReadByExtAttrs(ctx context.Context, data *Ns
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNsgroupStubmember).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NsgroupStubmember by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *NsgroupStubmemberResource) ReadByExtAttrs(ctx context.Context, data *Ns
 
 func (r *NsgroupStubmemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NsgroupStubmemberModel
+	var data NsgroupStubmemberResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -326,7 +335,7 @@ func (r *NsgroupStubmemberResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *dns.UpdateNsgroupStubmemberResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *NsgroupStubmemberResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *NsgroupStubmemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NsgroupStubmemberModel
+	var data NsgroupStubmemberResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *NsgroupStubmemberResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			NsgroupStubmemberAPI.
 			Delete(ctx, resourceRef).
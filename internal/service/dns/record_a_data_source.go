@@ -138,7 +138,7 @@ func (d *RecordADataSource) Read(ctx context.Context, req datasource.ReadRequest
 				ReturnFieldsPlus(readableAttributesForRecordA).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
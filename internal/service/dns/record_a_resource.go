@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +37,12 @@ type RecordAResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordAResourceModel describes the resource data model, extending RecordAModel with the operation timeouts.
+type RecordAResourceModel struct {
+	RecordAModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordAResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_record_a"
 	resp.ResourceBehavior = resource.ResourceBehavior{
@@ -47,6 +54,7 @@ func (r *RecordAResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DNS A record.",
 		Attributes:          RecordAResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -82,7 +90,7 @@ func (r *RecordAResource) Configure(ctx context.Context, req resource.ConfigureR
 
 func (r *RecordAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordAModel
+	var data RecordAResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -110,7 +118,11 @@ func (r *RecordAResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var apiRes *dns.CreateRecordAResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network are serialized so that parallel resources get distinct addresses
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -165,7 +177,7 @@ func (r *RecordAResource) Create(ctx context.Context, req resource.CreateRequest
 
 func (r *RecordAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordAModel
+	var data RecordAResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -187,14 +199,14 @@ func (r *RecordAResource) Read(ctx context.Context, req resource.ReadRequest, re
 		apiRes  *dns.GetRecordAResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			RecordAAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordA).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -251,7 +263,7 @@ func (r *RecordAResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordAResource) ReadByExtAttrs(ctx context.Context, data *RecordAModel, resp *resource.ReadResponse) bool {
+func (r *RecordAResource) ReadByExtAttrs(ctx context.Context, data *RecordAResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -278,7 +290,7 @@ func (r *RecordAResource) ReadByExtAttrs(ctx context.Context, data *RecordAModel
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordA).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordA by extattrs, got error: %s", err))
@@ -313,7 +325,7 @@ func (r *RecordAResource) ReadByExtAttrs(ctx context.Context, data *RecordAModel
 
 func (r *RecordAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordAModel
+	var data RecordAResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -364,7 +376,7 @@ func (r *RecordAResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var apiRes *dns.UpdateRecordAResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -410,7 +422,7 @@ func (r *RecordAResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *RecordAResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordAModel
+	var data RecordAResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -421,7 +433,7 @@ func (r *RecordAResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			RecordAAPI.
 			Delete(ctx, resourceRef).
@@ -442,7 +454,7 @@ func (r *RecordAResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
-func (r *RecordAResource) UpdateFuncCallAttributeName(ctx context.Context, data RecordAModel, diags *diag.Diagnostics) types.Object {
+func (r *RecordAResource) UpdateFuncCallAttributeName(ctx context.Context, data RecordAResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type RecordAaaaResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordAaaaResourceModel describes the resource data model, extending RecordAaaaModel with the operation timeouts.
+type RecordAaaaResourceModel struct {
+	RecordAaaaModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordAaaaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_record_aaaa"
 }
@@ -42,6 +49,7 @@ func (r *RecordAaaaResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a AAAA Record.",
 		Attributes:          RecordAaaaResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +75,7 @@ func (r *RecordAaaaResource) Configure(ctx context.Context, req resource.Configu
 
 func (r *RecordAaaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordAaaaModel
+	var data RecordAaaaResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -95,7 +103,11 @@ func (r *RecordAaaaResource) Create(ctx context.Context, req resource.CreateRequ
 
 	var apiRes *dns.CreateRecordAaaaResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network are serialized so that parallel resources get distinct addresses
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -147,7 +159,7 @@ func (r *RecordAaaaResource) Create(ctx context.Context, req resource.CreateRequ
 
 func (r *RecordAaaaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordAaaaModel
+	var data RecordAaaaResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -169,14 +181,14 @@ func (r *RecordAaaaResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *dns.GetRecordAaaaResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			RecordAaaaAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordAaaa).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -231,7 +243,7 @@ func (r *RecordAaaaResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordAaaaResource) ReadByExtAttrs(ctx context.Context, data *RecordAaaaModel, resp *resource.ReadResponse) bool {
+func (r *RecordAaaaResource) ReadByExtAttrs(ctx context.Context, data *RecordAaaaResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -258,7 +270,7 @@ func (r *RecordAaaaResource) ReadByExtAttrs(ctx context.Context, data *RecordAaa
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordAaaa).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordAaaa by extattrs, got error: %s", err))
@@ -289,7 +301,7 @@ func (r *RecordAaaaResource) ReadByExtAttrs(ctx context.Context, data *RecordAaa
 
 func (r *RecordAaaaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordAaaaModel
+	var data RecordAaaaResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -338,7 +350,7 @@ func (r *RecordAaaaResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	var apiRes *dns.UpdateRecordAaaaResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -381,7 +393,7 @@ func (r *RecordAaaaResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *RecordAaaaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordAaaaModel
+	var data RecordAaaaResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -392,7 +404,7 @@ func (r *RecordAaaaResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			RecordAaaaAPI.
 			Delete(ctx, resourceRef).
@@ -413,7 +425,7 @@ func (r *RecordAaaaResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
-func (r *RecordAaaaResource) UpdateFuncCallAttributeName(ctx context.Context, data RecordAaaaModel, diags *diag.Diagnostics) types.Object {
+func (r *RecordAaaaResource) UpdateFuncCallAttributeName(ctx context.Context, data RecordAaaaResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -139,7 +139,7 @@ func (d *RecordAliasDataSource) Read(ctx context.Context, req datasource.ReadReq
 				ReturnFieldsPlus(readableAttributesForRecordAlias).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,20 +9,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
 var readableAttributesForRecordAlias = "aws_rte53_record_info,cloud_info,comment,creator,disable,dns_name,dns_target_name,extattrs,last_queried,name,target_name,target_type,ttl,use_ttl,view,zone"
 
+// minWAPIVersionForRecordAlias is the first WAPI version that supports the record:alias object
+const minWAPIVersionForRecordAlias = "2.12"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RecordAliasResource{}
 var _ resource.ResourceWithImportState = &RecordAliasResource{}
+var _ resource.ResourceWithModifyPlan = &RecordAliasResource{}
 
 func NewRecordAliasResource() resource.Resource {
 	return &RecordAliasResource{}
@@ -33,6 +39,12 @@ type RecordAliasResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordAliasResourceModel describes the resource data model, extending RecordAliasModel with the operation timeouts.
+type RecordAliasResourceModel struct {
+	RecordAliasModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_record_alias"
 }
@@ -41,6 +53,7 @@ func (r *RecordAliasResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DNS Alias record.",
 		Attributes:          RecordAliasResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +79,7 @@ func (r *RecordAliasResource) Configure(ctx context.Context, req resource.Config
 
 func (r *RecordAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordAliasModel
+	var data RecordAliasResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +101,7 @@ func (r *RecordAliasResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *dns.CreateRecordAliasResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +148,7 @@ func (r *RecordAliasResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *RecordAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordAliasModel
+	var data RecordAliasResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +170,14 @@ func (r *RecordAliasResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *dns.GetRecordAliasResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			RecordAliasAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordAlias).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +232,7 @@ func (r *RecordAliasResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordAliasResource) ReadByExtAttrs(ctx context.Context, data *RecordAliasModel, resp *resource.ReadResponse) bool {
+func (r *RecordAliasResource) ReadByExtAttrs(ctx context.Context, data *RecordAliasResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +259,7 @@ func (r *RecordAliasResource) ReadByExtAttrs(ctx context.Context, data *RecordAl
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordAlias).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordAlias by extattrs, got error: %s", err))
@@ -277,7 +290,7 @@ func (r *RecordAliasResource) ReadByExtAttrs(ctx context.Context, data *RecordAl
 
 func (r *RecordAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordAliasModel
+	var data RecordAliasResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -326,7 +339,7 @@ func (r *RecordAliasResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *dns.UpdateRecordAliasResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +382,7 @@ func (r *RecordAliasResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *RecordAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordAliasModel
+	var data RecordAliasResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +393,7 @@ func (r *RecordAliasResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			RecordAliasAPI.
 			Delete(ctx, resourceRef).
@@ -405,3 +418,14 @@ func (r *RecordAliasResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ref"), req.ID)...)
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "associate_internal_id", []byte("true"))...)
 }
+
+func (r *RecordAliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if err := utils.RequireWAPIVersion(r.client, minWAPIVersionForRecordAlias, "nios_dns_record_alias"); err != nil {
+		resp.Diagnostics.AddError("Unsupported WAPI Version", err.Error())
+	}
+}
@@ -140,7 +140,7 @@ func (d *RecordCaaDataSource) Read(ctx context.Context, req datasource.ReadReque
 				ReturnFieldsPlus(readableAttributesForRecordCaa).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
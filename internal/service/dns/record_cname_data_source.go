@@ -139,7 +139,7 @@ func (d *RecordCnameDataSource) Read(ctx context.Context, req datasource.ReadReq
 				ReturnFieldsPlus(readableAttributesForRecordCname).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
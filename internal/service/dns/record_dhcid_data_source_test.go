@@ -0,0 +1,47 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// DHCID Record: a record added by a DDNS update to the zone example.com in the default view
+
+func TestAccRecordDhcidDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_dhcid.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDhcidDataSourceConfigFilters("example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.dhcid"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordDhcidDataSourceConfigFilters(zone, view string) string {
+	return fmt.Sprintf(`
+data "nios_dns_record_dhcid" "test" {
+  filters = {
+    zone = %q
+    view = %q
+  }
+  max_results = 1
+}
+`, zone, view)
+}
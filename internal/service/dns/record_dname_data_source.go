@@ -140,7 +140,7 @@ func (d *RecordDnameDataSource) Read(ctx context.Context, req datasource.ReadReq
 				ReturnFieldsPlus(readableAttributesForRecordDname).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
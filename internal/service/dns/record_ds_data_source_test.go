@@ -0,0 +1,69 @@
+package dns_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccRecordDsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_ds.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+	childFqdn := "child." + zoneFqdn
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDsDataSourceConfigFilters(zoneFqdn, childFqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", childFqdn),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", zoneFqdn),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.key_tag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.digest"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordDsDataSourceConfigFilters(zoneFqdn, childFqdn, view string) string {
+	// Signing the child zone adds its DS records to the signed parent zone
+	config := fmt.Sprintf(`
+resource "nios_dns_zone_auth" "child" {
+	fqdn = %q
+	view = %q
+	grid_primary = [
+		{
+			name = %q
+			stealth = false
+		}
+	]
+	depends_on = [nios_dns_dnssec_operation.test]
+}
+
+resource "nios_dns_dnssec_operation" "child" {
+	ref = nios_dns_zone_auth.child.ref
+	operation = "SIGN"
+}
+
+data "nios_dns_record_ds" "test" {
+	filters = {
+		zone = %q
+		view = %q
+	}
+	depends_on = [nios_dns_dnssec_operation.child]
+}
+`, childFqdn, view, utils.GetNIOSGridMasterHostName(), zoneFqdn, view)
+	return strings.Join([]string{testAccDnssecOperationBasicConfig(zoneFqdn, "SIGN"), config}, "")
+}
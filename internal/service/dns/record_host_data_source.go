@@ -139,7 +139,7 @@ func (d *RecordHostDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				ReturnFieldsPlus(readableAttributesForIPAllocation).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -0,0 +1,40 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordHostResource{}
+var _ resource.ResourceWithImportState = &RecordHostResource{}
+var _ resource.ResourceWithValidateConfig = &RecordHostResource{}
+var _ resource.ResourceWithModifyPlan = &RecordHostResource{}
+
+func NewRecordHostResource() resource.Resource {
+	return &RecordHostResource{
+		IPAllocationResource: IPAllocationResource{managesDHCP: true},
+	}
+}
+
+// RecordHostResource manages the complete host record, including the DHCP settings of its addresses.
+// The lifecycle is shared with IPAllocationResource, which manages the same object without DHCP.
+type RecordHostResource struct {
+	IPAllocationResource
+}
+
+func (r *RecordHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_record_host"
+}
+
+func (r *RecordHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a DNS Host Record.",
+		Attributes:          RecordHostResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
@@ -0,0 +1,414 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// The host record resource shares the RecordHost object with nios_ip_allocation,
+// so the existence and destroy checks of the allocation tests are reused here.
+
+func TestAccRecordHostResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_record_host.test"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+	ipv4addr := []map[string]any{
+		{
+			"ipv4addr": "192.168.2.10",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordHostBasicConfig(name, "default", ipv4addr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.ipv4addr", "192.168.2.10"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.configure_for_dhcp", "false"),
+					resource.TestCheckResourceAttr(resourceName, "configure_for_dns", "true"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "network_view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "rrset_order", "cyclic"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordHostResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_record_host.test"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+	ipv4addr := []map[string]any{
+		{
+			"ipv4addr": "192.168.2.11",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIPAllocationDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordHostBasicConfig(name, "default", ipv4addr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					testAccCheckIPAllocationDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordHostResource_Import(t *testing.T) {
+	var resourceName = "nios_dns_record_host.test"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+	ipv4addr := []map[string]any{
+		{
+			"ipv4addr": "192.168.2.12",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordHostBasicConfig(name, "default", ipv4addr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordHostImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+				ImportStateVerifyIgnore:              []string{"extattrs_all"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordHostResource_Aliases(t *testing.T) {
+	var resourceName = "nios_dns_record_host.test_aliases"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+	alias1 := acctest.RandomName() + ".example.com"
+	alias2 := acctest.RandomName() + ".example.com"
+	ipv4addr := []map[string]any{
+		{
+			"ipv4addr": "192.168.2.13",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordHostAliases(name, []string{alias1}, ipv4addr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aliases.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aliases.0", alias1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordHostAliases(name, []string{alias1, alias2}, ipv4addr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aliases.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordHostResource_Ipv4addrs(t *testing.T) {
+	var resourceName = "nios_dns_record_host.test_ipv4addrs"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+	ipv4addrs := []map[string]any{
+		{
+			"ipv4addr": "192.168.2.14",
+		},
+		{
+			"ipv4addr": "192.168.2.15",
+		},
+	}
+	updatedIpv4addrs := []map[string]any{
+		{
+			"ipv4addr": "192.168.2.14",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordHostIpv4addrs(name, ipv4addrs),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.ipv4addr", "192.168.2.14"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.1.ipv4addr", "192.168.2.15"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordHostIpv4addrs(name, updatedIpv4addrs),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.ipv4addr", "192.168.2.14"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordHostResource_Ipv4addrsDhcp(t *testing.T) {
+	var resourceName = "nios_dns_record_host.test_ipv4addrs"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+	ipv4addrs := []map[string]any{
+		{
+			"ipv4addr":           "192.168.2.16",
+			"mac":                "12:00:43:fe:9a:8c",
+			"configure_for_dhcp": false,
+		},
+	}
+	updatedIpv4addrs := []map[string]any{
+		{
+			"ipv4addr":           "192.168.2.16",
+			"mac":                "12:00:43:fe:9a:8d",
+			"configure_for_dhcp": true,
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordHostIpv4addrs(name, ipv4addrs),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.mac", "12:00:43:fe:9a:8c"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.configure_for_dhcp", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordHostIpv4addrs(name, updatedIpv4addrs),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.mac", "12:00:43:fe:9a:8d"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.configure_for_dhcp", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordHostResource_Ipv4addrsFuncCall(t *testing.T) {
+	var resourceName = "nios_dns_record_host.test_func_call"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordHostFuncCall(name, "86.86.0.0/16", "Original Function Call"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv4addrs.0.ipv4addr"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Original Function Call"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordHostFuncCall(name, "86.86.0.0/16", "Function Call with Update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Function Call with Update"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordHostResource_Ipv6addrs(t *testing.T) {
+	var resourceName = "nios_dns_record_host.test_ipv6addrs"
+	var v dns.RecordHost
+
+	name := acctest.RandomName() + ".example.com"
+	ipv6addrs := []map[string]any{
+		{
+			"ipv6addr":     "2002:1f93::12:10",
+			"duid":         "00:01:5f:3a:1b:2c:12:34:56:78:9a:bc",
+			"match_client": "DUID",
+		},
+	}
+	updatedIpv6addrs := []map[string]any{
+		{
+			"ipv6addr":     "2002:1f93::12:11",
+			"duid":         "00:01:5f:3a:1b:2c:12:34:56:78:9a:bd",
+			"match_client": "DUID",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordHostIpv6addrs(name, ipv6addrs),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addrs.0.ipv6addr", "2002:1f93::12:10"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addrs.0.duid", "00:01:5f:3a:1b:2c:12:34:56:78:9a:bc"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordHostIpv6addrs(name, updatedIpv6addrs),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAllocationExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addrs.0.ipv6addr", "2002:1f93::12:11"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addrs.0.duid", "00:01:5f:3a:1b:2c:12:34:56:78:9a:bd"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordHostImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.Attributes["ref"] == "" {
+			return "", fmt.Errorf("ref is not set")
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccRecordHostBasicConfig(name, view string, ipv4addr []map[string]any) string {
+	ipv4addrHCL := utils.ConvertSliceOfMapsToHCL(ipv4addr)
+	return fmt.Sprintf(`
+resource "nios_dns_record_host" "test" {
+	name = %q
+	ipv4addrs = %s
+	view = %q
+}
+`, name, ipv4addrHCL, view)
+}
+
+func testAccRecordHostAliases(name string, aliases []string, ipv4addr []map[string]any) string {
+	aliasesHCL := utils.ConvertStringSliceToHCL(aliases)
+	ipv4addrHCL := utils.ConvertSliceOfMapsToHCL(ipv4addr)
+	return fmt.Sprintf(`
+resource "nios_dns_record_host" "test_aliases" {
+	name = %q
+	aliases = %s
+	ipv4addrs = %s
+}
+`, name, aliasesHCL, ipv4addrHCL)
+}
+
+func testAccRecordHostIpv4addrs(name string, ipv4addrs []map[string]any) string {
+	ipv4addrHCL := utils.ConvertSliceOfMapsToHCL(ipv4addrs)
+	return fmt.Sprintf(`
+resource "nios_dns_record_host" "test_ipv4addrs" {
+	name = %q
+	ipv4addrs = %s
+}
+`, name, ipv4addrHCL)
+}
+
+func testAccRecordHostIpv6addrs(name string, ipv6addrs []map[string]any) string {
+	ipv6addrHCL := utils.ConvertSliceOfMapsToHCL(ipv6addrs)
+	return fmt.Sprintf(`
+resource "nios_dns_record_host" "test_ipv6addrs" {
+	name = %q
+	ipv6addrs = %s
+}
+`, name, ipv6addrHCL)
+}
+
+func testAccRecordHostFuncCall(name, network, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_network" "test_func_call" {
+	network = %[2]q
+	network_view = "default"
+}
+
+resource "nios_dns_record_host" "test_func_call" {
+	name = %[1]q
+	ipv4addrs = [
+		{
+			func_call = {
+				attribute_name = "ipv4addr"
+				object_function = "next_available_ip"
+				result_field = "ips"
+				object = "network"
+				object_parameters = {
+					network = %[2]q
+					network_view = "default"
+				}
+			}
+		}
+	]
+	comment = %[3]q
+	depends_on = [nios_ipam_network.test_func_call]
+}
+`, name, network, comment)
+}
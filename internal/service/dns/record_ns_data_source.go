@@ -132,7 +132,7 @@ func (d *RecordNsDataSource) Read(ctx context.Context, req datasource.ReadReques
 				ReturnFieldsPlus(readableAttributesForRecordNs).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
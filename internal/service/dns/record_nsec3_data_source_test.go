@@ -0,0 +1,48 @@
+package dns_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordNsec3DataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_nsec3.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordNsec3DataSourceConfigFilters(zoneFqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", zoneFqdn),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.next_owner_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.rrset_types.#"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordNsec3DataSourceConfigFilters(zoneFqdn, view string) string {
+	config := fmt.Sprintf(`
+data "nios_dns_record_nsec3" "test" {
+	filters = {
+		zone = %q
+		view = %q
+	}
+	depends_on = [nios_dns_dnssec_operation.test]
+}
+`, zoneFqdn, view)
+	return strings.Join([]string{testAccDnssecOperationBasicConfig(zoneFqdn, "SIGN"), config}, "")
+}
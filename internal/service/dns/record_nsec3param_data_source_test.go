@@ -0,0 +1,48 @@
+package dns_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordNsec3paramDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_nsec3param.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordNsec3paramDataSourceConfigFilters(zoneFqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", zoneFqdn),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", zoneFqdn),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.iterations"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordNsec3paramDataSourceConfigFilters(zoneFqdn, view string) string {
+	config := fmt.Sprintf(`
+data "nios_dns_record_nsec3param" "test" {
+	filters = {
+		zone = %q
+		view = %q
+	}
+	depends_on = [nios_dns_dnssec_operation.test]
+}
+`, zoneFqdn, view)
+	return strings.Join([]string{testAccDnssecOperationBasicConfig(zoneFqdn, "SIGN"), config}, "")
+}
@@ -0,0 +1,68 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccRecordNsecDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_record_nsec.test"
+	zoneFqdn := acctest.RandomNameWithPrefix("zone") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordNsecDataSourceConfigFilters(zoneFqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.zone", zoneFqdn),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.view", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.next_owner_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.rrset_types.#"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccRecordNsecDataSourceConfigFilters(zoneFqdn, view string) string {
+	// The zone is signed with NSEC, as the grid default is NSEC3
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test" {
+	fqdn = %q
+	view = %q
+	grid_primary = [
+		{
+			name = %q
+			stealth = false
+		}
+	]
+	use_dnssec_key_params = true
+	dnssec_key_params = {
+		next_secure_type = "NSEC"
+	}
+}
+
+resource "nios_dns_dnssec_operation" "test" {
+	ref = nios_dns_zone_auth.test.ref
+	operation = "SIGN"
+}
+
+data "nios_dns_record_nsec" "test" {
+	filters = {
+		zone = %q
+		view = %q
+	}
+	depends_on = [nios_dns_dnssec_operation.test]
+}
+`, zoneFqdn, view, utils.GetNIOSGridMasterHostName(), zoneFqdn, view)
+}
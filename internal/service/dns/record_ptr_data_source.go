@@ -139,7 +139,7 @@ func (d *RecordPtrDataSource) Read(ctx context.Context, req datasource.ReadReque
 				ReturnFieldsPlus(readableAttributesForRecordPtr).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -37,6 +38,12 @@ type RecordPtrResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordPtrResourceModel describes the resource data model, extending RecordPtrModel with the operation timeouts.
+type RecordPtrResourceModel struct {
+	RecordPtrModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordPtrResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_record_ptr"
 }
@@ -45,6 +52,7 @@ func (r *RecordPtrResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DNS PTR Record.",
 		Attributes:          RecordPtrResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -93,7 +101,7 @@ func (r *RecordPtrResource) ValidateConfig(ctx context.Context, req resource.Val
 
 func (r *RecordPtrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordPtrModel
+	var data RecordPtrResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -121,7 +129,11 @@ func (r *RecordPtrResource) Create(ctx context.Context, req resource.CreateReque
 
 	var apiRes *dns.CreateRecordPtrResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network are serialized so that parallel resources get distinct addresses
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -173,7 +185,7 @@ func (r *RecordPtrResource) Create(ctx context.Context, req resource.CreateReque
 
 func (r *RecordPtrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordPtrModel
+	var data RecordPtrResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -195,14 +207,14 @@ func (r *RecordPtrResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *dns.GetRecordPtrResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			RecordPtrAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordPtr).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -257,7 +269,7 @@ func (r *RecordPtrResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordPtrResource) ReadByExtAttrs(ctx context.Context, data *RecordPtrModel, resp *resource.ReadResponse) bool {
+func (r *RecordPtrResource) ReadByExtAttrs(ctx context.Context, data *RecordPtrResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -284,7 +296,7 @@ func (r *RecordPtrResource) ReadByExtAttrs(ctx context.Context, data *RecordPtrM
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordPtr).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordPtr by extattrs, got error: %s", err))
@@ -315,7 +327,7 @@ func (r *RecordPtrResource) ReadByExtAttrs(ctx context.Context, data *RecordPtrM
 
 func (r *RecordPtrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordPtrModel
+	var data RecordPtrResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -364,7 +376,7 @@ func (r *RecordPtrResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var apiRes *dns.UpdateRecordPtrResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -407,7 +419,7 @@ func (r *RecordPtrResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *RecordPtrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordPtrModel
+	var data RecordPtrResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -418,7 +430,7 @@ func (r *RecordPtrResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			RecordPtrAPI.
 			Delete(ctx, resourceRef).
@@ -439,7 +451,7 @@ func (r *RecordPtrResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
-func (r *RecordPtrResource) UpdateFuncCallAttributeName(ctx context.Context, data RecordPtrModel, diags *diag.Diagnostics) types.Object {
+func (r *RecordPtrResource) UpdateFuncCallAttributeName(ctx context.Context, data RecordPtrResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -140,7 +140,7 @@ func (d *RecordTlsaDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				ReturnFieldsPlus(readableAttributesForRecordTlsa).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -140,7 +140,7 @@ func (d *RecordUnknownDataSource) Read(ctx context.Context, req datasource.ReadR
 				ReturnFieldsPlus(readableAttributesForRecordUnknown).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -0,0 +1,61 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordnamepolicyDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_recordnamepolicy.test"
+	resourceName := "nios_dns_recordnamepolicy.test"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("recordnamepolicy")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnamepolicyDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnamepolicyDataSourceConfigFilters(name),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordnamepolicyResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordnamepolicyResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "is_default", dataSourceName, "result.0.is_default"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "pre_defined", dataSourceName, "result.0.pre_defined"),
+		resource.TestCheckResourceAttrPair(resourceName, "regex", dataSourceName, "result.0.regex"),
+	}
+}
+
+func testAccRecordnamepolicyDataSourceConfigFilters(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test" {
+  name = %q
+  regex = "^[a-z0-9-]+$"
+}
+
+data "nios_dns_recordnamepolicy" "test" {
+  filters = {
+	 name = nios_dns_recordnamepolicy.test.name
+  }
+}
+`, name)
+}
@@ -0,0 +1,208 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForRecordnamepolicy = "is_default,name,pre_defined,regex"
+
+func TestAccRecordnamepolicyResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("recordnamepolicy")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnamepolicyBasicConfig(name, "^[a-z0-9-]+$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "regex", "^[a-z0-9-]+$"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "pre_defined", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_recordnamepolicy.test"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("recordnamepolicy")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnamepolicyDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnamepolicyBasicConfig(name, "^[a-z0-9-]+$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					testAccCheckRecordnamepolicyDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test_name"
+	var v dns.Recordnamepolicy
+	name1 := acctest.RandomNameWithPrefix("recordnamepolicy")
+	name2 := acctest.RandomNameWithPrefix("recordnamepolicy")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnamepolicyName(name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnamepolicyName(name2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnamepolicyResource_Regex(t *testing.T) {
+	var resourceName = "nios_dns_recordnamepolicy.test_regex"
+	var v dns.Recordnamepolicy
+	name := acctest.RandomNameWithPrefix("recordnamepolicy")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnamepolicyRegex(name, "^[a-z0-9-]+$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex", "^[a-z0-9-]+$"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnamepolicyRegex(name, "^[a-zA-Z0-9_-]+$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnamepolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex", "^[a-zA-Z0-9_-]+$"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckRecordnamepolicyExists(ctx context.Context, resourceName string, v *dns.Recordnamepolicy) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordnamepolicyAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForRecordnamepolicy).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetRecordnamepolicyResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetRecordnamepolicyResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordnamepolicyDestroy(ctx context.Context, v *dns.Recordnamepolicy) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordnamepolicyAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForRecordnamepolicy).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordnamepolicyDisappears(ctx context.Context, v *dns.Recordnamepolicy) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordnamepolicyAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordnamepolicyBasicConfig(name, regex string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test" {
+    name = %q
+    regex = %q
+}
+`, name, regex)
+}
+
+func testAccRecordnamepolicyName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test_name" {
+    name = %q
+    regex = "^[a-z0-9-]+$"
+}
+`, name)
+}
+
+func testAccRecordnamepolicyRegex(name, regex string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_recordnamepolicy" "test_regex" {
+    name = %q
+    regex = %q
+}
+`, name, regex)
+}
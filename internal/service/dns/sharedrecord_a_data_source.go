@@ -139,7 +139,7 @@ func (d *SharedrecordADataSource) Read(ctx context.Context, req datasource.ReadR
 				ReturnFieldsPlus(readableAttributesForSharedrecordA).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -139,7 +139,7 @@ func (d *SharedrecordAaaaDataSource) Read(ctx context.Context, req datasource.Re
 				ReturnFieldsPlus(readableAttributesForSharedrecordAaaa).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type SharedrecordAaaaResource struct {
 	client *niosclient.APIClient
 }
 
+// SharedrecordAaaaResourceModel describes the resource data model, extending SharedrecordAaaaModel with the operation timeouts.
+type SharedrecordAaaaResourceModel struct {
+	SharedrecordAaaaModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SharedrecordAaaaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_sharedrecord_aaaa"
 }
@@ -41,6 +49,7 @@ func (r *SharedrecordAaaaResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Shared AAAA Record.",
 		Attributes:          SharedrecordAaaaResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *SharedrecordAaaaResource) Configure(ctx context.Context, req resource.C
 
 func (r *SharedrecordAaaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordAaaaModel
+	var data SharedrecordAaaaResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,7 +91,7 @@ func (r *SharedrecordAaaaResource) Create(ctx context.Context, req resource.Crea
 
 	var apiRes *dns.CreateSharedrecordAaaaResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -129,7 +138,7 @@ func (r *SharedrecordAaaaResource) Create(ctx context.Context, req resource.Crea
 
 func (r *SharedrecordAaaaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordAaaaModel
+	var data SharedrecordAaaaResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,14 +154,14 @@ func (r *SharedrecordAaaaResource) Read(ctx context.Context, req resource.ReadRe
 		apiRes  *dns.GetSharedrecordAaaaResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			SharedrecordAaaaAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSharedrecordAaaa).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -187,7 +196,7 @@ func (r *SharedrecordAaaaResource) Read(ctx context.Context, req resource.ReadRe
 
 func (r *SharedrecordAaaaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordAaaaModel
+	var data SharedrecordAaaaResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -225,7 +234,7 @@ func (r *SharedrecordAaaaResource) Update(ctx context.Context, req resource.Upda
 
 	var apiRes *dns.UpdateSharedrecordAaaaResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -264,7 +273,7 @@ func (r *SharedrecordAaaaResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *SharedrecordAaaaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SharedrecordAaaaModel
+	var data SharedrecordAaaaResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -275,7 +284,7 @@ func (r *SharedrecordAaaaResource) Delete(ctx context.Context, req resource.Dele
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			SharedrecordAaaaAPI.
 			Delete(ctx, resourceRef).
@@ -139,7 +139,7 @@ func (d *SharedrecordCnameDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForSharedrecordCname).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
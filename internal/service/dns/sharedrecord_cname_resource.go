@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type SharedrecordCnameResource struct {
 	client *niosclient.APIClient
 }
 
+// SharedrecordCnameResourceModel describes the resource data model, extending SharedrecordCnameModel with the operation timeouts.
+type SharedrecordCnameResourceModel struct {
+	SharedrecordCnameModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SharedrecordCnameResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_sharedrecord_cname"
 }
@@ -41,6 +49,7 @@ func (r *SharedrecordCnameResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DNS Shared CNAME Record.",
 		Attributes:          SharedrecordCnameResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *SharedrecordCnameResource) Configure(ctx context.Context, req resource.
 
 func (r *SharedrecordCnameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordCnameModel
+	var data SharedrecordCnameResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,7 +91,7 @@ func (r *SharedrecordCnameResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *dns.CreateSharedrecordCnameResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -129,7 +138,7 @@ func (r *SharedrecordCnameResource) Create(ctx context.Context, req resource.Cre
 
 func (r *SharedrecordCnameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordCnameModel
+	var data SharedrecordCnameResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,14 +154,14 @@ func (r *SharedrecordCnameResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *dns.GetSharedrecordCnameResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			SharedrecordCnameAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSharedrecordCname).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -185,7 +194,7 @@ func (r *SharedrecordCnameResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *SharedrecordCnameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordCnameModel
+	var data SharedrecordCnameResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -223,7 +232,7 @@ func (r *SharedrecordCnameResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *dns.UpdateSharedrecordCnameResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -262,7 +271,7 @@ func (r *SharedrecordCnameResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *SharedrecordCnameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SharedrecordCnameModel
+	var data SharedrecordCnameResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -273,7 +282,7 @@ func (r *SharedrecordCnameResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			SharedrecordCnameAPI.
 			Delete(ctx, resourceRef).
@@ -139,7 +139,7 @@ func (d *SharedrecordMxDataSource) Read(ctx context.Context, req datasource.Read
 				ReturnFieldsPlus(readableAttributesForSharedrecordMx).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
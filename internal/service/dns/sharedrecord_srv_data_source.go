@@ -139,7 +139,7 @@ func (d *SharedrecordSrvDataSource) Read(ctx context.Context, req datasource.Rea
 				ReturnFieldsPlus(readableAttributesForSharedrecordSrv).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type SharedrecordSrvResource struct {
 	client *niosclient.APIClient
 }
 
+// SharedrecordSrvResourceModel describes the resource data model, extending SharedrecordSrvModel with the operation timeouts.
+type SharedrecordSrvResourceModel struct {
+	SharedrecordSrvModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SharedrecordSrvResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_sharedrecord_srv"
 }
@@ -41,6 +49,7 @@ func (r *SharedrecordSrvResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DNS Shared SRV Record.",
 		Attributes:          SharedrecordSrvResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *SharedrecordSrvResource) Configure(ctx context.Context, req resource.Co
 
 func (r *SharedrecordSrvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordSrvModel
+	var data SharedrecordSrvResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,7 +91,7 @@ func (r *SharedrecordSrvResource) Create(ctx context.Context, req resource.Creat
 
 	var apiRes *dns.CreateSharedrecordSrvResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -129,7 +138,7 @@ func (r *SharedrecordSrvResource) Create(ctx context.Context, req resource.Creat
 
 func (r *SharedrecordSrvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordSrvModel
+	var data SharedrecordSrvResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,14 +154,14 @@ func (r *SharedrecordSrvResource) Read(ctx context.Context, req resource.ReadReq
 		apiRes  *dns.GetSharedrecordSrvResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			SharedrecordSrvAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSharedrecordSrv).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -187,7 +196,7 @@ func (r *SharedrecordSrvResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *SharedrecordSrvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordSrvModel
+	var data SharedrecordSrvResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -225,7 +234,7 @@ func (r *SharedrecordSrvResource) Update(ctx context.Context, req resource.Updat
 
 	var apiRes *dns.UpdateSharedrecordSrvResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -264,7 +273,7 @@ func (r *SharedrecordSrvResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *SharedrecordSrvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SharedrecordSrvModel
+	var data SharedrecordSrvResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -275,7 +284,7 @@ func (r *SharedrecordSrvResource) Delete(ctx context.Context, req resource.Delet
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			SharedrecordSrvAPI.
 			Delete(ctx, resourceRef).
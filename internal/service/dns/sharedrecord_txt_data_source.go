@@ -139,7 +139,7 @@ func (d *SharedrecordTxtDataSource) Read(ctx context.Context, req datasource.Rea
 				ReturnFieldsPlus(readableAttributesForSharedrecordTxt).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
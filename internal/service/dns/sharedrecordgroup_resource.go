@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type SharedrecordgroupResource struct {
 	client *niosclient.APIClient
 }
 
+// SharedrecordgroupResourceModel describes the resource data model, extending SharedrecordgroupModel with the operation timeouts.
+type SharedrecordgroupResourceModel struct {
+	SharedrecordgroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SharedrecordgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_sharedrecordgroup"
 }
@@ -42,6 +50,7 @@ func (r *SharedrecordgroupResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Shared Record Group.",
 		Attributes:          SharedrecordgroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *SharedrecordgroupResource) Configure(ctx context.Context, req resource.
 
 func (r *SharedrecordgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordgroupModel
+	var data SharedrecordgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *SharedrecordgroupResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *dns.CreateSharedrecordgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -136,7 +145,7 @@ func (r *SharedrecordgroupResource) Create(ctx context.Context, req resource.Cre
 
 func (r *SharedrecordgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordgroupModel
+	var data SharedrecordgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -158,14 +167,14 @@ func (r *SharedrecordgroupResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *dns.GetSharedrecordgroupResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			SharedrecordgroupAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSharedrecordgroup).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -220,7 +229,7 @@ func (r *SharedrecordgroupResource) Read(ctx context.Context, req resource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *SharedrecordgroupResource) ReadByExtAttrs(ctx context.Context, data *SharedrecordgroupModel, resp *resource.ReadResponse) bool {
+func (r *SharedrecordgroupResource) ReadByExtAttrs(ctx context.Context, data *SharedrecordgroupResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -247,7 +256,7 @@ func (r *SharedrecordgroupResource) ReadByExtAttrs(ctx context.Context, data *Sh
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForSharedrecordgroup).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Sharedrecordgroup by extattrs, got error: %s", err))
@@ -278,7 +287,7 @@ func (r *SharedrecordgroupResource) ReadByExtAttrs(ctx context.Context, data *Sh
 
 func (r *SharedrecordgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SharedrecordgroupModel
+	var data SharedrecordgroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -328,7 +337,7 @@ func (r *SharedrecordgroupResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *dns.UpdateSharedrecordgroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -370,7 +379,7 @@ func (r *SharedrecordgroupResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *SharedrecordgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SharedrecordgroupModel
+	var data SharedrecordgroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -381,7 +390,7 @@ func (r *SharedrecordgroupResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			SharedrecordgroupAPI.
 			Delete(ctx, resourceRef).
@@ -403,7 +412,7 @@ func (r *SharedrecordgroupResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *SharedrecordgroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data SharedrecordgroupModel
+	var data SharedrecordgroupResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -139,7 +139,7 @@ func (d *ViewDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 				ReturnFieldsPlus(readableAttributesForView).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -0,0 +1,42 @@
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Zone Auth Discrepancy: a discrepancy reported for an authoritative zone
+
+func TestAccZoneAuthDiscrepancyDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_auth_discrepancy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneAuthDiscrepancyDataSourceConfigFilters(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.zone"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.severity"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.description"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccZoneAuthDiscrepancyDataSourceConfigFilters() string {
+	return `
+data "nios_dns_zone_auth_discrepancy" "test" {
+  max_results = 1
+}
+`
+}
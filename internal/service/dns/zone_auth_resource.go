@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type ZoneAuthResource struct {
 	client *niosclient.APIClient
 }
 
+// ZoneAuthResourceModel describes the resource data model, extending ZoneAuthModel with the operation timeouts.
+type ZoneAuthResourceModel struct {
+	ZoneAuthModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *ZoneAuthResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_zone_auth"
 }
@@ -43,6 +51,7 @@ func (r *ZoneAuthResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Auth Zones.",
 		Attributes:          ZoneAuthResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *ZoneAuthResource) Configure(ctx context.Context, req resource.Configure
 
 func (r *ZoneAuthResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 
-	var data ZoneAuthModel
+	var data ZoneAuthResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -166,7 +175,7 @@ func (r *ZoneAuthResource) ValidateConfig(ctx context.Context, req resource.Vali
 
 func (r *ZoneAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data ZoneAuthModel
+	var data ZoneAuthResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -188,7 +197,7 @@ func (r *ZoneAuthResource) Create(ctx context.Context, req resource.CreateReques
 
 	var apiRes *dns.CreateZoneAuthResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -235,7 +244,7 @@ func (r *ZoneAuthResource) Create(ctx context.Context, req resource.CreateReques
 
 func (r *ZoneAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data ZoneAuthModel
+	var data ZoneAuthResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -257,14 +266,14 @@ func (r *ZoneAuthResource) Read(ctx context.Context, req resource.ReadRequest, r
 		apiRes  *dns.GetZoneAuthResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			ZoneAuthAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForZoneAuth).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -319,7 +328,7 @@ func (r *ZoneAuthResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *ZoneAuthResource) ReadByExtAttrs(ctx context.Context, data *ZoneAuthModel, resp *resource.ReadResponse) bool {
+func (r *ZoneAuthResource) ReadByExtAttrs(ctx context.Context, data *ZoneAuthResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -346,7 +355,7 @@ func (r *ZoneAuthResource) ReadByExtAttrs(ctx context.Context, data *ZoneAuthMod
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForZoneAuth).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ZoneAuth by extattrs, got error: %s", err))
@@ -377,7 +386,7 @@ func (r *ZoneAuthResource) ReadByExtAttrs(ctx context.Context, data *ZoneAuthMod
 
 func (r *ZoneAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data ZoneAuthModel
+	var data ZoneAuthResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -426,7 +435,7 @@ func (r *ZoneAuthResource) Update(ctx context.Context, req resource.UpdateReques
 
 	var apiRes *dns.UpdateZoneAuthResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -469,7 +478,7 @@ func (r *ZoneAuthResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *ZoneAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ZoneAuthModel
+	var data ZoneAuthResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -480,7 +489,7 @@ func (r *ZoneAuthResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			ZoneAuthAPI.
 			Delete(ctx, resourceRef).
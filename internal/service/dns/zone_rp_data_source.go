@@ -140,7 +140,7 @@ func (d *ZoneRpDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 				ReturnFieldsPlus(readableAttributesForZoneRp).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dns"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type ZoneRpResource struct {
 	client *niosclient.APIClient
 }
 
+// ZoneRpResourceModel describes the resource data model, extending ZoneRpModel with the operation timeouts.
+type ZoneRpResourceModel struct {
+	ZoneRpModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *ZoneRpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dns_zone_rp"
 }
@@ -43,6 +51,7 @@ func (r *ZoneRpResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DNS RPZ (Response Policy Zone).",
 		Attributes:          ZoneRpResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *ZoneRpResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *ZoneRpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 
-	var data ZoneRpModel
+	var data ZoneRpResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -119,7 +128,7 @@ func (r *ZoneRpResource) ValidateConfig(ctx context.Context, req resource.Valida
 
 func (r *ZoneRpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data ZoneRpModel
+	var data ZoneRpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -141,7 +150,7 @@ func (r *ZoneRpResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	var apiRes *dns.CreateZoneRpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -188,7 +197,7 @@ func (r *ZoneRpResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *ZoneRpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data ZoneRpModel
+	var data ZoneRpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -210,14 +219,14 @@ func (r *ZoneRpResource) Read(ctx context.Context, req resource.ReadRequest, res
 		apiRes  *dns.GetZoneRpResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DNSAPI.
 			ZoneRpAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForZoneRp).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -272,7 +281,7 @@ func (r *ZoneRpResource) Read(ctx context.Context, req resource.ReadRequest, res
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *ZoneRpResource) ReadByExtAttrs(ctx context.Context, data *ZoneRpModel, resp *resource.ReadResponse) bool {
+func (r *ZoneRpResource) ReadByExtAttrs(ctx context.Context, data *ZoneRpResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -299,7 +308,7 @@ func (r *ZoneRpResource) ReadByExtAttrs(ctx context.Context, data *ZoneRpModel,
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForZoneRp).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ZoneRp by extattrs, got error: %s", err))
@@ -330,7 +339,7 @@ func (r *ZoneRpResource) ReadByExtAttrs(ctx context.Context, data *ZoneRpModel,
 
 func (r *ZoneRpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data ZoneRpModel
+	var data ZoneRpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -379,7 +388,7 @@ func (r *ZoneRpResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	var apiRes *dns.UpdateZoneRpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -422,7 +431,7 @@ func (r *ZoneRpResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *ZoneRpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ZoneRpModel
+	var data ZoneRpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -433,7 +442,7 @@ func (r *ZoneRpResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DNSAPI.
 			ZoneRpAPI.
 			Delete(ctx, resourceRef).
@@ -139,7 +139,7 @@ func (d *ZoneStubDataSource) Read(ctx context.Context, req datasource.ReadReques
 				ReturnFieldsPlus(readableAttributesForZoneStub).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
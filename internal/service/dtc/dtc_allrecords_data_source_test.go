@@ -0,0 +1,55 @@
+package dtc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDtcAllrecordsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dtc_allrecords.test"
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+	serverIP := acctest.RandomIP()
+	recordIP := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcAllrecordsDataSourceConfigFilters(serverName, serverIP, recordIP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.dtc_server", serverName),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.type", "A"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.record", "nios_dtc_record_a.test", "ref"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccDtcAllrecordsDataSourceConfigFilters(serverName, serverIP, recordIP string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_server" "test" {
+  name = %q
+  host = %q
+}
+
+resource "nios_dtc_record_a" "test" {
+  dtc_server = nios_dtc_server.test.name
+  ipv4addr   = %q
+}
+
+data "nios_dtc_allrecords" "test" {
+  filters = {
+    dtc_server = nios_dtc_record_a.test.dtc_server
+  }
+}
+`, serverName, serverIP, recordIP)
+}
@@ -0,0 +1,292 @@
+package dtc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDtcCertificate = "certificate,in_use"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DtcCertificateResource{}
+var _ resource.ResourceWithImportState = &DtcCertificateResource{}
+
+func NewDtcCertificateResource() resource.Resource {
+	return &DtcCertificateResource{}
+}
+
+// DtcCertificateResource defines the resource implementation.
+type DtcCertificateResource struct {
+	client *niosclient.APIClient
+}
+
+// DtcCertificateResourceModel describes the resource data model, extending DtcCertificateModel with the operation timeouts.
+type DtcCertificateResourceModel struct {
+	DtcCertificateModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *DtcCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dtc_certificate"
+}
+
+func (r *DtcCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a DTC Certificate used by HTTPS health monitors.",
+		Attributes:          DtcCertificateResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *DtcCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DtcCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DtcCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseUrl := utils.WAPIBaseURL(r.client.DTCAPI.Cfg)
+
+	// The certificate added is found by comparing the certificates present before and after the upload,
+	// so the uploads to the same Grid are serialized to keep parallel creates from picking each other's certificate
+	lockKey := "dtc:certificate," + baseUrl
+	utils.GlobalMutexStore.Lock(lockKey)
+	defer utils.GlobalMutexStore.Unlock(lockKey)
+
+	// The certificates present before the upload are used to find the one added by this resource
+	existingRefs := r.listCertificateRefs(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filePath := data.CertificateFilePath.ValueString()
+	token, err := utils.UploadFileWithToken(ctx, r.client.DTCAPI.Cfg.HTTPClient, baseUrl, filePath)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to process certificate file %s, got error: %s", filePath, err))
+		return
+	}
+
+	dtcRef := r.getDtcRef(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		_, httpRes, callErr := utils.CallWAPIFunction(ctx, r.client.DTCAPI.Cfg.HTTPClient, baseUrl, dtcRef, "add_certificate", map[string]string{"token": token})
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DtcCertificate, got error: %s", err))
+		return
+	}
+
+	apiRes, _, err := r.client.DTCAPI.
+		DtcCertificateAPI.
+		List(ctx).
+		ReturnFieldsPlus(readableAttributesForDtcCertificate).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcCertificate after upload, got error: %s", err))
+		return
+	}
+
+	for _, res := range apiRes.ListDtcCertificateResponseObject.GetResult() {
+		if _, ok := existingRefs[res.GetRef()]; ok {
+			continue
+		}
+		data.Flatten(ctx, &res, &resp.Diagnostics)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Client Error",
+		fmt.Sprintf("Unable to find the DtcCertificate uploaded from %s. The certificate may already be present in NIOS, please import the existing resource into terraform state.", filePath),
+	)
+}
+
+func (r *DtcCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DtcCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *dtc.GetDtcCertificateResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.DTCAPI.
+			DtcCertificateAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForDtcCertificate).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcCertificate, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetDtcCertificateResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DtcCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, only the timeouts can change in place
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("certificate"), &data.Certificate)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("in_use"), &data.InUse)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DtcCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DtcCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseUrl := utils.WAPIBaseURL(r.client.DTCAPI.Cfg)
+
+	// The client does not expose a Delete call for dtc:certificate, so the WAPI is called directly
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := utils.DeleteWAPIObject(ctx, r.client.DTCAPI.Cfg.HTTPClient, baseUrl, data.Ref.ValueString())
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DtcCertificate, got error: %s", err))
+		return
+	}
+}
+
+func (r *DtcCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// listCertificateRefs returns the references of all DTC certificates currently present in NIOS
+func (r *DtcCertificateResource) listCertificateRefs(ctx context.Context, diags *diag.Diagnostics) map[string]struct{} {
+	apiRes, _, err := r.client.DTCAPI.
+		DtcCertificateAPI.
+		List(ctx).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list DtcCertificate, got error: %s", err))
+		return nil
+	}
+
+	refs := map[string]struct{}{}
+	for _, res := range apiRes.ListDtcCertificateResponseObject.GetResult() {
+		refs[res.GetRef()] = struct{}{}
+	}
+	return refs
+}
+
+// getDtcRef returns the reference of the DTC object the add_certificate function is called on
+func (r *DtcCertificateResource) getDtcRef(ctx context.Context, diags *diag.Diagnostics) string {
+	apiRes, _, err := r.client.DTCAPI.
+		DtcAPI.
+		List(ctx).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Dtc, got error: %s", err))
+		return ""
+	}
+
+	res := apiRes.ListDtcResponseObject.GetResult()
+	if len(res) == 0 || res[0].Ref == nil {
+		diags.AddError("Client Error", "Unable to read Dtc, no DTC object found")
+		return ""
+	}
+	return *res[0].Ref
+}
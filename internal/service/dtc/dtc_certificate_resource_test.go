@@ -0,0 +1,170 @@
+package dtc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDtcCertificate = "certificate,in_use"
+
+func TestAccDtcCertificateResource_basic(t *testing.T) {
+	var resourceName = "nios_dtc_certificate.test"
+	var v dtc.DtcCertificate
+	certificateFile := filepath.Join(getDtcCertificateTestDataPath(), "client.pem")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcCertificateBasicConfig(certificateFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_file_path", certificateFile),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "in_use", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcCertificateResource_disappears(t *testing.T) {
+	resourceName := "nios_dtc_certificate.test"
+	var v dtc.DtcCertificate
+	certificateFile := filepath.Join(getDtcCertificateTestDataPath(), "client.pem")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDtcCertificateDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcCertificateBasicConfig(certificateFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v),
+					testAccCheckDtcCertificateDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDtcCertificateResource_CertificateFilePath(t *testing.T) {
+	var resourceName = "nios_dtc_certificate.test"
+	var v1, v2 dtc.DtcCertificate
+	certificateFile := filepath.Join(getDtcCertificateTestDataPath(), "client.pem")
+	updatedCertificateFile := filepath.Join(getDtcCertificateTestDataPath(), "client_updated.pem")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcCertificateBasicConfig(certificateFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "certificate_file_path", certificateFile),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDtcCertificateBasicConfig(updatedCertificateFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcCertificateExists(context.Background(), resourceName, &v2),
+					testAccCheckDtcCertificateDestroy(context.Background(), &v1),
+					resource.TestCheckResourceAttr(resourceName, "certificate_file_path", updatedCertificateFile),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckDtcCertificateExists(ctx context.Context, resourceName string, v *dtc.DtcCertificate) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DTCAPI.
+			DtcCertificateAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDtcCertificate).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDtcCertificateResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDtcCertificateResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckDtcCertificateDestroy(ctx context.Context, v *dtc.DtcCertificate) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DTCAPI.
+			DtcCertificateAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForDtcCertificate).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckDtcCertificateDisappears(ctx context.Context, v *dtc.DtcCertificate) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		cfg := acctest.NIOSClient.DTCAPI.Cfg
+		_, err := utils.DeleteWAPIObject(ctx, cfg.HTTPClient, utils.WAPIBaseURL(cfg), *v.Ref)
+		return err
+	}
+}
+
+func testAccDtcCertificateBasicConfig(certificateFilePath string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_certificate" "test" {
+  certificate_file_path = %q
+}
+`, certificateFilePath)
+}
+
+func getDtcCertificateTestDataPath() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "../../testdata/nios_dtc_certificate"
+	}
+	return filepath.Join(wd, "../../testdata/nios_dtc_certificate")
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DtcLbdnResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcLbdnResourceModel describes the resource data model, extending DtcLbdnModel with the operation timeouts.
+type DtcLbdnResourceModel struct {
+	DtcLbdnModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcLbdnResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_lbdn"
 }
@@ -41,6 +49,7 @@ func (r *DtcLbdnResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages DTC Load Balanced Domain Name (LBDN)",
 		Attributes:          DtcLbdnResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *DtcLbdnResource) Configure(ctx context.Context, req resource.ConfigureR
 
 func (r *DtcLbdnResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcLbdnModel
+	var data DtcLbdnResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *DtcLbdnResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var apiRes *dtc.CreateDtcLbdnResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *DtcLbdnResource) Create(ctx context.Context, req resource.CreateRequest
 
 func (r *DtcLbdnResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcLbdnModel
+	var data DtcLbdnResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *DtcLbdnResource) Read(ctx context.Context, req resource.ReadRequest, re
 		apiRes  *dtc.GetDtcLbdnResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcLbdnAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcLbdn).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *DtcLbdnResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcLbdnResource) ReadByExtAttrs(ctx context.Context, data *DtcLbdnModel, resp *resource.ReadResponse) bool {
+func (r *DtcLbdnResource) ReadByExtAttrs(ctx context.Context, data *DtcLbdnResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *DtcLbdnResource) ReadByExtAttrs(ctx context.Context, data *DtcLbdnModel
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcLbdn).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcLbdn by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *DtcLbdnResource) ReadByExtAttrs(ctx context.Context, data *DtcLbdnModel
 
 func (r *DtcLbdnResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcLbdnModel
+	var data DtcLbdnResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -326,7 +335,7 @@ func (r *DtcLbdnResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var apiRes *dtc.UpdateDtcLbdnResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *DtcLbdnResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *DtcLbdnResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcLbdnModel
+	var data DtcLbdnResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *DtcLbdnResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcLbdnAPI.
 			Delete(ctx, resourceRef).
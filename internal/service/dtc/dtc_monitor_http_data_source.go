@@ -139,7 +139,7 @@ func (d *DtcMonitorHttpDataSource) Read(ctx context.Context, req datasource.Read
 				ReturnFieldsPlus(readableAttributesForDtcMonitorHttp).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
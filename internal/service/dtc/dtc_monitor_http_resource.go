@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type DtcMonitorHttpResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcMonitorHttpResourceModel describes the resource data model, extending DtcMonitorHttpModel with the operation timeouts.
+type DtcMonitorHttpResourceModel struct {
+	DtcMonitorHttpModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcMonitorHttpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_monitor_http"
 }
@@ -42,6 +50,7 @@ func (r *DtcMonitorHttpResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC Monitor HTTP",
 		Attributes:          DtcMonitorHttpResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *DtcMonitorHttpResource) Configure(ctx context.Context, req resource.Con
 
 func (r *DtcMonitorHttpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorHttpModel
+	var data DtcMonitorHttpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *DtcMonitorHttpResource) Create(ctx context.Context, req resource.Create
 
 	var apiRes *dtc.CreateDtcMonitorHttpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -136,7 +145,7 @@ func (r *DtcMonitorHttpResource) Create(ctx context.Context, req resource.Create
 
 func (r *DtcMonitorHttpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorHttpModel
+	var data DtcMonitorHttpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -158,14 +167,14 @@ func (r *DtcMonitorHttpResource) Read(ctx context.Context, req resource.ReadRequ
 		apiRes  *dtc.GetDtcMonitorHttpResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcMonitorHttpAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcMonitorHttp).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -220,7 +229,7 @@ func (r *DtcMonitorHttpResource) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcMonitorHttpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorHttpModel, resp *resource.ReadResponse) bool {
+func (r *DtcMonitorHttpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorHttpResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -247,7 +256,7 @@ func (r *DtcMonitorHttpResource) ReadByExtAttrs(ctx context.Context, data *DtcMo
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcMonitorHttp).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcMonitorHttp by extattrs, got error: %s", err))
@@ -278,7 +287,7 @@ func (r *DtcMonitorHttpResource) ReadByExtAttrs(ctx context.Context, data *DtcMo
 
 func (r *DtcMonitorHttpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorHttpModel
+	var data DtcMonitorHttpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -328,7 +337,7 @@ func (r *DtcMonitorHttpResource) Update(ctx context.Context, req resource.Update
 
 	var apiRes *dtc.UpdateDtcMonitorHttpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -370,7 +379,7 @@ func (r *DtcMonitorHttpResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *DtcMonitorHttpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcMonitorHttpModel
+	var data DtcMonitorHttpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -381,7 +390,7 @@ func (r *DtcMonitorHttpResource) Delete(ctx context.Context, req resource.Delete
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcMonitorHttpAPI.
 			Delete(ctx, resourceRef).
@@ -408,7 +417,7 @@ func (r *DtcMonitorHttpResource) ImportState(ctx context.Context, req resource.I
 }
 
 func (r *DtcMonitorHttpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data DtcMonitorHttpModel
+	var data DtcMonitorHttpResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
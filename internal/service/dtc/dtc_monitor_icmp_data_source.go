@@ -139,7 +139,7 @@ func (d *DtcMonitorIcmpDataSource) Read(ctx context.Context, req datasource.Read
 				ReturnFieldsPlus(readableAttributesForDtcMonitorIcmp).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DtcMonitorIcmpResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcMonitorIcmpResourceModel describes the resource data model, extending DtcMonitorIcmpModel with the operation timeouts.
+type DtcMonitorIcmpResourceModel struct {
+	DtcMonitorIcmpModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcMonitorIcmpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_monitor_icmp"
 }
@@ -41,6 +49,7 @@ func (r *DtcMonitorIcmpResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC ICMP Monitor",
 		Attributes:          DtcMonitorIcmpResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *DtcMonitorIcmpResource) Configure(ctx context.Context, req resource.Con
 
 func (r *DtcMonitorIcmpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorIcmpModel
+	var data DtcMonitorIcmpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *DtcMonitorIcmpResource) Create(ctx context.Context, req resource.Create
 
 	var apiRes *dtc.CreateDtcMonitorIcmpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *DtcMonitorIcmpResource) Create(ctx context.Context, req resource.Create
 
 func (r *DtcMonitorIcmpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorIcmpModel
+	var data DtcMonitorIcmpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *DtcMonitorIcmpResource) Read(ctx context.Context, req resource.ReadRequ
 		apiRes  *dtc.GetDtcMonitorIcmpResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcMonitorIcmpAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcMonitorIcmp).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *DtcMonitorIcmpResource) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcMonitorIcmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorIcmpModel, resp *resource.ReadResponse) bool {
+func (r *DtcMonitorIcmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorIcmpResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +258,7 @@ func (r *DtcMonitorIcmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMo
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcMonitorIcmp).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcMonitorIcmp by extattrs, got error: %s", err))
@@ -280,7 +289,7 @@ func (r *DtcMonitorIcmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMo
 
 func (r *DtcMonitorIcmpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorIcmpModel
+	var data DtcMonitorIcmpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -331,7 +340,7 @@ func (r *DtcMonitorIcmpResource) Update(ctx context.Context, req resource.Update
 
 	var apiRes *dtc.UpdateDtcMonitorIcmpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -374,7 +383,7 @@ func (r *DtcMonitorIcmpResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *DtcMonitorIcmpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcMonitorIcmpModel
+	var data DtcMonitorIcmpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -385,7 +394,7 @@ func (r *DtcMonitorIcmpResource) Delete(ctx context.Context, req resource.Delete
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcMonitorIcmpAPI.
 			Delete(ctx, resourceRef).
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DtcMonitorPdpResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcMonitorPdpResourceModel describes the resource data model, extending DtcMonitorPdpModel with the operation timeouts.
+type DtcMonitorPdpResourceModel struct {
+	DtcMonitorPdpModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcMonitorPdpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_monitor_pdp"
 }
@@ -41,6 +49,7 @@ func (r *DtcMonitorPdpResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC PDP Monitor.",
 		Attributes:          DtcMonitorPdpResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *DtcMonitorPdpResource) Configure(ctx context.Context, req resource.Conf
 
 func (r *DtcMonitorPdpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorPdpModel
+	var data DtcMonitorPdpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *DtcMonitorPdpResource) Create(ctx context.Context, req resource.CreateR
 
 	var apiRes *dtc.CreateDtcMonitorPdpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *DtcMonitorPdpResource) Create(ctx context.Context, req resource.CreateR
 
 func (r *DtcMonitorPdpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorPdpModel
+	var data DtcMonitorPdpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *DtcMonitorPdpResource) Read(ctx context.Context, req resource.ReadReque
 		apiRes  *dtc.GetDtcMonitorPdpResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcMonitorPdpAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcMonitorPdp).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *DtcMonitorPdpResource) Read(ctx context.Context, req resource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcMonitorPdpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorPdpModel, resp *resource.ReadResponse) bool {
+func (r *DtcMonitorPdpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorPdpResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *DtcMonitorPdpResource) ReadByExtAttrs(ctx context.Context, data *DtcMon
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcMonitorPdp).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcMonitorPdp by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *DtcMonitorPdpResource) ReadByExtAttrs(ctx context.Context, data *DtcMon
 
 func (r *DtcMonitorPdpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorPdpModel
+	var data DtcMonitorPdpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -327,7 +336,7 @@ func (r *DtcMonitorPdpResource) Update(ctx context.Context, req resource.UpdateR
 
 	var apiRes *dtc.UpdateDtcMonitorPdpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *DtcMonitorPdpResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *DtcMonitorPdpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcMonitorPdpModel
+	var data DtcMonitorPdpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *DtcMonitorPdpResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcMonitorPdpAPI.
 			Delete(ctx, resourceRef).
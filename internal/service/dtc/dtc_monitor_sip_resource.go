@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DtcMonitorSipResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcMonitorSipResourceModel describes the resource data model, extending DtcMonitorSipModel with the operation timeouts.
+type DtcMonitorSipResourceModel struct {
+	DtcMonitorSipModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcMonitorSipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_monitor_sip"
 }
@@ -41,6 +49,7 @@ func (r *DtcMonitorSipResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC SIP Monitor",
 		Attributes:          DtcMonitorSipResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *DtcMonitorSipResource) Configure(ctx context.Context, req resource.Conf
 
 func (r *DtcMonitorSipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorSipModel
+	var data DtcMonitorSipResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *DtcMonitorSipResource) Create(ctx context.Context, req resource.CreateR
 
 	var apiRes *dtc.CreateDtcMonitorSipResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *DtcMonitorSipResource) Create(ctx context.Context, req resource.CreateR
 
 func (r *DtcMonitorSipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorSipModel
+	var data DtcMonitorSipResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *DtcMonitorSipResource) Read(ctx context.Context, req resource.ReadReque
 		apiRes  *dtc.GetDtcMonitorSipResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcMonitorSipAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcMonitorSip).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *DtcMonitorSipResource) Read(ctx context.Context, req resource.ReadReque
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcMonitorSipResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorSipModel, resp *resource.ReadResponse) bool {
+func (r *DtcMonitorSipResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorSipResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *DtcMonitorSipResource) ReadByExtAttrs(ctx context.Context, data *DtcMon
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcMonitorSip).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcMonitorSip by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *DtcMonitorSipResource) ReadByExtAttrs(ctx context.Context, data *DtcMon
 
 func (r *DtcMonitorSipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorSipModel
+	var data DtcMonitorSipResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -327,7 +336,7 @@ func (r *DtcMonitorSipResource) Update(ctx context.Context, req resource.UpdateR
 
 	var apiRes *dtc.UpdateDtcMonitorSipResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *DtcMonitorSipResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *DtcMonitorSipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcMonitorSipModel
+	var data DtcMonitorSipResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *DtcMonitorSipResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcMonitorSipAPI.
 			Delete(ctx, resourceRef).
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DtcMonitorSnmpResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcMonitorSnmpResourceModel describes the resource data model, extending DtcMonitorSnmpModel with the operation timeouts.
+type DtcMonitorSnmpResourceModel struct {
+	DtcMonitorSnmpModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcMonitorSnmpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_monitor_snmp"
 }
@@ -41,6 +49,7 @@ func (r *DtcMonitorSnmpResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC SNMP Monitor",
 		Attributes:          DtcMonitorSnmpResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *DtcMonitorSnmpResource) Configure(ctx context.Context, req resource.Con
 
 func (r *DtcMonitorSnmpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorSnmpModel
+	var data DtcMonitorSnmpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (r *DtcMonitorSnmpResource) Create(ctx context.Context, req resource.Create
 
 	var apiRes *dtc.CreateDtcMonitorSnmpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -135,7 +144,7 @@ func (r *DtcMonitorSnmpResource) Create(ctx context.Context, req resource.Create
 
 func (r *DtcMonitorSnmpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorSnmpModel
+	var data DtcMonitorSnmpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,14 +166,14 @@ func (r *DtcMonitorSnmpResource) Read(ctx context.Context, req resource.ReadRequ
 		apiRes  *dtc.GetDtcMonitorSnmpResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcMonitorSnmpAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcMonitorSnmp).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -219,7 +228,7 @@ func (r *DtcMonitorSnmpResource) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcMonitorSnmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorSnmpModel, resp *resource.ReadResponse) bool {
+func (r *DtcMonitorSnmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMonitorSnmpResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -246,7 +255,7 @@ func (r *DtcMonitorSnmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMo
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcMonitorSnmp).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcMonitorSnmp by extattrs, got error: %s", err))
@@ -277,7 +286,7 @@ func (r *DtcMonitorSnmpResource) ReadByExtAttrs(ctx context.Context, data *DtcMo
 
 func (r *DtcMonitorSnmpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcMonitorSnmpModel
+	var data DtcMonitorSnmpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -327,7 +336,7 @@ func (r *DtcMonitorSnmpResource) Update(ctx context.Context, req resource.Update
 
 	var apiRes *dtc.UpdateDtcMonitorSnmpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -369,7 +378,7 @@ func (r *DtcMonitorSnmpResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *DtcMonitorSnmpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcMonitorSnmpModel
+	var data DtcMonitorSnmpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -380,7 +389,7 @@ func (r *DtcMonitorSnmpResource) Delete(ctx context.Context, req resource.Delete
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcMonitorSnmpAPI.
 			Delete(ctx, resourceRef).
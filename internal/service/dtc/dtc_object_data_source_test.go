@@ -0,0 +1,92 @@
+package dtc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccDtcObjectDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dtc_object.test"
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+	serverIP := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcObjectDataSourceConfigFilters(serverName, serverIP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", serverName),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.abstract_type", "SERVER"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.ipv4_address_list.0", serverIP),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.object", "nios_dtc_server.test", "ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDtcObjectDataSource_ExtAttrFilters(t *testing.T) {
+	dataSourceName := "data.nios_dtc_object.test"
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+	serverIP := acctest.RandomIP()
+	extAttrValue := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcObjectDataSourceConfigExtAttrFilters(serverName, serverIP, extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.name", serverName),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.extattrs.Site", extAttrValue),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccDtcObjectDataSourceConfigFilters(serverName, serverIP string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_server" "test" {
+  name = %q
+  host = %q
+}
+
+data "nios_dtc_object" "test" {
+  filters = {
+    name          = nios_dtc_server.test.name
+    abstract_type = "SERVER"
+  }
+}
+`, serverName, serverIP)
+}
+
+func testAccDtcObjectDataSourceConfigExtAttrFilters(serverName, serverIP, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_server" "test" {
+  name = %q
+  host = %q
+  extattrs = {
+    Site = %q
+  }
+}
+
+data "nios_dtc_object" "test" {
+  extattrfilters = {
+    Site = nios_dtc_server.test.extattrs.Site
+  }
+}
+`, serverName, serverIP, extAttrsValue)
+}
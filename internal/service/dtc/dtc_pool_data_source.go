@@ -139,7 +139,7 @@ func (d *DtcPoolDataSource) Read(ctx context.Context, req datasource.ReadRequest
 				ReturnFieldsPlus(readableAttributesForDtcPool).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type DtcPoolResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcPoolResourceModel describes the resource data model, extending DtcPoolModel with the operation timeouts.
+type DtcPoolResourceModel struct {
+	DtcPoolModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_pool"
 }
@@ -43,6 +51,7 @@ func (r *DtcPoolResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC Pool",
 		Attributes:          DtcPoolResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *DtcPoolResource) Configure(ctx context.Context, req resource.ConfigureR
 
 func (r *DtcPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcPoolModel
+	var data DtcPoolResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -90,7 +99,7 @@ func (r *DtcPoolResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var apiRes *dtc.CreateDtcPoolResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *DtcPoolResource) Create(ctx context.Context, req resource.CreateRequest
 
 func (r *DtcPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcPoolModel
+	var data DtcPoolResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *DtcPoolResource) Read(ctx context.Context, req resource.ReadRequest, re
 		apiRes  *dtc.GetDtcPoolResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcPoolAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcPool).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -221,7 +230,7 @@ func (r *DtcPoolResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcPoolResource) ReadByExtAttrs(ctx context.Context, data *DtcPoolModel, resp *resource.ReadResponse) bool {
+func (r *DtcPoolResource) ReadByExtAttrs(ctx context.Context, data *DtcPoolResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -248,7 +257,7 @@ func (r *DtcPoolResource) ReadByExtAttrs(ctx context.Context, data *DtcPoolModel
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcPool).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcPool by extattrs, got error: %s", err))
@@ -279,7 +288,7 @@ func (r *DtcPoolResource) ReadByExtAttrs(ctx context.Context, data *DtcPoolModel
 
 func (r *DtcPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcPoolModel
+	var data DtcPoolResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -328,7 +337,7 @@ func (r *DtcPoolResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var apiRes *dtc.UpdateDtcPoolResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -371,7 +380,7 @@ func (r *DtcPoolResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *DtcPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcPoolModel
+	var data DtcPoolResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -382,7 +391,7 @@ func (r *DtcPoolResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcPoolAPI.
 			Delete(ctx, resourceRef).
@@ -410,7 +419,7 @@ func (r *DtcPoolResource) ImportState(ctx context.Context, req resource.ImportSt
 
 // ValidateConfig validates the configuration of the DTC Pool resource.
 func (r *DtcPoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data DtcPoolModel
+	var data DtcPoolResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
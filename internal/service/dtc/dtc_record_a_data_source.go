@@ -136,7 +136,7 @@ func (d *DtcRecordADataSource) Read(ctx context.Context, req datasource.ReadRequ
 				ReturnFieldsPlus(readableAttributesForDtcRecordA).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
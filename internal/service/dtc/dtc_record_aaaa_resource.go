@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DtcRecordAaaaResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcRecordAaaaResourceModel describes the resource data model, extending DtcRecordAaaaModel with the operation timeouts.
+type DtcRecordAaaaResourceModel struct {
+	DtcRecordAaaaModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcRecordAaaaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_record_aaaa"
 }
@@ -41,6 +49,7 @@ func (r *DtcRecordAaaaResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC AAAA Record.",
 		Attributes:          DtcRecordAaaaResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -65,7 +74,7 @@ func (r *DtcRecordAaaaResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *DtcRecordAaaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data DtcRecordAaaaModel
+	var data DtcRecordAaaaResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -80,7 +89,7 @@ func (r *DtcRecordAaaaResource) Create(ctx context.Context, req resource.CreateR
 
 	var apiRes *dtc.CreateDtcRecordAaaaResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -121,7 +130,7 @@ func (r *DtcRecordAaaaResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *DtcRecordAaaaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data DtcRecordAaaaModel
+	var data DtcRecordAaaaResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -137,14 +146,14 @@ func (r *DtcRecordAaaaResource) Read(ctx context.Context, req resource.ReadReque
 		apiRes  *dtc.GetDtcRecordAaaaResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcRecordAaaaAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcRecordAaaa).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -174,7 +183,7 @@ func (r *DtcRecordAaaaResource) Read(ctx context.Context, req resource.ReadReque
 
 func (r *DtcRecordAaaaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcRecordAaaaModel
+	var data DtcRecordAaaaResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -198,7 +207,7 @@ func (r *DtcRecordAaaaResource) Update(ctx context.Context, req resource.UpdateR
 
 	var apiRes *dtc.UpdateDtcRecordAaaaResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -231,7 +240,7 @@ func (r *DtcRecordAaaaResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *DtcRecordAaaaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcRecordAaaaModel
+	var data DtcRecordAaaaResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -242,7 +251,7 @@ func (r *DtcRecordAaaaResource) Delete(ctx context.Context, req resource.DeleteR
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcRecordAaaaAPI.
 			Delete(ctx, resourceRef).
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type DtcRecordNaptrResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcRecordNaptrResourceModel describes the resource data model, extending DtcRecordNaptrModel with the operation timeouts.
+type DtcRecordNaptrResourceModel struct {
+	DtcRecordNaptrModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcRecordNaptrResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_record_naptr"
 }
@@ -41,6 +49,7 @@ func (r *DtcRecordNaptrResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC NAPTR Record.",
 		Attributes:          DtcRecordNaptrResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -65,7 +74,7 @@ func (r *DtcRecordNaptrResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *DtcRecordNaptrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data DtcRecordNaptrModel
+	var data DtcRecordNaptrResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -81,7 +90,7 @@ func (r *DtcRecordNaptrResource) Create(ctx context.Context, req resource.Create
 
 	var apiRes *dtc.CreateDtcRecordNaptrResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -122,7 +131,7 @@ func (r *DtcRecordNaptrResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *DtcRecordNaptrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data DtcRecordNaptrModel
+	var data DtcRecordNaptrResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -138,14 +147,14 @@ func (r *DtcRecordNaptrResource) Read(ctx context.Context, req resource.ReadRequ
 		apiRes  *dtc.GetDtcRecordNaptrResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcRecordNaptrAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcRecordNaptr).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -175,7 +184,7 @@ func (r *DtcRecordNaptrResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (r *DtcRecordNaptrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcRecordNaptrModel
+	var data DtcRecordNaptrResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -199,7 +208,7 @@ func (r *DtcRecordNaptrResource) Update(ctx context.Context, req resource.Update
 
 	var apiRes *dtc.UpdateDtcRecordNaptrResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -232,7 +241,7 @@ func (r *DtcRecordNaptrResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *DtcRecordNaptrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcRecordNaptrModel
+	var data DtcRecordNaptrResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -243,7 +252,7 @@ func (r *DtcRecordNaptrResource) Delete(ctx context.Context, req resource.Delete
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcRecordNaptrAPI.
 			Delete(ctx, resourceRef).
@@ -136,7 +136,7 @@ func (d *DtcRecordSrvDataSource) Read(ctx context.Context, req datasource.ReadRe
 				ReturnFieldsPlus(readableAttributesForDtcRecordSrv).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
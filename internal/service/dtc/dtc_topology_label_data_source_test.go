@@ -0,0 +1,45 @@
+package dtc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// DTC Topology Label: the "Africa" continent label from the Topology database
+
+func TestAccDtcTopologyLabelDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dtc_topology_label.test"
+	label := "Africa"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDtcTopologyLabelDataSourceConfigFilters(label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.field"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.label", label),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccDtcTopologyLabelDataSourceConfigFilters(label string) string {
+	return fmt.Sprintf(`
+data "nios_dtc_topology_label" "test" {
+  filters = {
+    label = %q
+  }
+}
+`, label)
+}
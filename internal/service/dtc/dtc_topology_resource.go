@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type DtcTopologyResource struct {
 	client *niosclient.APIClient
 }
 
+// DtcTopologyResourceModel describes the resource data model, extending DtcTopologyModel with the operation timeouts.
+type DtcTopologyResourceModel struct {
+	DtcTopologyModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DtcTopologyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "dtc_topology"
 }
@@ -42,6 +49,7 @@ func (r *DtcTopologyResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DTC Topology",
 		Attributes:          DtcTopologyResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +75,7 @@ func (r *DtcTopologyResource) Configure(ctx context.Context, req resource.Config
 
 func (r *DtcTopologyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DtcTopologyModel
+	var data DtcTopologyResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +97,7 @@ func (r *DtcTopologyResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *dtc.CreateDtcTopologyResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -141,7 +149,7 @@ func (r *DtcTopologyResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *DtcTopologyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DtcTopologyModel
+	var data DtcTopologyResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -163,14 +171,14 @@ func (r *DtcTopologyResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *dtc.GetDtcTopologyResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.DTCAPI.
 			DtcTopologyAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDtcTopology).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -231,7 +239,7 @@ func (r *DtcTopologyResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DtcTopologyResource) ReadByExtAttrs(ctx context.Context, data *DtcTopologyModel, resp *resource.ReadResponse) bool {
+func (r *DtcTopologyResource) ReadByExtAttrs(ctx context.Context, data *DtcTopologyResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -258,7 +266,7 @@ func (r *DtcTopologyResource) ReadByExtAttrs(ctx context.Context, data *DtcTopol
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForDtcTopology).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DtcTopology by extattrs, got error: %s", err))
@@ -289,7 +297,7 @@ func (r *DtcTopologyResource) ReadByExtAttrs(ctx context.Context, data *DtcTopol
 
 func (r *DtcTopologyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DtcTopologyModel
+	var data DtcTopologyResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -339,7 +347,7 @@ func (r *DtcTopologyResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *dtc.UpdateDtcTopologyResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -387,7 +395,7 @@ func (r *DtcTopologyResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *DtcTopologyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DtcTopologyModel
+	var data DtcTopologyResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -398,7 +406,7 @@ func (r *DtcTopologyResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.DTCAPI.
 			DtcTopologyAPI.
 			Delete(ctx, resourceRef).
@@ -425,7 +433,7 @@ func (r *DtcTopologyResource) ImportState(ctx context.Context, req resource.Impo
 }
 
 func (r *DtcTopologyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data DtcTopologyModel
+	var data DtcTopologyResourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -134,7 +134,7 @@ func (d *DtcTopologyRuleDataSource) Read(ctx context.Context, req datasource.Rea
 				ReturnFieldsPlus(readableAttributesForDtcTopologyRule).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -0,0 +1,216 @@
+package dtc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForDtcTopologyRule = "dest_type,destination_link,return_type,sources,topology,valid"
+
+func TestAccDtcTopologyRuleResource_basic(t *testing.T) {
+	var resourceName = "nios_dtc_topology_rule.test"
+	var v dtc.DtcTopologyRule
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+	serverIP := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcTopologyRuleBasicConfig(serverName, serverIP, "Africa"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcTopologyRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dest_type", "SERVER"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_link", "nios_dtc_server.test", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.source_op", "IS"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.source_type", "CONTINENT"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.source_value", "Africa"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "return_type", "REGULAR"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcTopologyRuleResource_Import(t *testing.T) {
+	var resourceName = "nios_dtc_topology_rule.test"
+	var v dtc.DtcTopologyRule
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+	serverIP := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcTopologyRuleBasicConfig(serverName, serverIP, "Africa"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcTopologyRuleExists(context.Background(), resourceName, &v),
+				),
+			},
+			// Import and Verify
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDtcTopologyRuleImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "ref",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func TestAccDtcTopologyRuleResource_ReturnType(t *testing.T) {
+	var resourceName = "nios_dtc_topology_rule.test_return_type"
+	var v dtc.DtcTopologyRule
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+	serverIP := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcTopologyRuleReturnType(serverName, serverIP, "NOERR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcTopologyRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "return_type", "NOERR"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDtcTopologyRuleReturnType(serverName, serverIP, "NXDOMAIN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcTopologyRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "return_type", "NXDOMAIN"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDtcTopologyRuleResource_Sources(t *testing.T) {
+	var resourceName = "nios_dtc_topology_rule.test"
+	var v dtc.DtcTopologyRule
+	serverName := acctest.RandomNameWithPrefix("dtc-server")
+	serverIP := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccDtcTopologyRuleBasicConfig(serverName, serverIP, "Africa"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcTopologyRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.source_value", "Africa"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccDtcTopologyRuleBasicConfig(serverName, serverIP, "Europe"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDtcTopologyRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.source_value", "Europe"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckDtcTopologyRuleExists(ctx context.Context, resourceName string, v *dtc.DtcTopologyRule) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DTCAPI.
+			DtcTopologyRuleAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForDtcTopologyRule).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetDtcTopologyRuleResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetDtcTopologyRuleResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccDtcTopologyRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccDtcTopologyRuleBasicConfig(serverName, serverIP, sourceValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_server" "test" {
+  name = %q
+  host = %q
+}
+
+resource "nios_dtc_topology_rule" "test" {
+  dest_type        = "SERVER"
+  destination_link = nios_dtc_server.test.ref
+  sources = [
+    {
+      source_op    = "IS"
+      source_type  = "CONTINENT"
+      source_value = %q
+    }
+  ]
+}
+`, serverName, serverIP, sourceValue)
+}
+
+func testAccDtcTopologyRuleReturnType(serverName, serverIP, returnType string) string {
+	return fmt.Sprintf(`
+resource "nios_dtc_server" "test" {
+  name = %q
+  host = %q
+}
+
+resource "nios_dtc_topology_rule" "test_return_type" {
+  dest_type        = "SERVER"
+  destination_link = nios_dtc_server.test.ref
+  return_type      = %q
+  sources = [
+    {
+      source_op    = "IS"
+      source_type  = "CONTINENT"
+      source_value = "Africa"
+    }
+  ]
+}
+`, serverName, serverIP, returnType)
+}
@@ -0,0 +1,92 @@
+package dtc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type DtcAllrecordsModel struct {
+	Ref       types.String `tfsdk:"ref"`
+	Comment   types.String `tfsdk:"comment"`
+	Disable   types.Bool   `tfsdk:"disable"`
+	DtcServer types.String `tfsdk:"dtc_server"`
+	Record    types.String `tfsdk:"record"`
+	Ttl       types.Int64  `tfsdk:"ttl"`
+	Type      types.String `tfsdk:"type"`
+}
+
+var DtcAllrecordsAttrTypes = map[string]attr.Type{
+	"ref":        types.StringType,
+	"comment":    types.StringType,
+	"disable":    types.BoolType,
+	"dtc_server": types.StringType,
+	"record":     types.StringType,
+	"ttl":        types.Int64Type,
+	"type":       types.StringType,
+}
+
+var DtcAllrecordsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record comment.",
+	},
+	"disable": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "The disable value determines if the record is disabled or not. \"False\" means the record is enabled.",
+	},
+	"dtc_server": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the DTC Server object with which the record is associated.",
+	},
+	"record": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record object, if supported by the WAPI. Otherwise, the value is \"None\".",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The TTL value of the record associated with the DTC AllRecords object.",
+	},
+	"type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record type. When searching for an unspecified record type, the search is performed for all records. On retrieval, the appliance returns \"UNSUPPORTED\" for unsupported records.",
+	},
+}
+
+func FlattenDtcAllrecords(ctx context.Context, from *dtc.DtcAllrecords, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DtcAllrecordsAttrTypes)
+	}
+	m := DtcAllrecordsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DtcAllrecordsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DtcAllrecordsModel) Flatten(ctx context.Context, from *dtc.DtcAllrecords, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DtcAllrecordsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DtcServer = flex.FlattenStringPointer(from.DtcServer)
+	m.Record = flex.FlattenStringPointer(from.Record)
+	m.Ttl = flex.FlattenInt64Pointer(from.Ttl)
+	m.Type = flex.FlattenStringPointer(from.Type)
+}
@@ -0,0 +1,75 @@
+package dtc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type DtcCertificateModel struct {
+	Ref                 types.String `tfsdk:"ref"`
+	Certificate         types.String `tfsdk:"certificate"`
+	InUse               types.Bool   `tfsdk:"in_use"`
+	CertificateFilePath types.String `tfsdk:"certificate_file_path"`
+}
+
+var DtcCertificateAttrTypes = map[string]attr.Type{
+	"ref":                   types.StringType,
+	"certificate":           types.StringType,
+	"in_use":                types.BoolType,
+	"certificate_file_path": types.StringType,
+}
+
+var DtcCertificateResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"certificate": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Reference to underlying X509Certificate.",
+	},
+	"in_use": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines whether the certificate is in use or not.",
+	},
+	"certificate_file_path": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The file path to the certificate to upload. Changing the file path uploads the new certificate and replaces the existing one.",
+	},
+}
+
+func FlattenDtcCertificate(ctx context.Context, from *dtc.DtcCertificate, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DtcCertificateAttrTypes)
+	}
+	m := DtcCertificateModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DtcCertificateAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DtcCertificateModel) Flatten(ctx context.Context, from *dtc.DtcCertificate, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DtcCertificateModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Certificate = flex.FlattenStringPointer(from.Certificate)
+	m.InUse = types.BoolPointerValue(from.InUse)
+}
@@ -0,0 +1,123 @@
+package dtc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type DtcObjectModel struct {
+	Ref             types.String `tfsdk:"ref"`
+	AbstractType    types.String `tfsdk:"abstract_type"`
+	Comment         types.String `tfsdk:"comment"`
+	DisplayType     types.String `tfsdk:"display_type"`
+	ExtAttrs        types.Map    `tfsdk:"extattrs"`
+	Ipv4AddressList types.List   `tfsdk:"ipv4_address_list"`
+	Ipv6AddressList types.List   `tfsdk:"ipv6_address_list"`
+	Name            types.String `tfsdk:"name"`
+	Object          types.String `tfsdk:"object"`
+	Status          types.String `tfsdk:"status"`
+	StatusTime      types.Int64  `tfsdk:"status_time"`
+}
+
+var DtcObjectAttrTypes = map[string]attr.Type{
+	"ref":               types.StringType,
+	"abstract_type":     types.StringType,
+	"comment":           types.StringType,
+	"display_type":      types.StringType,
+	"extattrs":          types.MapType{ElemType: types.StringType},
+	"ipv4_address_list": types.ListType{ElemType: types.StringType},
+	"ipv6_address_list": types.ListType{ElemType: types.StringType},
+	"name":              types.StringType,
+	"object":            types.StringType,
+	"status":            types.StringType,
+	"status_time":       types.Int64Type,
+}
+
+var DtcObjectResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"abstract_type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The abstract object type.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The comment for the DTC object; maximum 256 characters.",
+	},
+	"display_type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The display object type.",
+	},
+	"extattrs": schema.MapAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"ipv4_address_list": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The list of IPv4 addresses.",
+	},
+	"ipv6_address_list": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The list of IPv6 addresses.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The display name of the DTC object.",
+	},
+	"object": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The specific DTC object.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The availability color status.",
+	},
+	"status_time": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The timestamp when status or health was last determined.",
+	},
+}
+
+func FlattenDtcObject(ctx context.Context, from *dtc.DtcObject, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DtcObjectAttrTypes)
+	}
+	m := DtcObjectModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DtcObjectAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DtcObjectModel) Flatten(ctx context.Context, from *dtc.DtcObject, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DtcObjectModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AbstractType = flex.FlattenStringPointer(from.AbstractType)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.DisplayType = flex.FlattenStringPointer(from.DisplayType)
+	m.ExtAttrs = FlattenExtAttrs(ctx, m.ExtAttrs, from.ExtAttrs, diags)
+	m.Ipv4AddressList = flex.FlattenFrameworkListString(ctx, from.Ipv4AddressList, diags)
+	m.Ipv6AddressList = flex.FlattenFrameworkListString(ctx, from.Ipv6AddressList, diags)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Object = flex.FlattenStringPointer(from.Object)
+	m.Status = flex.FlattenStringPointer(from.Status)
+	m.StatusTime = flex.FlattenInt64Pointer(from.StatusTime)
+}
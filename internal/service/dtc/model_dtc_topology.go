@@ -84,7 +84,7 @@ var DtcTopologyResourceSchemaAttributes = map[string]schema.Attribute{
 		},
 		Optional:            true,
 		Computed:            true,
-		MarkdownDescription: "Topology rules.",
+		MarkdownDescription: "Topology rules. Do not combine with `nios_dtc_topology_rule` resources for the same DTC Topology, the two would report each other's rules as drift.",
 	},
 }
 
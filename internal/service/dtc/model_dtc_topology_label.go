@@ -0,0 +1,64 @@
+package dtc
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dtc"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type DtcTopologyLabelModel struct {
+	Ref   types.String `tfsdk:"ref"`
+	Field types.String `tfsdk:"field"`
+	Label types.String `tfsdk:"label"`
+}
+
+var DtcTopologyLabelAttrTypes = map[string]attr.Type{
+	"ref":   types.StringType,
+	"field": types.StringType,
+	"label": types.StringType,
+}
+
+var DtcTopologyLabelResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"field": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the field in the Topology database the label was obtained from.",
+	},
+	"label": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The DTC Topology label name.",
+	},
+}
+
+func FlattenDtcTopologyLabel(ctx context.Context, from *dtc.DtcTopologyLabel, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DtcTopologyLabelAttrTypes)
+	}
+	m := DtcTopologyLabelModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DtcTopologyLabelAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *DtcTopologyLabelModel) Flatten(ctx context.Context, from *dtc.DtcTopologyLabel, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DtcTopologyLabelModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Field = flex.FlattenStringPointer(from.Field)
+	m.Label = flex.FlattenStringPointer(from.Label)
+}
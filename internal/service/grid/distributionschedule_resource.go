@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/grid"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type DistributionscheduleResource struct {
 	client *niosclient.APIClient
 }
 
+// DistributionscheduleResourceModel describes the resource data model, extending DistributionscheduleModel with the operation timeouts.
+type DistributionscheduleResourceModel struct {
+	DistributionscheduleModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DistributionscheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "grid_distributionschedule"
 }
@@ -42,6 +50,7 @@ func (r *DistributionscheduleResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Distribution Schedule",
 		Attributes:          DistributionscheduleResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *DistributionscheduleResource) Configure(ctx context.Context, req resour
 }
 
 func (r *DistributionscheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data DistributionscheduleModel
+	var data DistributionscheduleResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -102,7 +111,7 @@ func (r *DistributionscheduleResource) ValidateConfig(ctx context.Context, req r
 
 func (r *DistributionscheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var (
-		data DistributionscheduleModel
+		data DistributionscheduleResourceModel
 	)
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -139,7 +148,7 @@ func (r *DistributionscheduleResource) Create(ctx context.Context, req resource.
 
 	var apiRes *grid.UpdateDistributionscheduleResponse
 
-	err = retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err = retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -178,7 +187,7 @@ func (r *DistributionscheduleResource) Create(ctx context.Context, req resource.
 }
 
 func (r *DistributionscheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data DistributionscheduleModel
+	var data DistributionscheduleResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -194,14 +203,14 @@ func (r *DistributionscheduleResource) Read(ctx context.Context, req resource.Re
 		apiRes  *grid.GetDistributionscheduleResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.GridAPI.
 			DistributionscheduleAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForDistributionschedule).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -232,7 +241,7 @@ func (r *DistributionscheduleResource) Read(ctx context.Context, req resource.Re
 func (r *DistributionscheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var (
 		diags diag.Diagnostics
-		data  DistributionscheduleModel
+		data  DistributionscheduleResourceModel
 	)
 
 	// Read Terraform plan data into the model
@@ -257,7 +266,7 @@ func (r *DistributionscheduleResource) Update(ctx context.Context, req resource.
 
 	var apiRes *grid.UpdateDistributionscheduleResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
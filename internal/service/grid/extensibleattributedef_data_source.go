@@ -132,7 +132,7 @@ func (d *ExtensibleattributedefDataSource) Read(ctx context.Context, req datasou
 				ReturnFieldsPlus(readableAttributesForExtensibleattributedef).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
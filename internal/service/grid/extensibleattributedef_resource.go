@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/grid"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type ExtensibleattributedefResource struct {
 	client *niosclient.APIClient
 }
 
+// ExtensibleattributedefResourceModel describes the resource data model, extending ExtensibleattributedefModel with the operation timeouts.
+type ExtensibleattributedefResourceModel struct {
+	ExtensibleattributedefModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *ExtensibleattributedefResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "grid_extensibleattributedef"
 }
@@ -42,6 +50,7 @@ func (r *ExtensibleattributedefResource) Schema(ctx context.Context, req resourc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an Extensible Attribute definition.",
 		Attributes:          ExtensibleattributedefResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *ExtensibleattributedefResource) Configure(ctx context.Context, req reso
 }
 
 func (r *ExtensibleattributedefResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ExtensibleattributedefModel
+	var data ExtensibleattributedefResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,7 +91,7 @@ func (r *ExtensibleattributedefResource) Create(ctx context.Context, req resourc
 
 	var apiRes *grid.CreateExtensibleattributedefResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -123,7 +132,7 @@ func (r *ExtensibleattributedefResource) Create(ctx context.Context, req resourc
 }
 
 func (r *ExtensibleattributedefResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ExtensibleattributedefModel
+	var data ExtensibleattributedefResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -139,14 +148,14 @@ func (r *ExtensibleattributedefResource) Read(ctx context.Context, req resource.
 		apiRes  *grid.GetExtensibleattributedefResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.GridAPI.
 			ExtensibleattributedefAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForExtensibleattributedef).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -176,7 +185,7 @@ func (r *ExtensibleattributedefResource) Read(ctx context.Context, req resource.
 
 func (r *ExtensibleattributedefResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data ExtensibleattributedefModel
+	var data ExtensibleattributedefResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -200,7 +209,7 @@ func (r *ExtensibleattributedefResource) Update(ctx context.Context, req resourc
 
 	var apiRes *grid.UpdateExtensibleattributedefResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -233,7 +242,7 @@ func (r *ExtensibleattributedefResource) Update(ctx context.Context, req resourc
 }
 
 func (r *ExtensibleattributedefResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ExtensibleattributedefModel
+	var data ExtensibleattributedefResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -244,7 +253,7 @@ func (r *ExtensibleattributedefResource) Delete(ctx context.Context, req resourc
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.GridAPI.
 			ExtensibleattributedefAPI.
 			Delete(ctx, resourceRef).
@@ -266,7 +275,7 @@ func (r *ExtensibleattributedefResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *ExtensibleattributedefResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data ExtensibleattributedefModel
+	var data ExtensibleattributedefResourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
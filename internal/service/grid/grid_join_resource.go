@@ -5,15 +5,20 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	gridclient "github.com/infobloxopen/infoblox-nios-go-client/grid"
 	"github.com/infobloxopen/infoblox-nios-go-client/option"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -28,6 +33,12 @@ type GridJoinResource struct {
 	client *niosclient.APIClient
 }
 
+// GridJoinResourceModel describes the resource data model, extending GridJoinModel with the operation timeouts.
+type GridJoinResourceModel struct {
+	GridJoinModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *GridJoinResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "grid_join"
 }
@@ -36,6 +47,7 @@ func (r *GridJoinResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages joining a member to an Infoblox Grid.",
 		Attributes:          GridJoinResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -60,7 +72,7 @@ func (r *GridJoinResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *GridJoinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data GridJoinModel
+	var data GridJoinResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,13 +94,29 @@ func (r *GridJoinResource) Create(ctx context.Context, req resource.CreateReques
 		SharedSecret: data.SharedSecret.ValueStringPointer(),
 	}
 
-	_, httpResp, err := memberClient.GridAPI.
-		GridAPI.
-		Create(ctx).
-		GridJoin(joinReq).
-		ReturnAsObject(1).
-		Function("join").
-		Execute()
+	var httpResp *http.Response
+
+	// The member may still be booting or restarting its services, so the join is retried
+	// on transient errors until the create timeout expires
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		if httpResp != nil && httpResp.Body != nil {
+			_ = httpResp.Body.Close()
+		}
+
+		var callErr error
+		_, httpResp, callErr = memberClient.GridAPI.
+			GridAPI.
+			Create(ctx).
+			GridJoin(joinReq).
+			ReturnAsObject(1).
+			Function("join").
+			Execute()
+
+		if httpResp != nil {
+			return httpResp.StatusCode, callErr
+		}
+		return 0, callErr
+	})
 
 	if httpResp != nil && httpResp.Body != nil {
 		defer func() { _ = httpResp.Body.Close() }()
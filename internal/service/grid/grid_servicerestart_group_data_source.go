@@ -140,7 +140,7 @@ func (d *GridServicerestartGroupDataSource) Read(ctx context.Context, req dataso
 				ReturnFieldsPlus(readableAttributesForGridServicerestartGroup).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	internaltypes "github.com/infobloxopen/terraform-provider-nios/internal/types"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
@@ -36,6 +37,12 @@ type GridServicerestartGroupResource struct {
 	client *niosclient.APIClient
 }
 
+// GridServicerestartGroupResourceModel describes the resource data model, extending GridServicerestartGroupModel with the operation timeouts.
+type GridServicerestartGroupResourceModel struct {
+	GridServicerestartGroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *GridServicerestartGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "grid_servicerestart_group"
 }
@@ -44,6 +51,7 @@ func (r *GridServicerestartGroupResource) Schema(ctx context.Context, req resour
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a GRID Service Restart Group.",
 		Attributes:          GridServicerestartGroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -69,7 +77,7 @@ func (r *GridServicerestartGroupResource) Configure(ctx context.Context, req res
 
 func (r *GridServicerestartGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data GridServicerestartGroupModel
+	var data GridServicerestartGroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -91,7 +99,7 @@ func (r *GridServicerestartGroupResource) Create(ctx context.Context, req resour
 
 	var apiRes *grid.CreateGridServicerestartGroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -138,7 +146,7 @@ func (r *GridServicerestartGroupResource) Create(ctx context.Context, req resour
 
 func (r *GridServicerestartGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data GridServicerestartGroupModel
+	var data GridServicerestartGroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -160,14 +168,14 @@ func (r *GridServicerestartGroupResource) Read(ctx context.Context, req resource
 		apiRes  *grid.GetGridServicerestartGroupResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.GridAPI.
 			GridServicerestartGroupAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForGridServicerestartGroup).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +230,7 @@ func (r *GridServicerestartGroupResource) Read(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *GridServicerestartGroupResource) ReadByExtAttrs(ctx context.Context, data *GridServicerestartGroupModel, resp *resource.ReadResponse) bool {
+func (r *GridServicerestartGroupResource) ReadByExtAttrs(ctx context.Context, data *GridServicerestartGroupResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +257,7 @@ func (r *GridServicerestartGroupResource) ReadByExtAttrs(ctx context.Context, da
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForGridServicerestartGroup).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GridServicerestartGroup by extattrs, got error: %s", err))
@@ -280,7 +288,7 @@ func (r *GridServicerestartGroupResource) ReadByExtAttrs(ctx context.Context, da
 
 func (r *GridServicerestartGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data GridServicerestartGroupModel
+	var data GridServicerestartGroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -329,7 +337,7 @@ func (r *GridServicerestartGroupResource) Update(ctx context.Context, req resour
 
 	var apiRes *grid.UpdateGridServicerestartGroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -372,7 +380,7 @@ func (r *GridServicerestartGroupResource) Update(ctx context.Context, req resour
 }
 
 func (r *GridServicerestartGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data GridServicerestartGroupModel
+	var data GridServicerestartGroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -383,7 +391,7 @@ func (r *GridServicerestartGroupResource) Delete(ctx context.Context, req resour
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.GridAPI.
 			GridServicerestartGroupAPI.
 			Delete(ctx, resourceRef).
@@ -405,7 +413,7 @@ func (r *GridServicerestartGroupResource) Delete(ctx context.Context, req resour
 }
 
 func (r *GridServicerestartGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data GridServicerestartGroupModel
+	var data GridServicerestartGroupResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
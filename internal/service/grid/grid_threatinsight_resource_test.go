@@ -0,0 +1,190 @@
+package grid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForGridThreatinsight = "allowlist_update_policy,configure_domain_collapsing,current_allowlist,current_moduleset,dns_tunnel_block_list_rpz_zones,domain_collapsing_level,enable_allowlist_auto_download,enable_allowlist_scheduled_download,enable_auto_download,enable_scheduled_download,last_allowlist_update_time,last_allowlist_update_version,last_checked_for_allowlist_update,last_checked_for_update,last_module_update_time,last_module_update_version,module_update_policy,name,scheduled_allowlist_download,scheduled_download"
+
+func TestAccGridThreatinsightResource_basic(t *testing.T) {
+	var resourceName = "nios_grid_threatinsight.test"
+	var v grid.GridThreatinsight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridThreatinsightBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "module_update_policy"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridThreatinsightResource_EnableAutoDownload(t *testing.T) {
+	var resourceName = "nios_grid_threatinsight.test_enable_auto_download"
+	var v grid.GridThreatinsight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridThreatinsightEnableAutoDownload(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_download", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridThreatinsightEnableAutoDownload(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_download", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridThreatinsightResource_ModuleUpdatePolicy(t *testing.T) {
+	var resourceName = "nios_grid_threatinsight.test_module_update_policy"
+	var v grid.GridThreatinsight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridThreatinsightModuleUpdatePolicy("MANUAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "module_update_policy", "MANUAL"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridThreatinsightModuleUpdatePolicy("AUTOMATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "module_update_policy", "AUTOMATIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGridThreatinsightResource_ScheduledDownload(t *testing.T) {
+	var resourceName = "nios_grid_threatinsight.test_scheduled_download"
+	var v grid.GridThreatinsight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccGridThreatinsightScheduledDownload("DAILY", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_scheduled_download", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_download.frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_download.hour_of_day", "2"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccGridThreatinsightScheduledDownload("WEEKLY", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGridThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_download.frequency", "WEEKLY"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_download.hour_of_day", "4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckGridThreatinsightExists(ctx context.Context, resourceName string, v *grid.GridThreatinsight) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			GridThreatinsightAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForGridThreatinsight).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetGridThreatinsightResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetGridThreatinsightResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccGridThreatinsightBasicConfig() string {
+	return `
+resource "nios_grid_threatinsight" "test" {
+}
+`
+}
+
+func testAccGridThreatinsightEnableAutoDownload(enableAutoDownload bool) string {
+	return fmt.Sprintf(`
+resource "nios_grid_threatinsight" "test_enable_auto_download" {
+  enable_auto_download = %t
+}
+`, enableAutoDownload)
+}
+
+func testAccGridThreatinsightModuleUpdatePolicy(moduleUpdatePolicy string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_threatinsight" "test_module_update_policy" {
+  module_update_policy = %q
+}
+`, moduleUpdatePolicy)
+}
+
+func testAccGridThreatinsightScheduledDownload(frequency string, hourOfDay int) string {
+	return fmt.Sprintf(`
+resource "nios_grid_threatinsight" "test_scheduled_download" {
+  enable_scheduled_download = true
+  scheduled_download = {
+    frequency         = %q
+    hour_of_day       = %d
+    minutes_past_hour = 0
+    weekdays          = ["MONDAY"]
+    repeat            = "RECUR"
+  }
+}
+`, frequency, hourOfDay)
+}
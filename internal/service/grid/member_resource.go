@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +36,12 @@ type MemberResource struct {
 	client *niosclient.APIClient
 }
 
+// MemberResourceModel describes the resource data model, extending MemberModel with the operation timeouts.
+type MemberResourceModel struct {
+	MemberModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *MemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "grid_member"
 }
@@ -43,6 +50,7 @@ func (r *MemberResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Member resource object.",
 		Attributes:          MemberResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +76,7 @@ func (r *MemberResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *MemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data MemberModel
+	var data MemberResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -112,7 +120,7 @@ func (r *MemberResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	var apiRes *grid.CreateMemberResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -176,7 +184,7 @@ func (r *MemberResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *MemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data MemberModel
+	var data MemberResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -198,14 +206,14 @@ func (r *MemberResource) Read(ctx context.Context, req resource.ReadRequest, res
 		apiRes  *grid.GetMemberResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.GridAPI.
 			MemberAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForMember).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -261,7 +269,7 @@ func (r *MemberResource) Read(ctx context.Context, req resource.ReadRequest, res
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *MemberResource) ReadByExtAttrs(ctx context.Context, data *MemberModel, resp *resource.ReadResponse) bool {
+func (r *MemberResource) ReadByExtAttrs(ctx context.Context, data *MemberResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -288,7 +296,7 @@ func (r *MemberResource) ReadByExtAttrs(ctx context.Context, data *MemberModel,
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForMember).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Member by extattrs, got error: %s", err))
@@ -320,7 +328,7 @@ func (r *MemberResource) ReadByExtAttrs(ctx context.Context, data *MemberModel,
 
 func (r *MemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data MemberModel
+	var data MemberResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -400,7 +408,7 @@ func (r *MemberResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	var apiRes *grid.UpdateMemberResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -443,7 +451,7 @@ func (r *MemberResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *MemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data MemberModel
+	var data MemberResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -454,7 +462,7 @@ func (r *MemberResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.GridAPI.
 			MemberAPI.
 			Delete(ctx, resourceRef).
@@ -476,7 +484,7 @@ func (r *MemberResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *MemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data MemberModel
+	var data MemberResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,112 @@
+package grid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// TODO : OBJECTS TO BE PRESENT IN GRID FOR TESTS
+// Grid Members: infoblox.localdomain
+
+var readableAttributesForMemberThreatinsight = "comment,enable_service,host_name,ipv4_address,ipv6_address,status"
+
+func TestAccMemberThreatinsightResource_basic(t *testing.T) {
+	var resourceName = "nios_grid_member_threatinsight.test"
+	var v grid.MemberThreatinsight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberThreatinsightBasicConfig("infoblox.localdomain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "host_name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttrSet(resourceName, "enable_service"),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv4_address"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMemberThreatinsightResource_EnableService(t *testing.T) {
+	var resourceName = "nios_grid_member_threatinsight.test_enable_service"
+	var v grid.MemberThreatinsight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMemberThreatinsightEnableService("infoblox.localdomain", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_service", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMemberThreatinsightEnableService("infoblox.localdomain", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberThreatinsightExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_service", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckMemberThreatinsightExists(ctx context.Context, resourceName string, v *grid.MemberThreatinsight) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.GridAPI.
+			MemberThreatinsightAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForMemberThreatinsight).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetMemberThreatinsightResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetMemberThreatinsightResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccMemberThreatinsightBasicConfig(hostName string) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_threatinsight" "test" {
+  host_name = %q
+}
+`, hostName)
+}
+
+func testAccMemberThreatinsightEnableService(hostName string, enableService bool) string {
+	return fmt.Sprintf(`
+resource "nios_grid_member_threatinsight" "test_enable_service" {
+  host_name      = %q
+  enable_service = %t
+}
+`, hostName, enableService)
+}
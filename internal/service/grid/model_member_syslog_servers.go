@@ -221,9 +221,7 @@ func (r *MemberResource) processSyslogServers(
 		return syslogServers, true
 	}
 
-	baseUrl := r.client.GridAPI.Cfg.NIOSHostURL
-	username := r.client.GridAPI.Cfg.NIOSUsername
-	password := r.client.GridAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.GridAPI.Cfg)
 
 	var servers []MemberSyslogServersModel
 	diagResult := syslogServers.ElementsAs(ctx, &servers, false)
@@ -235,7 +233,7 @@ func (r *MemberResource) processSyslogServers(
 	for i, server := range servers {
 		if !server.CertificateFilePath.IsNull() && !server.CertificateFilePath.IsUnknown() {
 			filePath := server.CertificateFilePath.ValueString()
-			token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+			token, err := utils.UploadFileWithToken(ctx, r.client.GridAPI.Cfg.HTTPClient, baseUrl, filePath)
 			if err != nil {
 				diag.AddError(
 					"Client Error",
@@ -0,0 +1,108 @@
+package grid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/grid"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type MemberThreatinsightModel struct {
+	Ref           types.String `tfsdk:"ref"`
+	Comment       types.String `tfsdk:"comment"`
+	EnableService types.Bool   `tfsdk:"enable_service"`
+	HostName      types.String `tfsdk:"host_name"`
+	Ipv4Address   types.String `tfsdk:"ipv4_address"`
+	Ipv6Address   types.String `tfsdk:"ipv6_address"`
+	Status        types.String `tfsdk:"status"`
+}
+
+var MemberThreatinsightAttrTypes = map[string]attr.Type{
+	"ref":            types.StringType,
+	"comment":        types.StringType,
+	"enable_service": types.BoolType,
+	"host_name":      types.StringType,
+	"ipv4_address":   types.StringType,
+	"ipv6_address":   types.StringType,
+	"status":         types.StringType,
+}
+
+var MemberThreatinsightResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Grid member descriptive comment.",
+	},
+	"enable_service": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines whether the threat insight service is enabled.",
+	},
+	"host_name": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The Grid member host name.",
+	},
+	"ipv4_address": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The IPv4 Address address of the Grid member.",
+	},
+	"ipv6_address": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The IPv6 Address address of the Grid member.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Grid member threat insight status.",
+	},
+}
+
+func (m *MemberThreatinsightModel) Expand(ctx context.Context, diags *diag.Diagnostics) *grid.MemberThreatinsight {
+	if m == nil {
+		return nil
+	}
+	to := &grid.MemberThreatinsight{
+		EnableService: flex.ExpandBoolPointer(m.EnableService),
+	}
+	return to
+}
+
+func FlattenMemberThreatinsight(ctx context.Context, from *grid.MemberThreatinsight, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(MemberThreatinsightAttrTypes)
+	}
+	m := MemberThreatinsightModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, MemberThreatinsightAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *MemberThreatinsightModel) Flatten(ctx context.Context, from *grid.MemberThreatinsight, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = MemberThreatinsightModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.EnableService = types.BoolPointerValue(from.EnableService)
+	m.HostName = flex.FlattenStringPointer(from.HostName)
+	m.Ipv4Address = flex.FlattenStringPointer(from.Ipv4Address)
+	m.Ipv6Address = flex.FlattenStringPointer(from.Ipv6Address)
+	m.Status = flex.FlattenStringPointer(from.Status)
+}
@@ -132,7 +132,7 @@ func (d *UpgradegroupDataSource) Read(ctx context.Context, req datasource.ReadRe
 				ReturnFieldsPlus(readableAttributesForUpgradegroup).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -93,7 +93,7 @@ func (d *UpgradescheduleDataSource) Read(ctx context.Context, req datasource.Rea
 		List(ctx).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForUpgradeschedule).
-		ProxySearch(config.GetProxySearch())
+		ProxySearch(config.GetProxySearch(ctx))
 
 	// Execute the request
 	apiRes, _, err := request.Execute()
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/grid"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type UpgradescheduleResource struct {
 	client *niosclient.APIClient
 }
 
+// UpgradescheduleResourceModel describes the resource data model, extending UpgradescheduleModel with the operation timeouts.
+type UpgradescheduleResourceModel struct {
+	UpgradescheduleModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *UpgradescheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "grid_upgradeschedule"
 }
@@ -42,6 +50,7 @@ func (r *UpgradescheduleResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an Upgrade Schedule",
 		Attributes:          UpgradescheduleResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *UpgradescheduleResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *UpgradescheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data UpgradescheduleModel
+	var data UpgradescheduleResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -101,7 +110,7 @@ func (r *UpgradescheduleResource) ValidateConfig(ctx context.Context, req resour
 }
 
 func (r *UpgradescheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data UpgradescheduleModel
+	var data UpgradescheduleResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -139,7 +148,7 @@ func (r *UpgradescheduleResource) Create(ctx context.Context, req resource.Creat
 
 	var apiRes *grid.UpdateUpgradescheduleResponse
 
-	err = retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err = retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -179,7 +188,7 @@ func (r *UpgradescheduleResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *UpgradescheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data UpgradescheduleModel
+	var data UpgradescheduleResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -195,14 +204,14 @@ func (r *UpgradescheduleResource) Read(ctx context.Context, req resource.ReadReq
 		apiRes  *grid.GetUpgradescheduleResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.GridAPI.
 			UpgradescheduleAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForUpgradeschedule).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -232,7 +241,7 @@ func (r *UpgradescheduleResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *UpgradescheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data UpgradescheduleModel
+	var data UpgradescheduleResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -256,7 +265,7 @@ func (r *UpgradescheduleResource) Update(ctx context.Context, req resource.Updat
 
 	var apiRes *grid.UpdateUpgradescheduleResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -132,7 +132,7 @@ func (d *BulkhostnametemplateDataSource) Read(ctx context.Context, req datasourc
 				ReturnFieldsPlus(readableAttributesForBulkhostnametemplate).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
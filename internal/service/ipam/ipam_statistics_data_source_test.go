@@ -0,0 +1,49 @@
+package ipam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccIpamStatisticsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_ipam_statistics.test"
+	network := "10.120.32.0/24"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpamStatisticsDataSourceConfigFilters(network),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network", network),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network_view", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.cidr", "24"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.utilization"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccIpamStatisticsDataSourceConfigFilters(network string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_network" "test" {
+  network = %q
+}
+
+data "nios_ipam_statistics" "test" {
+  filters = {
+    network      = nios_ipam_network.test.network
+    network_view = nios_ipam_network.test.network_view
+  }
+}
+`, network)
+}
@@ -0,0 +1,84 @@
+package ipam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccIpv4addressDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_ipam_ipv4address.test"
+	network := "10.120.30.0/24"
+	ipAddress := "10.120.30.5"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv4addressDataSourceConfigFilters(network, ipAddress),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.ip_address", ipAddress),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network", network),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network_view", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.status", "UNUSED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIpv4addressDataSource_Network(t *testing.T) {
+	dataSourceName := "data.nios_ipam_ipv4address.test"
+	network := "10.120.31.0/29"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv4addressDataSourceConfigNetwork(network),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "8"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.status"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccIpv4addressDataSourceConfigFilters(network, ipAddress string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_network" "test" {
+  network = %q
+}
+
+data "nios_ipam_ipv4address" "test" {
+  filters = {
+    network    = nios_ipam_network.test.network
+    ip_address = %q
+  }
+}
+`, network, ipAddress)
+}
+
+func testAccIpv4addressDataSourceConfigNetwork(network string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_network" "test" {
+  network = %q
+}
+
+data "nios_ipam_ipv4address" "test" {
+  filters = {
+    network = nios_ipam_network.test.network
+  }
+}
+`, network)
+}
@@ -0,0 +1,57 @@
+package ipam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccIpv6addressDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_ipam_ipv6address.test"
+	network := "2001:db8:120:30::/64"
+	ipv6addr := "2001:db8:120:30::10"
+	duid := "00:01:00:01:1d:2b:3c:4d:00:0c:29:ab:cd:ef"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIpv6addressDataSourceConfigFilters(network, ipv6addr, duid),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.ip_address", ipv6addr),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.network", network),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.duid", duid),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.status", "USED"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccIpv6addressDataSourceConfigFilters(network, ipv6addr, duid string) string {
+	return fmt.Sprintf(`
+resource "nios_ipam_ipv6network" "test" {
+  network = %q
+}
+
+resource "nios_dhcp_ipv6fixedaddress" "test" {
+  ipv6addr = %q
+  duid     = %q
+  network  = nios_ipam_ipv6network.test.network
+}
+
+data "nios_ipam_ipv6address" "test" {
+  filters = {
+    network    = nios_ipam_ipv6network.test.network
+    ip_address = nios_dhcp_ipv6fixedaddress.test.ipv6addr
+  }
+}
+`, network, ipv6addr, duid)
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -37,6 +38,12 @@ type Ipv6networkResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6networkResourceModel describes the resource data model, extending Ipv6networkModel with the operation timeouts.
+type Ipv6networkResourceModel struct {
+	Ipv6networkModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6networkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_ipv6network"
 }
@@ -45,6 +52,7 @@ func (r *Ipv6networkResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages IPv6 Network",
 		Attributes:          Ipv6networkResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -70,7 +78,7 @@ func (r *Ipv6networkResource) Configure(ctx context.Context, req resource.Config
 
 func (r *Ipv6networkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6networkModel
+	var data Ipv6networkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -98,7 +106,11 @@ func (r *Ipv6networkResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *ipam.CreateIpv6networkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network container are serialized so that parallel resources get distinct networks
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -163,7 +175,7 @@ func (r *Ipv6networkResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *Ipv6networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6networkModel
+	var data Ipv6networkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -185,14 +197,14 @@ func (r *Ipv6networkResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *ipam.GetIpv6networkResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			Ipv6networkAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6network).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -247,7 +259,7 @@ func (r *Ipv6networkResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *Ipv6networkResource) ReadByExtAttrs(ctx context.Context, data *Ipv6networkModel, resp *resource.ReadResponse) bool {
+func (r *Ipv6networkResource) ReadByExtAttrs(ctx context.Context, data *Ipv6networkResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -274,7 +286,7 @@ func (r *Ipv6networkResource) ReadByExtAttrs(ctx context.Context, data *Ipv6netw
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForIpv6network).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Ipv6network by extattrs, got error: %s", err))
@@ -305,7 +317,7 @@ func (r *Ipv6networkResource) ReadByExtAttrs(ctx context.Context, data *Ipv6netw
 
 func (r *Ipv6networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6networkModel
+	var data Ipv6networkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -354,7 +366,7 @@ func (r *Ipv6networkResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *ipam.UpdateIpv6networkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -397,7 +409,7 @@ func (r *Ipv6networkResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *Ipv6networkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6networkModel
+	var data Ipv6networkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -408,7 +420,7 @@ func (r *Ipv6networkResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			Ipv6networkAPI.
 			Delete(ctx, resourceRef).
@@ -429,7 +441,7 @@ func (r *Ipv6networkResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
-func (r *Ipv6networkResource) UpdateFuncCallAttributeName(ctx context.Context, data Ipv6networkModel, diags *diag.Diagnostics) types.Object {
+func (r *Ipv6networkResource) UpdateFuncCallAttributeName(ctx context.Context, data Ipv6networkResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -449,7 +461,7 @@ func (r *Ipv6networkResource) ImportState(ctx context.Context, req resource.Impo
 }
 
 func (r *Ipv6networkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data Ipv6networkModel
+	var data Ipv6networkResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -727,7 +739,7 @@ func (r *Ipv6networkResource) ValidateConfig(ctx context.Context, req resource.V
 	}
 }
 
-func (r *Ipv6networkResource) isIpv6NetworkConvertedToContainer(ctx context.Context, data *Ipv6networkModel) bool {
+func (r *Ipv6networkResource) isIpv6NetworkConvertedToContainer(ctx context.Context, data *Ipv6networkResourceModel) bool {
 	// Try to fetch as Ipv6network container
 	_, _, err := r.client.IPAMAPI.
 		Ipv6networkcontainerAPI.
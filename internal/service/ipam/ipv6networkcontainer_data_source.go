@@ -139,7 +139,7 @@ func (d *Ipv6networkcontainerDataSource) Read(ctx context.Context, req datasourc
 				ReturnFieldsPlus(readableAttributesForIpv6networkcontainer).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +37,12 @@ type Ipv6networkcontainerResource struct {
 	client *niosclient.APIClient
 }
 
+// Ipv6networkcontainerResourceModel describes the resource data model, extending Ipv6networkcontainerModel with the operation timeouts.
+type Ipv6networkcontainerResourceModel struct {
+	Ipv6networkcontainerModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *Ipv6networkcontainerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_ipv6network_container"
 }
@@ -44,6 +51,7 @@ func (r *Ipv6networkcontainerResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an IPv6 Network Container",
 		Attributes:          Ipv6networkcontainerResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -69,7 +77,7 @@ func (r *Ipv6networkcontainerResource) Configure(ctx context.Context, req resour
 
 func (r *Ipv6networkcontainerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6networkcontainerModel
+	var data Ipv6networkcontainerResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -97,7 +105,11 @@ func (r *Ipv6networkcontainerResource) Create(ctx context.Context, req resource.
 
 	var apiRes *ipam.CreateIpv6networkcontainerResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network container are serialized so that parallel resources get distinct networks
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -149,7 +161,7 @@ func (r *Ipv6networkcontainerResource) Create(ctx context.Context, req resource.
 
 func (r *Ipv6networkcontainerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6networkcontainerModel
+	var data Ipv6networkcontainerResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -171,14 +183,14 @@ func (r *Ipv6networkcontainerResource) Read(ctx context.Context, req resource.Re
 		apiRes  *ipam.GetIpv6networkcontainerResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			Ipv6networkcontainerAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForIpv6networkcontainer).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -233,7 +245,7 @@ func (r *Ipv6networkcontainerResource) Read(ctx context.Context, req resource.Re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *Ipv6networkcontainerResource) ReadByExtAttrs(ctx context.Context, data *Ipv6networkcontainerModel, resp *resource.ReadResponse) bool {
+func (r *Ipv6networkcontainerResource) ReadByExtAttrs(ctx context.Context, data *Ipv6networkcontainerResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -260,7 +272,7 @@ func (r *Ipv6networkcontainerResource) ReadByExtAttrs(ctx context.Context, data
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForIpv6networkcontainer).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Ipv6networkcontainer by extattrs, got error: %s", err))
@@ -291,7 +303,7 @@ func (r *Ipv6networkcontainerResource) ReadByExtAttrs(ctx context.Context, data
 
 func (r *Ipv6networkcontainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data Ipv6networkcontainerModel
+	var data Ipv6networkcontainerResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -340,7 +352,7 @@ func (r *Ipv6networkcontainerResource) Update(ctx context.Context, req resource.
 
 	var apiRes *ipam.UpdateIpv6networkcontainerResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -383,7 +395,7 @@ func (r *Ipv6networkcontainerResource) Update(ctx context.Context, req resource.
 }
 
 func (r *Ipv6networkcontainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data Ipv6networkcontainerModel
+	var data Ipv6networkcontainerResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -394,7 +406,7 @@ func (r *Ipv6networkcontainerResource) Delete(ctx context.Context, req resource.
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			Ipv6networkcontainerAPI.
 			Delete(ctx, resourceRef).
@@ -415,7 +427,7 @@ func (r *Ipv6networkcontainerResource) Delete(ctx context.Context, req resource.
 	}
 }
 
-func (r *Ipv6networkcontainerResource) UpdateFuncCallAttributeName(ctx context.Context, data Ipv6networkcontainerModel, diags *diag.Diagnostics) types.Object {
+func (r *Ipv6networkcontainerResource) UpdateFuncCallAttributeName(ctx context.Context, data Ipv6networkcontainerResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -435,7 +447,7 @@ func (r *Ipv6networkcontainerResource) ImportState(ctx context.Context, req reso
 }
 
 func (r *Ipv6networkcontainerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data Ipv6networkcontainerModel
+	var data Ipv6networkcontainerResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
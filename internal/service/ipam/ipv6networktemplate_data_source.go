@@ -140,7 +140,7 @@ func (d *Ipv6networktemplateDataSource) Read(ctx context.Context, req datasource
 				ReturnFieldsPlus(readableAttributesForIpv6networktemplate).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -0,0 +1,107 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type IpamStatisticsModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	Cidr              types.Int64  `tfsdk:"cidr"`
+	ConflictCount     types.Int64  `tfsdk:"conflict_count"`
+	MsAdUserData      types.Object `tfsdk:"ms_ad_user_data"`
+	Network           types.String `tfsdk:"network"`
+	NetworkView       types.String `tfsdk:"network_view"`
+	UnmanagedCount    types.Int64  `tfsdk:"unmanaged_count"`
+	Utilization       types.Int64  `tfsdk:"utilization"`
+	UtilizationUpdate types.Int64  `tfsdk:"utilization_update"`
+}
+
+var IpamStatisticsAttrTypes = map[string]attr.Type{
+	"ref":                types.StringType,
+	"cidr":               types.Int64Type,
+	"conflict_count":     types.Int64Type,
+	"ms_ad_user_data":    types.ObjectType{AttrTypes: IpamStatisticsMsAdUserDataAttrTypes},
+	"network":            types.StringType,
+	"network_view":       types.StringType,
+	"unmanaged_count":    types.Int64Type,
+	"utilization":        types.Int64Type,
+	"utilization_update": types.Int64Type,
+}
+
+var IpamStatisticsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"cidr": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The network CIDR.",
+	},
+	"conflict_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of conflicts discovered via network discovery. This attribute is only valid for a Network object.",
+	},
+	"ms_ad_user_data": schema.SingleNestedAttribute{
+		Attributes:          IpamStatisticsMsAdUserDataResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The Microsoft Active Directory user related information.",
+	},
+	"network": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The network address.",
+	},
+	"network_view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The network view.",
+	},
+	"unmanaged_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of unmanaged IP addresses as discovered by network discovery. This attribute is only valid for a Network object.",
+	},
+	"utilization": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The network utilization in percentage.",
+	},
+	"utilization_update": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time that the utilization statistics were updated last. This attribute is only valid for a Network object. For a Network Container object, the return value is undefined.",
+	},
+}
+
+func FlattenIpamStatistics(ctx context.Context, from *ipam.IpamStatistics, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(IpamStatisticsAttrTypes)
+	}
+	m := IpamStatisticsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, IpamStatisticsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *IpamStatisticsModel) Flatten(ctx context.Context, from *ipam.IpamStatistics, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = IpamStatisticsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Cidr = flex.FlattenInt64Pointer(from.Cidr)
+	m.ConflictCount = flex.FlattenInt64Pointer(from.ConflictCount)
+	m.MsAdUserData = FlattenIpamStatisticsMsAdUserData(ctx, from.MsAdUserData, diags)
+	m.Network = flex.FlattenStringPointer(from.Network)
+	m.NetworkView = flex.FlattenStringPointer(from.NetworkView)
+	m.UnmanagedCount = flex.FlattenInt64Pointer(from.UnmanagedCount)
+	m.Utilization = flex.FlattenInt64Pointer(from.Utilization)
+	m.UtilizationUpdate = flex.FlattenInt64Pointer(from.UtilizationUpdate)
+}
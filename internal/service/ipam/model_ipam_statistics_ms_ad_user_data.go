@@ -0,0 +1,50 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type IpamStatisticsMsAdUserDataModel struct {
+	ActiveUsersCount types.Int64 `tfsdk:"active_users_count"`
+}
+
+var IpamStatisticsMsAdUserDataAttrTypes = map[string]attr.Type{
+	"active_users_count": types.Int64Type,
+}
+
+var IpamStatisticsMsAdUserDataResourceSchemaAttributes = map[string]schema.Attribute{
+	"active_users_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of active users.",
+	},
+}
+
+func FlattenIpamStatisticsMsAdUserData(ctx context.Context, from *ipam.IpamStatisticsMsAdUserData, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(IpamStatisticsMsAdUserDataAttrTypes)
+	}
+	m := IpamStatisticsMsAdUserDataModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, IpamStatisticsMsAdUserDataAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *IpamStatisticsMsAdUserDataModel) Flatten(ctx context.Context, from *ipam.IpamStatisticsMsAdUserData, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = IpamStatisticsMsAdUserDataModel{}
+	}
+	m.ActiveUsersCount = flex.FlattenInt64Pointer(from.ActiveUsersCount)
+}
@@ -0,0 +1,50 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type Ipv4addressMsAdUserDataModel struct {
+	ActiveUsersCount types.Int64 `tfsdk:"active_users_count"`
+}
+
+var Ipv4addressMsAdUserDataAttrTypes = map[string]attr.Type{
+	"active_users_count": types.Int64Type,
+}
+
+var Ipv4addressMsAdUserDataResourceSchemaAttributes = map[string]schema.Attribute{
+	"active_users_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of active users.",
+	},
+}
+
+func FlattenIpv4addressMsAdUserData(ctx context.Context, from *ipam.Ipv4addressMsAdUserData, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(Ipv4addressMsAdUserDataAttrTypes)
+	}
+	m := Ipv4addressMsAdUserDataModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, Ipv4addressMsAdUserDataAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *Ipv4addressMsAdUserDataModel) Flatten(ctx context.Context, from *ipam.Ipv4addressMsAdUserData, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = Ipv4addressMsAdUserDataModel{}
+	}
+	m.ActiveUsersCount = flex.FlattenInt64Pointer(from.ActiveUsersCount)
+}
@@ -0,0 +1,190 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type Ipv6addressModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	Comment           types.String `tfsdk:"comment"`
+	ConflictTypes     types.List   `tfsdk:"conflict_types"`
+	DiscoverNowStatus types.String `tfsdk:"discover_now_status"`
+	DiscoveredData    types.Object `tfsdk:"discovered_data"`
+	ExtAttrs          types.Map    `tfsdk:"extattrs"`
+	Duid              types.String `tfsdk:"duid"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	IpAddress         types.String `tfsdk:"ip_address"`
+	IsConflict        types.Bool   `tfsdk:"is_conflict"`
+	LeaseState        types.String `tfsdk:"lease_state"`
+	MsAdUserData      types.Object `tfsdk:"ms_ad_user_data"`
+	Names             types.List   `tfsdk:"names"`
+	Network           types.String `tfsdk:"network"`
+	NetworkView       types.String `tfsdk:"network_view"`
+	Objects           types.String `tfsdk:"objects"`
+	ReservedPort      types.String `tfsdk:"reserved_port"`
+	Status            types.String `tfsdk:"status"`
+	Types             types.List   `tfsdk:"types"`
+	Usage             types.List   `tfsdk:"usage"`
+}
+
+var Ipv6addressAttrTypes = map[string]attr.Type{
+	"ref":                 types.StringType,
+	"comment":             types.StringType,
+	"conflict_types":      types.ListType{ElemType: types.StringType},
+	"discover_now_status": types.StringType,
+	"discovered_data":     types.ObjectType{AttrTypes: Ipv6addressDiscoveredDataAttrTypes},
+	"extattrs":            types.MapType{ElemType: types.StringType},
+	"duid":                types.StringType,
+	"fingerprint":         types.StringType,
+	"ip_address":          types.StringType,
+	"is_conflict":         types.BoolType,
+	"lease_state":         types.StringType,
+	"ms_ad_user_data":     types.ObjectType{AttrTypes: Ipv6addressMsAdUserDataAttrTypes},
+	"names":               types.ListType{ElemType: types.StringType},
+	"network":             types.StringType,
+	"network_view":        types.StringType,
+	"objects":             types.StringType,
+	"reserved_port":       types.StringType,
+	"status":              types.StringType,
+	"types":               types.ListType{ElemType: types.StringType},
+	"usage":               types.ListType{ElemType: types.StringType},
+}
+
+var Ipv6addressResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Comment for the address; maximum 256 characters.",
+	},
+	"conflict_types": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "Types of the conflict.",
+	},
+	"discover_now_status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Discover now status for this address.",
+	},
+	"discovered_data": schema.SingleNestedAttribute{
+		Attributes:          Ipv6addressDiscoveredDataResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The discovered data for this IPv6 address.",
+	},
+	"extattrs": schema.MapAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"duid": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "DHCPv6 Unique Identifier (DUID) of the address object.",
+	},
+	"fingerprint": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "DHCP fingerprint for the address.",
+	},
+	"ip_address": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "IPv6 addresses of the address object.",
+	},
+	"is_conflict": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "IP address has either a duid conflict or a DHCP lease conflict detected through a network discovery.",
+	},
+	"lease_state": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The lease state of the address.",
+	},
+	"ms_ad_user_data": schema.SingleNestedAttribute{
+		Attributes:          Ipv6addressMsAdUserDataResourceSchemaAttributes,
+		Computed:            true,
+		MarkdownDescription: "The Microsoft Active Directory user related information.",
+	},
+	"names": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The DNS names. For example, if the IP address belongs to a host record, this field contains the hostname. This field supports both single and array search.",
+	},
+	"network": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The network to which this address belongs, in FQDN/CIDR format.",
+	},
+	"network_view": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the network view.",
+	},
+	"objects": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The objects associated with the IP address.",
+	},
+	"reserved_port": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reserved port for the address.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The current status of the address.",
+	},
+	"types": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The types of associated objects. This field supports both single and array search.",
+	},
+	"usage": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "Indicates whether the IP address is configured for DNS or DHCP. This field supports both single and array search.",
+	},
+}
+
+func FlattenIpv6address(ctx context.Context, from *ipam.Ipv6address, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(Ipv6addressAttrTypes)
+	}
+	m := Ipv6addressModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, Ipv6addressAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *Ipv6addressModel) Flatten(ctx context.Context, from *ipam.Ipv6address, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = Ipv6addressModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.ConflictTypes = flex.FlattenFrameworkListString(ctx, from.ConflictTypes, diags)
+	m.DiscoverNowStatus = flex.FlattenStringPointer(from.DiscoverNowStatus)
+	m.DiscoveredData = FlattenIpv6addressDiscoveredData(ctx, from.DiscoveredData, diags)
+	m.ExtAttrs = FlattenExtAttrs(ctx, m.ExtAttrs, from.ExtAttrs, diags)
+	m.Duid = flex.FlattenStringPointer(from.Duid)
+	m.Fingerprint = flex.FlattenStringPointer(from.Fingerprint)
+	m.IpAddress = flex.FlattenStringPointer(from.IpAddress)
+	m.IsConflict = types.BoolPointerValue(from.IsConflict)
+	m.LeaseState = flex.FlattenStringPointer(from.LeaseState)
+	m.MsAdUserData = FlattenIpv6addressMsAdUserData(ctx, from.MsAdUserData, diags)
+	m.Names = flex.FlattenFrameworkListString(ctx, from.Names, diags)
+	m.Network = flex.FlattenStringPointer(from.Network)
+	m.NetworkView = flex.FlattenStringPointer(from.NetworkView)
+	m.Objects = flex.FlattenStringPointer(from.Objects)
+	m.ReservedPort = flex.FlattenStringPointer(from.ReservedPort)
+	m.Status = flex.FlattenStringPointer(from.Status)
+	m.Types = flex.FlattenFrameworkListString(ctx, from.Types, diags)
+	m.Usage = flex.FlattenFrameworkListString(ctx, from.Usage, diags)
+}
@@ -0,0 +1,50 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type Ipv6addressMsAdUserDataModel struct {
+	ActiveUsersCount types.Int64 `tfsdk:"active_users_count"`
+}
+
+var Ipv6addressMsAdUserDataAttrTypes = map[string]attr.Type{
+	"active_users_count": types.Int64Type,
+}
+
+var Ipv6addressMsAdUserDataResourceSchemaAttributes = map[string]schema.Attribute{
+	"active_users_count": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The number of active users.",
+	},
+}
+
+func FlattenIpv6addressMsAdUserData(ctx context.Context, from *ipam.Ipv6addressMsAdUserData, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(Ipv6addressMsAdUserDataAttrTypes)
+	}
+	m := Ipv6addressMsAdUserDataModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, Ipv6addressMsAdUserDataAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *Ipv6addressMsAdUserDataModel) Flatten(ctx context.Context, from *ipam.Ipv6addressMsAdUserData, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = Ipv6addressMsAdUserDataModel{}
+	}
+	m.ActiveUsersCount = flex.FlattenInt64Pointer(from.ActiveUsersCount)
+}
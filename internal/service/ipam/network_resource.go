@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +37,12 @@ type NetworkResource struct {
 	client *niosclient.APIClient
 }
 
+// NetworkResourceModel describes the resource data model, extending NetworkModel with the operation timeouts.
+type NetworkResourceModel struct {
+	NetworkModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_network"
 }
@@ -44,6 +51,7 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Network",
 		Attributes:          NetworkResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -69,7 +77,7 @@ func (r *NetworkResource) Configure(ctx context.Context, req resource.ConfigureR
 
 func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NetworkModel
+	var data NetworkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -97,7 +105,11 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var apiRes *ipam.CreateNetworkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network container are serialized so that parallel resources get distinct networks
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -162,7 +174,7 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 
 func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NetworkModel
+	var data NetworkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -184,14 +196,14 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		apiRes  *ipam.GetNetworkResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			NetworkAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNetwork).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -246,7 +258,7 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NetworkResource) ReadByExtAttrs(ctx context.Context, data *NetworkModel, resp *resource.ReadResponse) bool {
+func (r *NetworkResource) ReadByExtAttrs(ctx context.Context, data *NetworkResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -273,7 +285,7 @@ func (r *NetworkResource) ReadByExtAttrs(ctx context.Context, data *NetworkModel
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNetwork).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Network by extattrs, got error: %s", err))
@@ -304,7 +316,7 @@ func (r *NetworkResource) ReadByExtAttrs(ctx context.Context, data *NetworkModel
 
 func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NetworkModel
+	var data NetworkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -353,7 +365,7 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var apiRes *ipam.UpdateNetworkResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -396,7 +408,7 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NetworkModel
+	var data NetworkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -407,7 +419,7 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			NetworkAPI.
 			Delete(ctx, resourceRef).
@@ -428,7 +440,7 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
-func (r *NetworkResource) UpdateFuncCallAttributeName(ctx context.Context, data NetworkModel, diags *diag.Diagnostics) types.Object {
+func (r *NetworkResource) UpdateFuncCallAttributeName(ctx context.Context, data NetworkResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -448,7 +460,7 @@ func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportSt
 }
 
 func (r *NetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data NetworkModel
+	var data NetworkResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -611,7 +623,7 @@ func (r *NetworkResource) ValidateConfig(ctx context.Context, req resource.Valid
 	}
 }
 
-func (r *NetworkResource) isNetworkConvertedToContainer(ctx context.Context, data *NetworkModel) bool {
+func (r *NetworkResource) isNetworkConvertedToContainer(ctx context.Context, data *NetworkResourceModel) bool {
 	// Try to fetch as Network container
 	_, _, err := r.client.IPAMAPI.
 		NetworkcontainerAPI.
@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type NetworkcontainerResource struct {
 	client *niosclient.APIClient
 }
 
+// NetworkcontainerResourceModel describes the resource data model, extending NetworkcontainerModel with the operation timeouts.
+type NetworkcontainerResourceModel struct {
+	NetworkcontainerModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NetworkcontainerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_network_container"
 }
@@ -42,6 +49,7 @@ func (r *NetworkcontainerResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Network Container",
 		Attributes:          NetworkcontainerResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +75,7 @@ func (r *NetworkcontainerResource) Configure(ctx context.Context, req resource.C
 
 func (r *NetworkcontainerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NetworkcontainerModel
+	var data NetworkcontainerResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -95,7 +103,11 @@ func (r *NetworkcontainerResource) Create(ctx context.Context, req resource.Crea
 
 	var apiRes *ipam.CreateNetworkcontainerResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	// Allocations from the same network container are serialized so that parallel resources get distinct networks
+	unlock := utils.LockNextAvailable(payload)
+	defer unlock()
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -147,7 +159,7 @@ func (r *NetworkcontainerResource) Create(ctx context.Context, req resource.Crea
 
 func (r *NetworkcontainerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NetworkcontainerModel
+	var data NetworkcontainerResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -169,14 +181,14 @@ func (r *NetworkcontainerResource) Read(ctx context.Context, req resource.ReadRe
 		apiRes  *ipam.GetNetworkcontainerResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			NetworkcontainerAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNetworkcontainer).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -231,7 +243,7 @@ func (r *NetworkcontainerResource) Read(ctx context.Context, req resource.ReadRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NetworkcontainerResource) ReadByExtAttrs(ctx context.Context, data *NetworkcontainerModel, resp *resource.ReadResponse) bool {
+func (r *NetworkcontainerResource) ReadByExtAttrs(ctx context.Context, data *NetworkcontainerResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -258,7 +270,7 @@ func (r *NetworkcontainerResource) ReadByExtAttrs(ctx context.Context, data *Net
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNetworkcontainer).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Networkcontainer by extattrs, got error: %s", err))
@@ -289,7 +301,7 @@ func (r *NetworkcontainerResource) ReadByExtAttrs(ctx context.Context, data *Net
 
 func (r *NetworkcontainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NetworkcontainerModel
+	var data NetworkcontainerResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -338,7 +350,7 @@ func (r *NetworkcontainerResource) Update(ctx context.Context, req resource.Upda
 
 	var apiRes *ipam.UpdateNetworkcontainerResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -381,7 +393,7 @@ func (r *NetworkcontainerResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *NetworkcontainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NetworkcontainerModel
+	var data NetworkcontainerResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -392,7 +404,7 @@ func (r *NetworkcontainerResource) Delete(ctx context.Context, req resource.Dele
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			NetworkcontainerAPI.
 			Delete(ctx, resourceRef).
@@ -413,7 +425,7 @@ func (r *NetworkcontainerResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
-func (r *NetworkcontainerResource) UpdateFuncCallAttributeName(ctx context.Context, data NetworkcontainerModel, diags *diag.Diagnostics) types.Object {
+func (r *NetworkcontainerResource) UpdateFuncCallAttributeName(ctx context.Context, data NetworkcontainerResourceModel, diags *diag.Diagnostics) types.Object {
 
 	updatedFuncCallAttrs := data.FuncCall.Attributes()
 	attrVal := updatedFuncCallAttrs["attribute_name"].(types.String).ValueString()
@@ -433,7 +445,7 @@ func (r *NetworkcontainerResource) ImportState(ctx context.Context, req resource
 }
 
 func (r *NetworkcontainerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data NetworkcontainerModel
+	var data NetworkcontainerResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
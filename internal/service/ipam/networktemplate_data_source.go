@@ -140,7 +140,7 @@ func (d *NetworktemplateDataSource) Read(ctx context.Context, req datasource.Rea
 				ReturnFieldsPlus(readableAttributesForNetworktemplate).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
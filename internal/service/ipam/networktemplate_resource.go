@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type NetworktemplateResource struct {
 	client *niosclient.APIClient
 }
 
+// NetworktemplateResourceModel describes the resource data model, extending NetworktemplateModel with the operation timeouts.
+type NetworktemplateResourceModel struct {
+	NetworktemplateModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NetworktemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_networktemplate"
 }
@@ -42,6 +50,7 @@ func (r *NetworktemplateResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Network Template.",
 		Attributes:          NetworktemplateResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *NetworktemplateResource) Configure(ctx context.Context, req resource.Co
 
 func (r *NetworktemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NetworktemplateModel
+	var data NetworktemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -90,7 +99,7 @@ func (r *NetworktemplateResource) Create(ctx context.Context, req resource.Creat
 
 	var apiRes *ipam.CreateNetworktemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -138,7 +147,7 @@ func (r *NetworktemplateResource) Create(ctx context.Context, req resource.Creat
 
 func (r *NetworktemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NetworktemplateModel
+	var data NetworktemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -160,14 +169,14 @@ func (r *NetworktemplateResource) Read(ctx context.Context, req resource.ReadReq
 		apiRes  *ipam.GetNetworktemplateResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			NetworktemplateAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNetworktemplate).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -223,7 +232,7 @@ func (r *NetworktemplateResource) Read(ctx context.Context, req resource.ReadReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NetworktemplateResource) ReadByExtAttrs(ctx context.Context, data *NetworktemplateModel, resp *resource.ReadResponse) bool {
+func (r *NetworktemplateResource) ReadByExtAttrs(ctx context.Context, data *NetworktemplateResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -250,7 +259,7 @@ func (r *NetworktemplateResource) ReadByExtAttrs(ctx context.Context, data *Netw
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNetworktemplate).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Networktemplate by extattrs, got error: %s", err))
@@ -281,7 +290,7 @@ func (r *NetworktemplateResource) ReadByExtAttrs(ctx context.Context, data *Netw
 
 func (r *NetworktemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NetworktemplateModel
+	var data NetworktemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -332,7 +341,7 @@ func (r *NetworktemplateResource) Update(ctx context.Context, req resource.Updat
 
 	var apiRes *ipam.UpdateNetworktemplateResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -375,7 +384,7 @@ func (r *NetworktemplateResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *NetworktemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NetworktemplateModel
+	var data NetworktemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -386,7 +395,7 @@ func (r *NetworktemplateResource) Delete(ctx context.Context, req resource.Delet
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			NetworktemplateAPI.
 			Delete(ctx, resourceRef).
@@ -408,7 +417,7 @@ func (r *NetworktemplateResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *NetworktemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data NetworktemplateModel
+	var data NetworktemplateResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
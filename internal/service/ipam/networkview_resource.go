@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type NetworkviewResource struct {
 	client *niosclient.APIClient
 }
 
+// NetworkviewResourceModel describes the resource data model, extending NetworkviewModel with the operation timeouts.
+type NetworkviewResourceModel struct {
+	NetworkviewModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NetworkviewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_network_view"
 	resp.ResourceBehavior = resource.ResourceBehavior{
@@ -46,6 +54,7 @@ func (r *NetworkviewResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Network View.",
 		Attributes:          NetworkviewResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -81,7 +90,7 @@ func (r *NetworkviewResource) Configure(ctx context.Context, req resource.Config
 
 func (r *NetworkviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NetworkviewModel
+	var data NetworkviewResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -104,7 +113,7 @@ func (r *NetworkviewResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *ipam.CreateNetworkviewResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -154,7 +163,7 @@ func (r *NetworkviewResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *NetworkviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NetworkviewModel
+	var data NetworkviewResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -176,14 +185,14 @@ func (r *NetworkviewResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *ipam.GetNetworkviewResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			NetworkviewAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNetworkview).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -242,7 +251,7 @@ func (r *NetworkviewResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NetworkviewResource) ReadByExtAttrs(ctx context.Context, data *NetworkviewModel, resp *resource.ReadResponse) bool {
+func (r *NetworkviewResource) ReadByExtAttrs(ctx context.Context, data *NetworkviewResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -269,7 +278,7 @@ func (r *NetworkviewResource) ReadByExtAttrs(ctx context.Context, data *Networkv
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNetworkview).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Networkview by extattrs, got error: %s", err))
@@ -304,7 +313,7 @@ func (r *NetworkviewResource) ReadByExtAttrs(ctx context.Context, data *Networkv
 
 func (r *NetworkviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NetworkviewModel
+	var data NetworkviewResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -355,7 +364,7 @@ func (r *NetworkviewResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *ipam.UpdateNetworkviewResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -401,7 +410,7 @@ func (r *NetworkviewResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *NetworkviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NetworkviewModel
+	var data NetworkviewResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -412,7 +421,7 @@ func (r *NetworkviewResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			NetworkviewAPI.
 			Delete(ctx, resourceRef).
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type SuperhostResource struct {
 	client *niosclient.APIClient
 }
 
+// SuperhostResourceModel describes the resource data model, extending SuperhostModel with the operation timeouts.
+type SuperhostResourceModel struct {
+	SuperhostModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SuperhostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_superhost"
 }
@@ -43,6 +51,7 @@ func (r *SuperhostResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Super Host",
 		Attributes:          SuperhostResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *SuperhostResource) Configure(ctx context.Context, req resource.Configur
 
 func (r *SuperhostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SuperhostModel
+	var data SuperhostResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -110,7 +119,7 @@ func (r *SuperhostResource) Create(ctx context.Context, req resource.CreateReque
 
 	var apiRes *ipam.CreateSuperhostResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -158,7 +167,7 @@ func (r *SuperhostResource) Create(ctx context.Context, req resource.CreateReque
 
 func (r *SuperhostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SuperhostModel
+	var data SuperhostResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -180,14 +189,14 @@ func (r *SuperhostResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *ipam.GetSuperhostResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			SuperhostAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSuperhost).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -243,7 +252,7 @@ func (r *SuperhostResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *SuperhostResource) ReadByExtAttrs(ctx context.Context, data *SuperhostModel, resp *resource.ReadResponse) bool {
+func (r *SuperhostResource) ReadByExtAttrs(ctx context.Context, data *SuperhostResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -270,7 +279,7 @@ func (r *SuperhostResource) ReadByExtAttrs(ctx context.Context, data *SuperhostM
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForSuperhost).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Superhost by extattrs, got error: %s", err))
@@ -302,7 +311,7 @@ func (r *SuperhostResource) ReadByExtAttrs(ctx context.Context, data *SuperhostM
 
 func (r *SuperhostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SuperhostModel
+	var data SuperhostResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -372,7 +381,7 @@ func (r *SuperhostResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var apiRes *ipam.UpdateSuperhostResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -415,7 +424,7 @@ func (r *SuperhostResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *SuperhostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SuperhostModel
+	var data SuperhostResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -426,7 +435,7 @@ func (r *SuperhostResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			SuperhostAPI.
 			Delete(ctx, resourceRef).
@@ -448,7 +457,7 @@ func (r *SuperhostResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *SuperhostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data SuperhostModel
+	var data SuperhostResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
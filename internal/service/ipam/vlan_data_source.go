@@ -140,7 +140,7 @@ func (d *VlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 				ReturnFieldsPlus(readableAttributesForVlan).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
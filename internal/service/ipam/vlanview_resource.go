@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/ipam"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type VlanviewResource struct {
 	client *niosclient.APIClient
 }
 
+// VlanviewResourceModel describes the resource data model, extending VlanviewModel with the operation timeouts.
+type VlanviewResourceModel struct {
+	VlanviewModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *VlanviewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "ipam_vlanview"
 }
@@ -42,6 +50,7 @@ func (r *VlanviewResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a VLAN View",
 		Attributes:          VlanviewResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *VlanviewResource) Configure(ctx context.Context, req resource.Configure
 
 func (r *VlanviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data VlanviewModel
+	var data VlanviewResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *VlanviewResource) Create(ctx context.Context, req resource.CreateReques
 
 	var apiRes *ipam.CreateVlanviewResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -136,7 +145,7 @@ func (r *VlanviewResource) Create(ctx context.Context, req resource.CreateReques
 
 func (r *VlanviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data VlanviewModel
+	var data VlanviewResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -158,14 +167,14 @@ func (r *VlanviewResource) Read(ctx context.Context, req resource.ReadRequest, r
 		apiRes  *ipam.GetVlanviewResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.IPAMAPI.
 			VlanviewAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForVlanview).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -220,7 +229,7 @@ func (r *VlanviewResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *VlanviewResource) ReadByExtAttrs(ctx context.Context, data *VlanviewModel, resp *resource.ReadResponse) bool {
+func (r *VlanviewResource) ReadByExtAttrs(ctx context.Context, data *VlanviewResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -247,7 +256,7 @@ func (r *VlanviewResource) ReadByExtAttrs(ctx context.Context, data *VlanviewMod
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForVlanview).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Vlanview by extattrs, got error: %s", err))
@@ -278,7 +287,7 @@ func (r *VlanviewResource) ReadByExtAttrs(ctx context.Context, data *VlanviewMod
 
 func (r *VlanviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data VlanviewModel
+	var data VlanviewResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -328,7 +337,7 @@ func (r *VlanviewResource) Update(ctx context.Context, req resource.UpdateReques
 
 	var apiRes *ipam.UpdateVlanviewResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -370,7 +379,7 @@ func (r *VlanviewResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *VlanviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data VlanviewModel
+	var data VlanviewResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -381,7 +390,7 @@ func (r *VlanviewResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.IPAMAPI.
 			VlanviewAPI.
 			Delete(ctx, resourceRef).
@@ -403,7 +412,7 @@ func (r *VlanviewResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *VlanviewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data VlanviewModel
+	var data VlanviewResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
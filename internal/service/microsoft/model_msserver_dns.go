@@ -0,0 +1,133 @@
+package microsoft
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type MsserverDnsModel struct {
+	Ref                        types.String `tfsdk:"ref"`
+	Address                    types.String `tfsdk:"address"`
+	EnableDnsReportsSync       types.Bool   `tfsdk:"enable_dns_reports_sync"`
+	LoginName                  types.String `tfsdk:"login_name"`
+	LoginPassword              types.String `tfsdk:"login_password"`
+	SynchronizationInterval    types.Int64  `tfsdk:"synchronization_interval"`
+	UseEnableDnsReportsSync    types.Bool   `tfsdk:"use_enable_dns_reports_sync"`
+	UseLogin                   types.Bool   `tfsdk:"use_login"`
+	UseSynchronizationInterval types.Bool   `tfsdk:"use_synchronization_interval"`
+}
+
+var MsserverDnsAttrTypes = map[string]attr.Type{
+	"ref":                          types.StringType,
+	"address":                      types.StringType,
+	"enable_dns_reports_sync":      types.BoolType,
+	"login_name":                   types.StringType,
+	"login_password":               types.StringType,
+	"synchronization_interval":     types.Int64Type,
+	"use_enable_dns_reports_sync":  types.BoolType,
+	"use_login":                    types.BoolType,
+	"use_synchronization_interval": types.BoolType,
+}
+
+var MsserverDnsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"address": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The address or FQDN of the DNS Microsoft Server.",
+	},
+	"enable_dns_reports_sync": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines if synchronization of DNS reporting data from the Microsoft server is enabled or not.",
+	},
+	"login_name": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The login name of the DNS Microsoft Server.",
+	},
+	"login_password": schema.StringAttribute{
+		Optional:            true,
+		Sensitive:           true,
+		MarkdownDescription: "The login password of the DNS Microsoft Server.",
+	},
+	"synchronization_interval": schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The minimum number of minutes between two synchronizations.",
+	},
+	"use_enable_dns_reports_sync": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Use flag for: enable_dns_reports_sync",
+	},
+	"use_login": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Use flag for: login_name , login_password",
+	},
+	"use_synchronization_interval": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Use flag for: synchronization_interval",
+	},
+}
+
+func (m *MsserverDnsModel) Expand(ctx context.Context, diags *diag.Diagnostics) *microsoft.MsserverDns {
+	if m == nil {
+		return nil
+	}
+	to := &microsoft.MsserverDns{
+		EnableDnsReportsSync:       flex.ExpandBoolPointer(m.EnableDnsReportsSync),
+		LoginName:                  flex.ExpandStringPointer(m.LoginName),
+		LoginPassword:              flex.ExpandStringPointer(m.LoginPassword),
+		SynchronizationInterval:    flex.ExpandInt64Pointer(m.SynchronizationInterval),
+		UseEnableDnsReportsSync:    flex.ExpandBoolPointer(m.UseEnableDnsReportsSync),
+		UseLogin:                   flex.ExpandBoolPointer(m.UseLogin),
+		UseSynchronizationInterval: flex.ExpandBoolPointer(m.UseSynchronizationInterval),
+	}
+	return to
+}
+
+func FlattenMsserverDns(ctx context.Context, from *microsoft.MsserverDns, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(MsserverDnsAttrTypes)
+	}
+	m := MsserverDnsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, MsserverDnsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *MsserverDnsModel) Flatten(ctx context.Context, from *microsoft.MsserverDns, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = MsserverDnsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Address = flex.FlattenStringPointer(from.Address)
+	m.EnableDnsReportsSync = types.BoolPointerValue(from.EnableDnsReportsSync)
+	m.LoginName = flex.FlattenStringPointer(from.LoginName)
+	m.SynchronizationInterval = flex.FlattenInt64Pointer(from.SynchronizationInterval)
+	m.UseEnableDnsReportsSync = types.BoolPointerValue(from.UseEnableDnsReportsSync)
+	m.UseLogin = types.BoolPointerValue(from.UseLogin)
+	m.UseSynchronizationInterval = types.BoolPointerValue(from.UseSynchronizationInterval)
+}
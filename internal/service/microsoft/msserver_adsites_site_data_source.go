@@ -132,7 +132,7 @@ func (d *MsserverAdsitesSiteDataSource) Read(ctx context.Context, req datasource
 				ReturnFieldsPlus(readableAttributesForMsserverAdsitesSite).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
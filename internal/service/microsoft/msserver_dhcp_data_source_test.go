@@ -0,0 +1,69 @@
+package microsoft_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccMsserverDhcpDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_microsoft_msserver_dhcp.test"
+	resourceName := "nios_microsoft_msserver_dhcp.test"
+	var v microsoft.MsserverDhcp
+	address := "10.10.1.10"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMsserverDhcpDataSourceConfigFilters(address, loginName),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					}, testAccCheckMsserverDhcpResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckMsserverDhcpResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "address", dataSourceName, "result.0.address"),
+		resource.TestCheckResourceAttrPair(resourceName, "last_sync_ts", dataSourceName, "result.0.last_sync_ts"),
+		resource.TestCheckResourceAttrPair(resourceName, "status", dataSourceName, "result.0.status"),
+		resource.TestCheckResourceAttrPair(resourceName, "synchronization_interval", dataSourceName, "result.0.synchronization_interval"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_synchronization_interval", dataSourceName, "result.0.use_synchronization_interval"),
+	}
+}
+
+func testAccMsserverDhcpDataSourceConfigFilters(address, loginName string) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dhcp" "test" {
+  address                      = nios_microsoft_msserver.test.address
+  synchronization_interval     = 5
+  use_synchronization_interval = true
+}
+
+data "nios_microsoft_msserver_dhcp" "test" {
+  filters = {
+    address = nios_microsoft_msserver_dhcp.test.address
+  }
+}
+`, address, loginName)
+}
@@ -0,0 +1,177 @@
+package microsoft_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMsserverDhcp = "address,comment,dhcp_utilization,dhcp_utilization_status,dynamic_hosts,last_sync_ts,login_name,network_view,next_sync_control,read_only,server_name,static_hosts,status,status_detail,status_last_updated,supports_failover,synchronization_interval,total_hosts,use_login,use_synchronization_interval"
+
+func TestAccMsserverDhcpResource_basic(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test"
+	var v microsoft.MsserverDhcp
+	address := "10.10.1.1"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDhcpBasicConfig(address, loginName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address", address),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "read_only", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMsserverDhcpResource_SynchronizationInterval(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test_synchronization_interval"
+	var v microsoft.MsserverDhcp
+	address := "10.10.1.2"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDhcpSynchronizationInterval(address, loginName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "synchronization_interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "use_synchronization_interval", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMsserverDhcpSynchronizationInterval(address, loginName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "synchronization_interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "use_synchronization_interval", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMsserverDhcpResource_UseLogin(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dhcp.test_use_login"
+	var v microsoft.MsserverDhcp
+	address := "10.10.1.3"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDhcpUseLogin(address, loginName, "dhcp_admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "login_name", "dhcp_admin"),
+					resource.TestCheckResourceAttr(resourceName, "use_login", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMsserverDhcpUseLogin(address, loginName, "dhcp_operator"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDhcpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "login_name", "dhcp_operator"),
+					resource.TestCheckResourceAttr(resourceName, "use_login", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckMsserverDhcpExists(ctx context.Context, resourceName string, v *microsoft.MsserverDhcp) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.MicrosoftAPI.
+			MsserverDhcpAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForMsserverDhcp).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetMsserverDhcpResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetMsserverDhcpResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccMsserverDhcpBasicConfig(address, loginName string) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dhcp" "test" {
+  address = nios_microsoft_msserver.test.address
+}
+`, address, loginName)
+}
+
+func testAccMsserverDhcpSynchronizationInterval(address, loginName string, synchronizationInterval int) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dhcp" "test_synchronization_interval" {
+  address                      = nios_microsoft_msserver.test.address
+  synchronization_interval     = %d
+  use_synchronization_interval = true
+}
+`, address, loginName, synchronizationInterval)
+}
+
+func testAccMsserverDhcpUseLogin(address, loginName, dhcpLoginName string) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dhcp" "test_use_login" {
+  address        = nios_microsoft_msserver.test.address
+  login_name     = %q
+  login_password = "Example-Passw0rd"
+  use_login      = true
+}
+`, address, loginName, dhcpLoginName)
+}
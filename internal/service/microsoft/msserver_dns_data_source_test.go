@@ -0,0 +1,68 @@
+package microsoft_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccMsserverDnsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_microsoft_msserver_dns.test"
+	resourceName := "nios_microsoft_msserver_dns.test"
+	var v microsoft.MsserverDns
+	address := "10.10.2.10"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMsserverDnsDataSourceConfigFilters(address, loginName),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckMsserverDnsExists(context.Background(), resourceName, &v),
+					}, testAccCheckMsserverDnsResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckMsserverDnsResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "address", dataSourceName, "result.0.address"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_dns_reports_sync", dataSourceName, "result.0.enable_dns_reports_sync"),
+		resource.TestCheckResourceAttrPair(resourceName, "synchronization_interval", dataSourceName, "result.0.synchronization_interval"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_synchronization_interval", dataSourceName, "result.0.use_synchronization_interval"),
+	}
+}
+
+func testAccMsserverDnsDataSourceConfigFilters(address, loginName string) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dns" "test" {
+  address                      = nios_microsoft_msserver.test.address
+  synchronization_interval     = 5
+  use_synchronization_interval = true
+}
+
+data "nios_microsoft_msserver_dns" "test" {
+  filters = {
+    address = nios_microsoft_msserver_dns.test.address
+  }
+}
+`, address, loginName)
+}
@@ -0,0 +1,175 @@
+package microsoft_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForMsserverDns = "address,enable_dns_reports_sync,login_name,synchronization_interval,use_enable_dns_reports_sync,use_login,use_synchronization_interval"
+
+func TestAccMsserverDnsResource_basic(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dns.test"
+	var v microsoft.MsserverDns
+	address := "10.10.2.1"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDnsBasicConfig(address, loginName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address", address),
+					resource.TestCheckResourceAttrSet(resourceName, "enable_dns_reports_sync"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMsserverDnsResource_EnableDnsReportsSync(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dns.test_enable_dns_reports_sync"
+	var v microsoft.MsserverDns
+	address := "10.10.2.2"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDnsEnableDnsReportsSync(address, loginName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_dns_reports_sync", "true"),
+					resource.TestCheckResourceAttr(resourceName, "use_enable_dns_reports_sync", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMsserverDnsEnableDnsReportsSync(address, loginName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_dns_reports_sync", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_enable_dns_reports_sync", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMsserverDnsResource_SynchronizationInterval(t *testing.T) {
+	var resourceName = "nios_microsoft_msserver_dns.test_synchronization_interval"
+	var v microsoft.MsserverDns
+	address := "10.10.2.3"
+	loginName := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccMsserverDnsSynchronizationInterval(address, loginName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "synchronization_interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "use_synchronization_interval", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccMsserverDnsSynchronizationInterval(address, loginName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMsserverDnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "synchronization_interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "use_synchronization_interval", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckMsserverDnsExists(ctx context.Context, resourceName string, v *microsoft.MsserverDns) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.MicrosoftAPI.
+			MsserverDnsAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForMsserverDns).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetMsserverDnsResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetMsserverDnsResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccMsserverDnsBasicConfig(address, loginName string) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dns" "test" {
+  address = nios_microsoft_msserver.test.address
+}
+`, address, loginName)
+}
+
+func testAccMsserverDnsEnableDnsReportsSync(address, loginName string, enableDnsReportsSync bool) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dns" "test_enable_dns_reports_sync" {
+  address                     = nios_microsoft_msserver.test.address
+  enable_dns_reports_sync     = %t
+  use_enable_dns_reports_sync = true
+}
+`, address, loginName, enableDnsReportsSync)
+}
+
+func testAccMsserverDnsSynchronizationInterval(address, loginName string, synchronizationInterval int) string {
+	return fmt.Sprintf(`
+resource "nios_microsoft_msserver" "test" {
+  address    = %q
+  login_name = %q
+}
+
+resource "nios_microsoft_msserver_dns" "test_synchronization_interval" {
+  address                      = nios_microsoft_msserver.test.address
+  synchronization_interval     = %d
+  use_synchronization_interval = true
+}
+`, address, loginName, synchronizationInterval)
+}
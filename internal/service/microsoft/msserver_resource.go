@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -36,6 +37,12 @@ type MsserverResource struct {
 	client *niosclient.APIClient
 }
 
+// MsserverResourceModel describes the resource data model, extending MsserverModel with the operation timeouts.
+type MsserverResourceModel struct {
+	MsserverModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *MsserverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "microsoft_msserver"
 }
@@ -44,6 +51,7 @@ func (r *MsserverResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Microsoft Server.",
 		Attributes:          MsserverResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +76,7 @@ func (r *MsserverResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *MsserverResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data MsserverModel
+	var data MsserverResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -168,7 +176,7 @@ func validateSubConfig(
 
 func (r *MsserverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data MsserverModel
+	var data MsserverResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -191,7 +199,7 @@ func (r *MsserverResource) Create(ctx context.Context, req resource.CreateReques
 
 	var apiRes *microsoft.CreateMsserverResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -239,7 +247,7 @@ func (r *MsserverResource) Create(ctx context.Context, req resource.CreateReques
 
 func (r *MsserverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data MsserverModel
+	var data MsserverResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -261,14 +269,14 @@ func (r *MsserverResource) Read(ctx context.Context, req resource.ReadRequest, r
 		apiRes  *microsoft.GetMsserverResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
 			MsserverAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForMsserver).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -324,7 +332,7 @@ func (r *MsserverResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *MsserverResource) ReadByExtAttrs(ctx context.Context, data *MsserverModel, resp *resource.ReadResponse) bool {
+func (r *MsserverResource) ReadByExtAttrs(ctx context.Context, data *MsserverResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -351,7 +359,7 @@ func (r *MsserverResource) ReadByExtAttrs(ctx context.Context, data *MsserverMod
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForMsserver).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Msserver by extattrs, got error: %s", err))
@@ -382,7 +390,7 @@ func (r *MsserverResource) ReadByExtAttrs(ctx context.Context, data *MsserverMod
 
 func (r *MsserverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data MsserverModel
+	var data MsserverResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -433,7 +441,7 @@ func (r *MsserverResource) Update(ctx context.Context, req resource.UpdateReques
 
 	var apiRes *microsoft.UpdateMsserverResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -476,7 +484,7 @@ func (r *MsserverResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *MsserverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data MsserverModel
+	var data MsserverResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -487,7 +495,7 @@ func (r *MsserverResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.MicrosoftAPI.
 			MsserverAPI.
 			Delete(ctx, resourceRef).
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/microsoft"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type MssuperscopeResource struct {
 	client *niosclient.APIClient
 }
 
+// MssuperscopeResourceModel describes the resource data model, extending MssuperscopeModel with the operation timeouts.
+type MssuperscopeResourceModel struct {
+	MssuperscopeModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *MssuperscopeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "microsoft_mssuperscope"
 }
@@ -41,6 +49,7 @@ func (r *MssuperscopeResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Microsoft Super Scope.",
 		Attributes:          MssuperscopeResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *MssuperscopeResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *MssuperscopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data MssuperscopeModel
+	var data MssuperscopeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *MssuperscopeResource) Create(ctx context.Context, req resource.CreateRe
 
 	var apiRes *microsoft.CreateMssuperscopeResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *MssuperscopeResource) Create(ctx context.Context, req resource.CreateRe
 
 func (r *MssuperscopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data MssuperscopeModel
+	var data MssuperscopeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *MssuperscopeResource) Read(ctx context.Context, req resource.ReadReques
 		apiRes  *microsoft.GetMssuperscopeResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.MicrosoftAPI.
 			MssuperscopeAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForMssuperscope).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *MssuperscopeResource) Read(ctx context.Context, req resource.ReadReques
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *MssuperscopeResource) ReadByExtAttrs(ctx context.Context, data *MssuperscopeModel, resp *resource.ReadResponse) bool {
+func (r *MssuperscopeResource) ReadByExtAttrs(ctx context.Context, data *MssuperscopeResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +258,7 @@ func (r *MssuperscopeResource) ReadByExtAttrs(ctx context.Context, data *Mssuper
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForMssuperscope).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Mssuperscope by extattrs, got error: %s", err))
@@ -281,7 +290,7 @@ func (r *MssuperscopeResource) ReadByExtAttrs(ctx context.Context, data *Mssuper
 
 func (r *MssuperscopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data MssuperscopeModel
+	var data MssuperscopeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -332,7 +341,7 @@ func (r *MssuperscopeResource) Update(ctx context.Context, req resource.UpdateRe
 
 	var apiRes *microsoft.UpdateMssuperscopeResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -375,7 +384,7 @@ func (r *MssuperscopeResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *MssuperscopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data MssuperscopeModel
+	var data MssuperscopeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -386,7 +395,7 @@ func (r *MssuperscopeResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.MicrosoftAPI.
 			MssuperscopeAPI.
 			Delete(ctx, resourceRef).
@@ -15,6 +15,7 @@ import (
 	"github.com/infobloxopen/infoblox-nios-go-client/misc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type DxlEndpointResource struct {
 	client *niosclient.APIClient
 }
 
+// DxlEndpointResourceModel describes the resource data model, extending DxlEndpointModel with the operation timeouts.
+type DxlEndpointResourceModel struct {
+	DxlEndpointModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *DxlEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "misc_dxl_endpoint"
 }
@@ -42,6 +49,7 @@ func (r *DxlEndpointResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a DXL Endpoint.",
 		Attributes:          DxlEndpointResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +75,7 @@ func (r *DxlEndpointResource) Configure(ctx context.Context, req resource.Config
 
 func (r *DxlEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data DxlEndpointModel
+	var data DxlEndpointResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -98,7 +106,7 @@ func (r *DxlEndpointResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *misc.CreateDxlEndpointResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -146,7 +154,7 @@ func (r *DxlEndpointResource) Create(ctx context.Context, req resource.CreateReq
 
 func (r *DxlEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data DxlEndpointModel
+	var data DxlEndpointResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -168,7 +176,7 @@ func (r *DxlEndpointResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *misc.GetDxlEndpointResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.MiscAPI.
 			DxlEndpointAPI.
@@ -230,7 +238,7 @@ func (r *DxlEndpointResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *DxlEndpointResource) ReadByExtAttrs(ctx context.Context, data *DxlEndpointModel, resp *resource.ReadResponse) bool {
+func (r *DxlEndpointResource) ReadByExtAttrs(ctx context.Context, data *DxlEndpointResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -288,7 +296,7 @@ func (r *DxlEndpointResource) ReadByExtAttrs(ctx context.Context, data *DxlEndpo
 
 func (r *DxlEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data DxlEndpointModel
+	var data DxlEndpointResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -347,7 +355,7 @@ func (r *DxlEndpointResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *misc.UpdateDxlEndpointResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -390,7 +398,7 @@ func (r *DxlEndpointResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *DxlEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data DxlEndpointModel
+	var data DxlEndpointResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -401,7 +409,7 @@ func (r *DxlEndpointResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.MiscAPI.
 			DxlEndpointAPI.
 			Delete(ctx, resourceRef).
@@ -423,7 +431,7 @@ func (r *DxlEndpointResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *DxlEndpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data DxlEndpointModel
+	var data DxlEndpointResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -494,7 +502,7 @@ func (r *DxlEndpointResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "associate_internal_id", []byte("true"))...)
 }
 
-func (r *DxlEndpointResource) processClientCertificate(ctx context.Context, data *DxlEndpointModel, diag *diag.Diagnostics) bool {
+func (r *DxlEndpointResource) processClientCertificate(ctx context.Context, data *DxlEndpointResourceModel, diag *diag.Diagnostics) bool {
 	return r.processFileUpload(
 		ctx,
 		data.ClientCertificateFile,
@@ -504,7 +512,7 @@ func (r *DxlEndpointResource) processClientCertificate(ctx context.Context, data
 	)
 }
 
-func (r *DxlEndpointResource) processBrokersImportFile(ctx context.Context, data *DxlEndpointModel, diag *diag.Diagnostics) bool {
+func (r *DxlEndpointResource) processBrokersImportFile(ctx context.Context, data *DxlEndpointResourceModel, diag *diag.Diagnostics) bool {
 	return r.processFileUpload(
 		ctx,
 		data.BrokersImportFile,
@@ -526,12 +534,10 @@ func (r *DxlEndpointResource) processFileUpload(
 		return true
 	}
 
-	baseUrl := r.client.SecurityAPI.Cfg.NIOSHostURL
-	username := r.client.SecurityAPI.Cfg.NIOSUsername
-	password := r.client.SecurityAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.SecurityAPI.Cfg)
 
 	filePath := filePathAttr.ValueString()
-	token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+	token, err := utils.UploadFileWithToken(ctx, r.client.SecurityAPI.Cfg.HTTPClient, baseUrl, filePath)
 	if err != nil {
 		diag.AddError(
 			"Client Error",
@@ -16,6 +16,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +36,12 @@ type SyslogEndpointResource struct {
 	client *niosclient.APIClient
 }
 
+// SyslogEndpointResourceModel describes the resource data model, extending SyslogEndpointModel with the operation timeouts.
+type SyslogEndpointResourceModel struct {
+	SyslogEndpointModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SyslogEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "misc_syslog_endpoint"
 }
@@ -43,6 +50,7 @@ func (r *SyslogEndpointResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Syslog Endpoint.",
 		Attributes:          SyslogEndpointResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +76,7 @@ func (r *SyslogEndpointResource) Configure(ctx context.Context, req resource.Con
 
 func (r *SyslogEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SyslogEndpointModel
+	var data SyslogEndpointResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -94,7 +102,7 @@ func (r *SyslogEndpointResource) Create(ctx context.Context, req resource.Create
 
 	var apiRes *misc.CreateSyslogEndpointResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -142,7 +150,7 @@ func (r *SyslogEndpointResource) Create(ctx context.Context, req resource.Create
 
 func (r *SyslogEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SyslogEndpointModel
+	var data SyslogEndpointResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -164,14 +172,14 @@ func (r *SyslogEndpointResource) Read(ctx context.Context, req resource.ReadRequ
 		apiRes  *misc.GetSyslogEndpointResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.MiscAPI.
 			SyslogEndpointAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSyslogEndpoint).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -227,7 +235,7 @@ func (r *SyslogEndpointResource) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *SyslogEndpointResource) ReadByExtAttrs(ctx context.Context, data *SyslogEndpointModel, resp *resource.ReadResponse) bool {
+func (r *SyslogEndpointResource) ReadByExtAttrs(ctx context.Context, data *SyslogEndpointResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -254,7 +262,7 @@ func (r *SyslogEndpointResource) ReadByExtAttrs(ctx context.Context, data *Syslo
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForSyslogEndpoint).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SyslogEndpoint by extattrs, got error: %s", err))
@@ -285,7 +293,7 @@ func (r *SyslogEndpointResource) ReadByExtAttrs(ctx context.Context, data *Syslo
 
 func (r *SyslogEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SyslogEndpointModel
+	var data SyslogEndpointResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -340,7 +348,7 @@ func (r *SyslogEndpointResource) Update(ctx context.Context, req resource.Update
 
 	var apiRes *misc.UpdateSyslogEndpointResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -383,7 +391,7 @@ func (r *SyslogEndpointResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *SyslogEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SyslogEndpointModel
+	var data SyslogEndpointResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -394,7 +402,7 @@ func (r *SyslogEndpointResource) Delete(ctx context.Context, req resource.Delete
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.MiscAPI.
 			SyslogEndpointAPI.
 			Delete(ctx, resourceRef).
@@ -420,11 +428,9 @@ func (r *SyslogEndpointResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "associate_internal_id", []byte("true"))...)
 }
 
-func (r *SyslogEndpointResource) processCertificatePath(ctx context.Context, data *SyslogEndpointModel, diags *diag.Diagnostics) bool {
+func (r *SyslogEndpointResource) processCertificatePath(ctx context.Context, data *SyslogEndpointResourceModel, diags *diag.Diagnostics) bool {
 	// Get connection details from client configuration
-	baseUrl := r.client.MiscAPI.Cfg.NIOSHostURL
-	username := r.client.MiscAPI.Cfg.NIOSUsername
-	password := r.client.MiscAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.MiscAPI.Cfg)
 
 	var syslogServers []SyslogEndpointSyslogServersModel
 	diagResult := data.SyslogServers.ElementsAs(ctx, &syslogServers, false)
@@ -438,7 +444,7 @@ func (r *SyslogEndpointResource) processCertificatePath(ctx context.Context, dat
 		if !server.CertificateFilePath.IsNull() && server.ConnectionType.ValueString() == "stcp" {
 			certificate := server.CertificateFilePath.ValueString()
 			if certificate != "" {
-				token, err := utils.UploadFileWithToken(ctx, baseUrl, certificate, username, password)
+				token, err := utils.UploadFileWithToken(ctx, r.client.MiscAPI.Cfg.HTTPClient, baseUrl, certificate)
 				if err != nil {
 					diags.AddError("Certificate Upload Error", fmt.Sprintf("Unable to upload certificate for Syslog Server, got error: %s", err))
 					return false
@@ -458,7 +464,7 @@ func (r *SyslogEndpointResource) processCertificatePath(ctx context.Context, dat
 }
 
 func (r *SyslogEndpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data SyslogEndpointModel
+	var data SyslogEndpointResourceModel
 
 	// Read Terraform plan data into the model
 
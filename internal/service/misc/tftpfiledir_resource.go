@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/misc"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type TftpfiledirResource struct {
 	client *niosclient.APIClient
 }
 
+// TftpfiledirResourceModel describes the resource data model, extending TftpfiledirModel with the operation timeouts.
+type TftpfiledirResourceModel struct {
+	TftpfiledirModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *TftpfiledirResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "misc_tftpfiledir"
 }
@@ -43,6 +51,7 @@ func (r *TftpfiledirResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a TFTP file/directory object.",
 		Attributes:          TftpfiledirResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *TftpfiledirResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *TftpfiledirResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data TftpfiledirModel
+	var data TftpfiledirResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -83,7 +92,7 @@ func (r *TftpfiledirResource) Create(ctx context.Context, req resource.CreateReq
 
 	var apiRes *misc.CreateTftpfiledirResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -144,7 +153,7 @@ func (r *TftpfiledirResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *TftpfiledirResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data TftpfiledirModel
+	var data TftpfiledirResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -160,7 +169,7 @@ func (r *TftpfiledirResource) Read(ctx context.Context, req resource.ReadRequest
 		apiRes  *misc.GetTftpfiledirResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 
 		apiRes, httpRes, callErr = r.client.MiscAPI.
@@ -168,7 +177,7 @@ func (r *TftpfiledirResource) Read(ctx context.Context, req resource.ReadRequest
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForTftpfiledir).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -198,7 +207,7 @@ func (r *TftpfiledirResource) Read(ctx context.Context, req resource.ReadRequest
 
 func (r *TftpfiledirResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data TftpfiledirModel
+	var data TftpfiledirResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -222,7 +231,7 @@ func (r *TftpfiledirResource) Update(ctx context.Context, req resource.UpdateReq
 
 	var apiRes *misc.UpdateTftpfiledirResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -255,7 +264,7 @@ func (r *TftpfiledirResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *TftpfiledirResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data TftpfiledirModel
+	var data TftpfiledirResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -266,7 +275,7 @@ func (r *TftpfiledirResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.MiscAPI.
 			TftpfiledirAPI.
 			Delete(ctx, resourceRef).
@@ -288,7 +297,7 @@ func (r *TftpfiledirResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *TftpfiledirResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data TftpfiledirModel
+	var data TftpfiledirResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
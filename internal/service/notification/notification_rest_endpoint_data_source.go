@@ -140,7 +140,7 @@ func (d *NotificationRestEndpointDataSource) Read(ctx context.Context, req datas
 				ReturnFieldsPlus(readableAttributesForNotificationRestEndpoint).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -38,6 +39,12 @@ type NotificationRestEndpointResource struct {
 	client *niosclient.APIClient
 }
 
+// NotificationRestEndpointResourceModel describes the resource data model, extending NotificationRestEndpointModel with the operation timeouts.
+type NotificationRestEndpointResourceModel struct {
+	NotificationRestEndpointModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NotificationRestEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "notification_rest_endpoint"
 }
@@ -46,6 +53,7 @@ func (r *NotificationRestEndpointResource) Schema(ctx context.Context, req resou
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Notification REST Endpoint.",
 		Attributes:          NotificationRestEndpointResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -70,7 +78,7 @@ func (r *NotificationRestEndpointResource) Configure(ctx context.Context, req re
 }
 
 func (r *NotificationRestEndpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data NotificationRestEndpointModel
+	var data NotificationRestEndpointResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -157,7 +165,7 @@ func (r *NotificationRestEndpointResource) ValidateConfig(ctx context.Context, r
 
 func (r *NotificationRestEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data NotificationRestEndpointModel
+	var data NotificationRestEndpointResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -183,7 +191,7 @@ func (r *NotificationRestEndpointResource) Create(ctx context.Context, req resou
 
 	var apiRes *notification.CreateNotificationRestEndpointResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -230,7 +238,7 @@ func (r *NotificationRestEndpointResource) Create(ctx context.Context, req resou
 
 func (r *NotificationRestEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data NotificationRestEndpointModel
+	var data NotificationRestEndpointResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -252,14 +260,14 @@ func (r *NotificationRestEndpointResource) Read(ctx context.Context, req resourc
 		apiRes  *notification.GetNotificationRestEndpointResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.NotificationAPI.
 			NotificationRestEndpointAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNotificationRestEndpoint).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -314,7 +322,7 @@ func (r *NotificationRestEndpointResource) Read(ctx context.Context, req resourc
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NotificationRestEndpointResource) ReadByExtAttrs(ctx context.Context, data *NotificationRestEndpointModel, resp *resource.ReadResponse) bool {
+func (r *NotificationRestEndpointResource) ReadByExtAttrs(ctx context.Context, data *NotificationRestEndpointResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -341,7 +349,7 @@ func (r *NotificationRestEndpointResource) ReadByExtAttrs(ctx context.Context, d
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForNotificationRestEndpoint).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NotificationRestEndpoint by extattrs, got error: %s", err))
@@ -372,7 +380,7 @@ func (r *NotificationRestEndpointResource) ReadByExtAttrs(ctx context.Context, d
 
 func (r *NotificationRestEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NotificationRestEndpointModel
+	var data NotificationRestEndpointResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -424,7 +432,7 @@ func (r *NotificationRestEndpointResource) Update(ctx context.Context, req resou
 
 	var apiRes *notification.UpdateNotificationRestEndpointResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -467,7 +475,7 @@ func (r *NotificationRestEndpointResource) Update(ctx context.Context, req resou
 }
 
 func (r *NotificationRestEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NotificationRestEndpointModel
+	var data NotificationRestEndpointResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -478,7 +486,7 @@ func (r *NotificationRestEndpointResource) Delete(ctx context.Context, req resou
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.NotificationAPI.
 			NotificationRestEndpointAPI.
 			Delete(ctx, resourceRef).
@@ -506,7 +514,7 @@ func (r *NotificationRestEndpointResource) ImportState(ctx context.Context, req
 
 func (r *NotificationRestEndpointResource) processClientCertificate(
 	ctx context.Context,
-	data *NotificationRestEndpointModel,
+	data *NotificationRestEndpointResourceModel,
 	diag *diag.Diagnostics,
 ) bool {
 
@@ -514,12 +522,10 @@ func (r *NotificationRestEndpointResource) processClientCertificate(
 		return true
 	}
 
-	baseUrl := r.client.SecurityAPI.Cfg.NIOSHostURL
-	username := r.client.SecurityAPI.Cfg.NIOSUsername
-	password := r.client.SecurityAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.SecurityAPI.Cfg)
 
 	filePath := data.ClientCertificateFile.ValueString()
-	token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+	token, err := utils.UploadFileWithToken(ctx, r.client.SecurityAPI.Cfg.HTTPClient, baseUrl, filePath)
 	if err != nil {
 		diag.AddError(
 			"Client Error",
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/notification"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type NotificationRuleResource struct {
 	client *niosclient.APIClient
 }
 
+// NotificationRuleResourceModel describes the resource data model, extending NotificationRuleModel with the operation timeouts.
+type NotificationRuleResourceModel struct {
+	NotificationRuleModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *NotificationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "notification_rule"
 }
@@ -42,6 +50,7 @@ func (r *NotificationRuleResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Notification Rule.",
 		Attributes:          NotificationRuleResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *NotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data NotificationRuleModel
+	var data NotificationRuleResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -82,7 +91,7 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 
 	var apiRes *notification.CreateNotificationRuleResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -123,7 +132,7 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data NotificationRuleModel
+	var data NotificationRuleResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -139,14 +148,14 @@ func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		apiRes  *notification.GetNotificationRuleResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.NotificationAPI.
 			NotificationRuleAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForNotificationRule).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -176,7 +185,7 @@ func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 
 func (r *NotificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data NotificationRuleModel
+	var data NotificationRuleResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -200,7 +209,7 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 
 	var apiRes *notification.UpdateNotificationRuleResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -233,7 +242,7 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NotificationRuleModel
+	var data NotificationRuleResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -244,7 +253,7 @@ func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.Dele
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.NotificationAPI.
 			NotificationRuleAPI.
 			Delete(ctx, resourceRef).
@@ -266,7 +275,7 @@ func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data NotificationRuleModel
+	var data NotificationRuleResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
@@ -132,7 +132,7 @@ func (d *ParentalcontrolAvpDataSource) Read(ctx context.Context, req datasource.
 				ReturnFieldsPlus(readableAttributesForParentalcontrolAvp).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/parentalcontrol"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type ParentalcontrolAvpResource struct {
 	client *niosclient.APIClient
 }
 
+// ParentalcontrolAvpResourceModel describes the resource data model, extending ParentalcontrolAvpModel with the operation timeouts.
+type ParentalcontrolAvpResourceModel struct {
+	ParentalcontrolAvpModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *ParentalcontrolAvpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "parentalcontrol_avp"
 }
@@ -42,6 +50,7 @@ func (r *ParentalcontrolAvpResource) Schema(ctx context.Context, req resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a parental control AVP.",
 		Attributes:          ParentalcontrolAvpResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *ParentalcontrolAvpResource) Configure(ctx context.Context, req resource
 }
 
 func (r *ParentalcontrolAvpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data ParentalcontrolAvpModel
+	var data ParentalcontrolAvpResourceModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -99,7 +108,7 @@ func (r *ParentalcontrolAvpResource) ValidateConfig(ctx context.Context, req res
 }
 
 func (r *ParentalcontrolAvpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ParentalcontrolAvpModel
+	var data ParentalcontrolAvpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -115,7 +124,7 @@ func (r *ParentalcontrolAvpResource) Create(ctx context.Context, req resource.Cr
 
 	var apiRes *parentalcontrol.CreateParentalcontrolAvpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -156,7 +165,7 @@ func (r *ParentalcontrolAvpResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *ParentalcontrolAvpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ParentalcontrolAvpModel
+	var data ParentalcontrolAvpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -172,14 +181,14 @@ func (r *ParentalcontrolAvpResource) Read(ctx context.Context, req resource.Read
 		apiRes  *parentalcontrol.GetParentalcontrolAvpResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.ParentalControlAPI.
 			ParentalcontrolAvpAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForParentalcontrolAvp).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -209,7 +218,7 @@ func (r *ParentalcontrolAvpResource) Read(ctx context.Context, req resource.Read
 
 func (r *ParentalcontrolAvpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data ParentalcontrolAvpModel
+	var data ParentalcontrolAvpResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -233,7 +242,7 @@ func (r *ParentalcontrolAvpResource) Update(ctx context.Context, req resource.Up
 
 	var apiRes *parentalcontrol.UpdateParentalcontrolAvpResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -266,7 +275,7 @@ func (r *ParentalcontrolAvpResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *ParentalcontrolAvpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ParentalcontrolAvpModel
+	var data ParentalcontrolAvpResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -277,7 +286,7 @@ func (r *ParentalcontrolAvpResource) Delete(ctx context.Context, req resource.De
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.ParentalControlAPI.
 			ParentalcontrolAvpAPI.
 			Delete(ctx, resourceRef).
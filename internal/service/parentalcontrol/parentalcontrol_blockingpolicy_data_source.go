@@ -132,7 +132,7 @@ func (d *ParentalcontrolBlockingpolicyDataSource) Read(ctx context.Context, req
 				ReturnFieldsPlus(readableAttributesForParentalcontrolBlockingpolicy).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
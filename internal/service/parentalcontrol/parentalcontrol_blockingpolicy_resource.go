@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/parentalcontrol"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type ParentalcontrolBlockingpolicyResource struct {
 	client *niosclient.APIClient
 }
 
+// ParentalcontrolBlockingpolicyResourceModel describes the resource data model, extending ParentalcontrolBlockingpolicyModel with the operation timeouts.
+type ParentalcontrolBlockingpolicyResourceModel struct {
+	ParentalcontrolBlockingpolicyModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *ParentalcontrolBlockingpolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "parentalcontrol_blockingpolicy"
 }
@@ -41,6 +49,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Schema(ctx context.Context, req
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Parental Control Blocking Policy.",
 		Attributes:          ParentalcontrolBlockingpolicyResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -65,7 +74,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Configure(ctx context.Context, r
 }
 
 func (r *ParentalcontrolBlockingpolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ParentalcontrolBlockingpolicyModel
+	var data ParentalcontrolBlockingpolicyResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -81,7 +90,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Create(ctx context.Context, req
 
 	var apiRes *parentalcontrol.CreateParentalcontrolBlockingpolicyResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -122,7 +131,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Create(ctx context.Context, req
 }
 
 func (r *ParentalcontrolBlockingpolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ParentalcontrolBlockingpolicyModel
+	var data ParentalcontrolBlockingpolicyResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -138,14 +147,14 @@ func (r *ParentalcontrolBlockingpolicyResource) Read(ctx context.Context, req re
 		apiRes  *parentalcontrol.GetParentalcontrolBlockingpolicyResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.ParentalControlAPI.
 			ParentalcontrolBlockingpolicyAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForParentalcontrolBlockingpolicy).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -175,7 +184,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Read(ctx context.Context, req re
 
 func (r *ParentalcontrolBlockingpolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data ParentalcontrolBlockingpolicyModel
+	var data ParentalcontrolBlockingpolicyResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -199,7 +208,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Update(ctx context.Context, req
 
 	var apiRes *parentalcontrol.UpdateParentalcontrolBlockingpolicyResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -232,7 +241,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Update(ctx context.Context, req
 }
 
 func (r *ParentalcontrolBlockingpolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ParentalcontrolBlockingpolicyModel
+	var data ParentalcontrolBlockingpolicyResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -243,7 +252,7 @@ func (r *ParentalcontrolBlockingpolicyResource) Delete(ctx context.Context, req
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.ParentalControlAPI.
 			ParentalcontrolBlockingpolicyAPI.
 			Delete(ctx, resourceRef).
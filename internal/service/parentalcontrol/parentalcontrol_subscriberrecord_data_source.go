@@ -132,7 +132,7 @@ func (d *ParentalcontrolSubscriberrecordDataSource) Read(ctx context.Context, re
 				ReturnFieldsPlus(readableAttributesForParentalcontrolSubscriberrecord).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/parentalcontrol"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type ParentalcontrolSubscriberrecordResource struct {
 	client *niosclient.APIClient
 }
 
+// ParentalcontrolSubscriberrecordResourceModel describes the resource data model, extending ParentalcontrolSubscriberrecordModel with the operation timeouts.
+type ParentalcontrolSubscriberrecordResourceModel struct {
+	ParentalcontrolSubscriberrecordModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *ParentalcontrolSubscriberrecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "parentalcontrol_subscriberrecord"
 }
@@ -41,6 +49,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Schema(ctx context.Context, re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Parental Control Subscriber Record.",
 		Attributes:          ParentalcontrolSubscriberrecordResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Configure(ctx context.Context,
 
 func (r *ParentalcontrolSubscriberrecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 
-	var data ParentalcontrolSubscriberrecordModel
+	var data ParentalcontrolSubscriberrecordResourceModel
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -86,7 +95,7 @@ func (r *ParentalcontrolSubscriberrecordResource) ValidateConfig(ctx context.Con
 }
 
 func (r *ParentalcontrolSubscriberrecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ParentalcontrolSubscriberrecordModel
+	var data ParentalcontrolSubscriberrecordResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -102,7 +111,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Create(ctx context.Context, re
 
 	var apiRes *parentalcontrol.CreateParentalcontrolSubscriberrecordResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -143,7 +152,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Create(ctx context.Context, re
 }
 
 func (r *ParentalcontrolSubscriberrecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data ParentalcontrolSubscriberrecordModel
+	var data ParentalcontrolSubscriberrecordResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *ParentalcontrolSubscriberrecordResource) Read(ctx context.Context, req
 		apiRes  *parentalcontrol.GetParentalcontrolSubscriberrecordResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.ParentalControlAPI.
 			ParentalcontrolSubscriberrecordAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForParentalcontrolSubscriberrecord).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -196,7 +205,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Read(ctx context.Context, req
 
 func (r *ParentalcontrolSubscriberrecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data ParentalcontrolSubscriberrecordModel
+	var data ParentalcontrolSubscriberrecordResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -220,7 +229,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Update(ctx context.Context, re
 
 	var apiRes *parentalcontrol.UpdateParentalcontrolSubscriberrecordResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -253,7 +262,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Update(ctx context.Context, re
 }
 
 func (r *ParentalcontrolSubscriberrecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ParentalcontrolSubscriberrecordModel
+	var data ParentalcontrolSubscriberrecordResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -264,7 +273,7 @@ func (r *ParentalcontrolSubscriberrecordResource) Delete(ctx context.Context, re
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.ParentalControlAPI.
 			ParentalcontrolSubscriberrecordAPI.
 			Delete(ctx, resourceRef).
@@ -139,7 +139,7 @@ func (d *ParentalcontrolSubscribersiteDataSource) Read(ctx context.Context, req
 				ReturnFieldsPlus(readableAttributesForParentalcontrolSubscribersite).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/parentalcontrol"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type ParentalcontrolSubscribersiteResource struct {
 	client *niosclient.APIClient
 }
 
+// ParentalcontrolSubscribersiteResourceModel describes the resource data model, extending ParentalcontrolSubscribersiteModel with the operation timeouts.
+type ParentalcontrolSubscribersiteResourceModel struct {
+	ParentalcontrolSubscribersiteModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *ParentalcontrolSubscribersiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "parentalcontrol_subscribersite"
 }
@@ -41,6 +49,7 @@ func (r *ParentalcontrolSubscribersiteResource) Schema(ctx context.Context, req
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Parental Control Subscribe Site.",
 		Attributes:          ParentalcontrolSubscribersiteResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *ParentalcontrolSubscribersiteResource) Configure(ctx context.Context, r
 
 func (r *ParentalcontrolSubscribersiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data ParentalcontrolSubscribersiteModel
+	var data ParentalcontrolSubscribersiteResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *ParentalcontrolSubscribersiteResource) Create(ctx context.Context, req
 
 	var apiRes *parentalcontrol.CreateParentalcontrolSubscribersiteResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *ParentalcontrolSubscribersiteResource) Create(ctx context.Context, req
 
 func (r *ParentalcontrolSubscribersiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data ParentalcontrolSubscribersiteModel
+	var data ParentalcontrolSubscribersiteResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *ParentalcontrolSubscribersiteResource) Read(ctx context.Context, req re
 		apiRes  *parentalcontrol.GetParentalcontrolSubscribersiteResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.ParentalControlAPI.
 			ParentalcontrolSubscribersiteAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForParentalcontrolSubscribersite).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *ParentalcontrolSubscribersiteResource) Read(ctx context.Context, req re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *ParentalcontrolSubscribersiteResource) ReadByExtAttrs(ctx context.Context, data *ParentalcontrolSubscribersiteModel, resp *resource.ReadResponse) bool {
+func (r *ParentalcontrolSubscribersiteResource) ReadByExtAttrs(ctx context.Context, data *ParentalcontrolSubscribersiteResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -248,7 +257,7 @@ func (r *ParentalcontrolSubscribersiteResource) ReadByExtAttrs(ctx context.Conte
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForParentalcontrolSubscribersite).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ParentalcontrolSubscribersite by extattrs, got error: %s", err))
@@ -279,7 +288,7 @@ func (r *ParentalcontrolSubscribersiteResource) ReadByExtAttrs(ctx context.Conte
 
 func (r *ParentalcontrolSubscribersiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data ParentalcontrolSubscribersiteModel
+	var data ParentalcontrolSubscribersiteResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -330,7 +339,7 @@ func (r *ParentalcontrolSubscribersiteResource) Update(ctx context.Context, req
 
 	var apiRes *parentalcontrol.UpdateParentalcontrolSubscribersiteResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -373,7 +382,7 @@ func (r *ParentalcontrolSubscribersiteResource) Update(ctx context.Context, req
 }
 
 func (r *ParentalcontrolSubscribersiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data ParentalcontrolSubscribersiteModel
+	var data ParentalcontrolSubscribersiteResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -384,7 +393,7 @@ func (r *ParentalcontrolSubscribersiteResource) Delete(ctx context.Context, req
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.ParentalControlAPI.
 			ParentalcontrolSubscribersiteAPI.
 			Delete(ctx, resourceRef).
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/rir"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -79,6 +81,12 @@ type RirOrganizationResource struct {
 	client *niosclient.APIClient
 }
 
+// RirOrganizationResourceModel describes the resource data model, extending RirOrganizationModel with the operation timeouts.
+type RirOrganizationResourceModel struct {
+	RirOrganizationModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RirOrganizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "rir_organization"
 }
@@ -87,6 +95,7 @@ func (r *RirOrganizationResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a RirOrganization resource object.",
 		Attributes:          RirOrganizationResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -111,7 +120,7 @@ func (r *RirOrganizationResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *RirOrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data RirOrganizationModel
+	var data RirOrganizationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -127,7 +136,7 @@ func (r *RirOrganizationResource) Create(ctx context.Context, req resource.Creat
 
 	var apiRes *rir.CreateRirOrganizationResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -168,7 +177,7 @@ func (r *RirOrganizationResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *RirOrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data RirOrganizationModel
+	var data RirOrganizationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -184,14 +193,14 @@ func (r *RirOrganizationResource) Read(ctx context.Context, req resource.ReadReq
 		apiRes  *rir.GetRirOrganizationResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.RIRAPI.
 			RirOrganizationAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRirOrganization).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -221,7 +230,7 @@ func (r *RirOrganizationResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *RirOrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RirOrganizationModel
+	var data RirOrganizationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -245,7 +254,7 @@ func (r *RirOrganizationResource) Update(ctx context.Context, req resource.Updat
 
 	var apiRes *rir.UpdateRirOrganizationResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -278,7 +287,7 @@ func (r *RirOrganizationResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *RirOrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RirOrganizationModel
+	var data RirOrganizationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -289,7 +298,7 @@ func (r *RirOrganizationResource) Delete(ctx context.Context, req resource.Delet
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.RIRAPI.
 			RirOrganizationAPI.
 			Delete(ctx, resourceRef).
@@ -311,7 +320,7 @@ func (r *RirOrganizationResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *RirOrganizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data RirOrganizationModel
+	var data RirOrganizationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -139,7 +139,7 @@ func (d *RecordRpzADataSource) Read(ctx context.Context, req datasource.ReadRequ
 				ReturnFieldsPlus(readableAttributesForRecordRpzA).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
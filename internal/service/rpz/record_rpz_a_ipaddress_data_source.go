@@ -139,7 +139,7 @@ func (d *RecordRpzAIpaddressDataSource) Read(ctx context.Context, req datasource
 				ReturnFieldsPlus(readableAttributesForRecordRpzAIpaddress).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -139,7 +139,7 @@ func (d *RecordRpzAaaaIpaddressDataSource) Read(ctx context.Context, req datasou
 				ReturnFieldsPlus(readableAttributesForRecordRpzAaaaIpaddress).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
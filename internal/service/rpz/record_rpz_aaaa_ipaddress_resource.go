@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/rpz"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type RecordRpzAaaaIpaddressResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordRpzAaaaIpaddressResourceModel describes the resource data model, extending RecordRpzAaaaIpaddressModel with the operation timeouts.
+type RecordRpzAaaaIpaddressResourceModel struct {
+	RecordRpzAaaaIpaddressModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordRpzAaaaIpaddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "rpz_record_aaaa_ipaddress"
 }
@@ -41,6 +49,7 @@ func (r *RecordRpzAaaaIpaddressResource) Schema(ctx context.Context, req resourc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an RPZ AAAA IPADDRESS record.",
 		Attributes:          RecordRpzAaaaIpaddressResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *RecordRpzAaaaIpaddressResource) Configure(ctx context.Context, req reso
 
 func (r *RecordRpzAaaaIpaddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzAaaaIpaddressModel
+	var data RecordRpzAaaaIpaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *RecordRpzAaaaIpaddressResource) Create(ctx context.Context, req resourc
 
 	var apiRes *rpz.CreateRecordRpzAaaaIpaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *RecordRpzAaaaIpaddressResource) Create(ctx context.Context, req resourc
 
 func (r *RecordRpzAaaaIpaddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzAaaaIpaddressModel
+	var data RecordRpzAaaaIpaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *RecordRpzAaaaIpaddressResource) Read(ctx context.Context, req resource.
 		apiRes  *rpz.GetRecordRpzAaaaIpaddressResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.RPZAPI.
 			RecordRpzAaaaIpaddressAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordRpzAaaaIpaddress).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *RecordRpzAaaaIpaddressResource) Read(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordRpzAaaaIpaddressResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzAaaaIpaddressModel, resp *resource.ReadResponse) bool {
+func (r *RecordRpzAaaaIpaddressResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzAaaaIpaddressResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +258,7 @@ func (r *RecordRpzAaaaIpaddressResource) ReadByExtAttrs(ctx context.Context, dat
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordRpzAaaaIpaddress).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordRpzAaaaIpaddress by extattrs, got error: %s", err))
@@ -280,7 +289,7 @@ func (r *RecordRpzAaaaIpaddressResource) ReadByExtAttrs(ctx context.Context, dat
 
 func (r *RecordRpzAaaaIpaddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzAaaaIpaddressModel
+	var data RecordRpzAaaaIpaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -331,7 +340,7 @@ func (r *RecordRpzAaaaIpaddressResource) Update(ctx context.Context, req resourc
 
 	var apiRes *rpz.UpdateRecordRpzAaaaIpaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -374,7 +383,7 @@ func (r *RecordRpzAaaaIpaddressResource) Update(ctx context.Context, req resourc
 }
 
 func (r *RecordRpzAaaaIpaddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordRpzAaaaIpaddressModel
+	var data RecordRpzAaaaIpaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -385,7 +394,7 @@ func (r *RecordRpzAaaaIpaddressResource) Delete(ctx context.Context, req resourc
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.RPZAPI.
 			RecordRpzAaaaIpaddressAPI.
 			Delete(ctx, resourceRef).
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/rpz"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type RecordRpzCnameClientipaddressResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordRpzCnameClientipaddressResourceModel describes the resource data model, extending RecordRpzCnameClientipaddressModel with the operation timeouts.
+type RecordRpzCnameClientipaddressResourceModel struct {
+	RecordRpzCnameClientipaddressModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordRpzCnameClientipaddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "rpz_record_cname_clientipaddress"
 }
@@ -43,6 +51,7 @@ func (r *RecordRpzCnameClientipaddressResource) Schema(ctx context.Context, req
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an RPZ CNAME Client IP Address Record.",
 		Attributes:          RecordRpzCnameClientipaddressResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +76,7 @@ func (r *RecordRpzCnameClientipaddressResource) Configure(ctx context.Context, r
 }
 
 func (r *RecordRpzCnameClientipaddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data RecordRpzCnameClientipaddressModel
+	var data RecordRpzCnameClientipaddressResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -90,7 +99,7 @@ func (r *RecordRpzCnameClientipaddressResource) ValidateConfig(ctx context.Conte
 
 func (r *RecordRpzCnameClientipaddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameClientipaddressModel
+	var data RecordRpzCnameClientipaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -113,7 +122,7 @@ func (r *RecordRpzCnameClientipaddressResource) Create(ctx context.Context, req
 
 	var apiRes *rpz.CreateRecordRpzCnameClientipaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -161,7 +170,7 @@ func (r *RecordRpzCnameClientipaddressResource) Create(ctx context.Context, req
 
 func (r *RecordRpzCnameClientipaddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameClientipaddressModel
+	var data RecordRpzCnameClientipaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -183,14 +192,14 @@ func (r *RecordRpzCnameClientipaddressResource) Read(ctx context.Context, req re
 		apiRes  *rpz.GetRecordRpzCnameClientipaddressResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.RPZAPI.
 			RecordRpzCnameClientipaddressAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordRpzCnameClientipaddress).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -246,7 +255,7 @@ func (r *RecordRpzCnameClientipaddressResource) Read(ctx context.Context, req re
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordRpzCnameClientipaddressResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzCnameClientipaddressModel, resp *resource.ReadResponse) bool {
+func (r *RecordRpzCnameClientipaddressResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzCnameClientipaddressResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -273,7 +282,7 @@ func (r *RecordRpzCnameClientipaddressResource) ReadByExtAttrs(ctx context.Conte
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordRpzCnameClientipaddress).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordRpzCnameClientipaddress by extattrs, got error: %s", err))
@@ -304,7 +313,7 @@ func (r *RecordRpzCnameClientipaddressResource) ReadByExtAttrs(ctx context.Conte
 
 func (r *RecordRpzCnameClientipaddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameClientipaddressModel
+	var data RecordRpzCnameClientipaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -355,7 +364,7 @@ func (r *RecordRpzCnameClientipaddressResource) Update(ctx context.Context, req
 
 	var apiRes *rpz.UpdateRecordRpzCnameClientipaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -398,7 +407,7 @@ func (r *RecordRpzCnameClientipaddressResource) Update(ctx context.Context, req
 }
 
 func (r *RecordRpzCnameClientipaddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordRpzCnameClientipaddressModel
+	var data RecordRpzCnameClientipaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -409,7 +418,7 @@ func (r *RecordRpzCnameClientipaddressResource) Delete(ctx context.Context, req
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.RPZAPI.
 			RecordRpzCnameClientipaddressAPI.
 			Delete(ctx, resourceRef).
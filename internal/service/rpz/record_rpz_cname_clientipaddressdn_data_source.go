@@ -139,7 +139,7 @@ func (d *RecordRpzCnameClientipaddressdnDataSource) Read(ctx context.Context, re
 				ReturnFieldsPlus(readableAttributesForRecordRpzCnameClientipaddressdn).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
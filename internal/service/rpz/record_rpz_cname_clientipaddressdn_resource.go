@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/rpz"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type RecordRpzCnameClientipaddressdnResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordRpzCnameClientipaddressdnResourceModel describes the resource data model, extending RecordRpzCnameClientipaddressdnModel with the operation timeouts.
+type RecordRpzCnameClientipaddressdnResourceModel struct {
+	RecordRpzCnameClientipaddressdnModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordRpzCnameClientipaddressdnResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "rpz_record_cname_clientipaddressdn"
 }
@@ -41,6 +49,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Schema(ctx context.Context, re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an RPZ CNAME Client IP Address DN record.",
 		Attributes:          RecordRpzCnameClientipaddressdnResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Configure(ctx context.Context,
 
 func (r *RecordRpzCnameClientipaddressdnResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameClientipaddressdnModel
+	var data RecordRpzCnameClientipaddressdnResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -89,7 +98,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Create(ctx context.Context, re
 
 	var apiRes *rpz.CreateRecordRpzCnameClientipaddressdnResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -137,7 +146,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Create(ctx context.Context, re
 
 func (r *RecordRpzCnameClientipaddressdnResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameClientipaddressdnModel
+	var data RecordRpzCnameClientipaddressdnResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -159,14 +168,14 @@ func (r *RecordRpzCnameClientipaddressdnResource) Read(ctx context.Context, req
 		apiRes  *rpz.GetRecordRpzCnameClientipaddressdnResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.RPZAPI.
 			RecordRpzCnameClientipaddressdnAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordRpzCnameClientipaddressdn).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -222,7 +231,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Read(ctx context.Context, req
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordRpzCnameClientipaddressdnResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzCnameClientipaddressdnModel, resp *resource.ReadResponse) bool {
+func (r *RecordRpzCnameClientipaddressdnResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzCnameClientipaddressdnResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -249,7 +258,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) ReadByExtAttrs(ctx context.Con
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordRpzCnameClientipaddressdn).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordRpzCnameClientipaddressdn by extattrs, got error: %s", err))
@@ -280,7 +289,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) ReadByExtAttrs(ctx context.Con
 
 func (r *RecordRpzCnameClientipaddressdnResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameClientipaddressdnModel
+	var data RecordRpzCnameClientipaddressdnResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -331,7 +340,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Update(ctx context.Context, re
 
 	var apiRes *rpz.UpdateRecordRpzCnameClientipaddressdnResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -374,7 +383,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Update(ctx context.Context, re
 }
 
 func (r *RecordRpzCnameClientipaddressdnResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordRpzCnameClientipaddressdnModel
+	var data RecordRpzCnameClientipaddressdnResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -385,7 +394,7 @@ func (r *RecordRpzCnameClientipaddressdnResource) Delete(ctx context.Context, re
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.RPZAPI.
 			RecordRpzCnameClientipaddressdnAPI.
 			Delete(ctx, resourceRef).
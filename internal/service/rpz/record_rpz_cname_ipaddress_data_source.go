@@ -139,7 +139,7 @@ func (d *RecordRpzCnameIpaddressDataSource) Read(ctx context.Context, req dataso
 				ReturnFieldsPlus(readableAttributesForRecordRpzCnameIpaddress).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -10,12 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/rpz"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +37,12 @@ type RecordRpzCnameIpaddressResource struct {
 	client *niosclient.APIClient
 }
 
+// RecordRpzCnameIpaddressResourceModel describes the resource data model, extending RecordRpzCnameIpaddressModel with the operation timeouts.
+type RecordRpzCnameIpaddressResourceModel struct {
+	RecordRpzCnameIpaddressModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *RecordRpzCnameIpaddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "rpz_record_cname_ipaddress"
 }
@@ -43,6 +51,7 @@ func (r *RecordRpzCnameIpaddressResource) Schema(ctx context.Context, req resour
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages RPZ CNAME IP address record.",
 		Attributes:          RecordRpzCnameIpaddressResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -68,7 +77,7 @@ func (r *RecordRpzCnameIpaddressResource) Configure(ctx context.Context, req res
 
 func (r *RecordRpzCnameIpaddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameIpaddressModel
+	var data RecordRpzCnameIpaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -91,7 +100,7 @@ func (r *RecordRpzCnameIpaddressResource) Create(ctx context.Context, req resour
 
 	var apiRes *rpz.CreateRecordRpzCnameIpaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -139,7 +148,7 @@ func (r *RecordRpzCnameIpaddressResource) Create(ctx context.Context, req resour
 
 func (r *RecordRpzCnameIpaddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameIpaddressModel
+	var data RecordRpzCnameIpaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -161,14 +170,14 @@ func (r *RecordRpzCnameIpaddressResource) Read(ctx context.Context, req resource
 		apiRes  *rpz.GetRecordRpzCnameIpaddressResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.RPZAPI.
 			RecordRpzCnameIpaddressAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForRecordRpzCnameIpaddress).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -224,7 +233,7 @@ func (r *RecordRpzCnameIpaddressResource) Read(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RecordRpzCnameIpaddressResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzCnameIpaddressModel, resp *resource.ReadResponse) bool {
+func (r *RecordRpzCnameIpaddressResource) ReadByExtAttrs(ctx context.Context, data *RecordRpzCnameIpaddressResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -251,7 +260,7 @@ func (r *RecordRpzCnameIpaddressResource) ReadByExtAttrs(ctx context.Context, da
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForRecordRpzCnameIpaddress).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RecordRpzCnameIpaddress by extattrs, got error: %s", err))
@@ -282,7 +291,7 @@ func (r *RecordRpzCnameIpaddressResource) ReadByExtAttrs(ctx context.Context, da
 
 func (r *RecordRpzCnameIpaddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data RecordRpzCnameIpaddressModel
+	var data RecordRpzCnameIpaddressResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -333,7 +342,7 @@ func (r *RecordRpzCnameIpaddressResource) Update(ctx context.Context, req resour
 
 	var apiRes *rpz.UpdateRecordRpzCnameIpaddressResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -376,7 +385,7 @@ func (r *RecordRpzCnameIpaddressResource) Update(ctx context.Context, req resour
 }
 
 func (r *RecordRpzCnameIpaddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data RecordRpzCnameIpaddressModel
+	var data RecordRpzCnameIpaddressResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -387,7 +396,7 @@ func (r *RecordRpzCnameIpaddressResource) Delete(ctx context.Context, req resour
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.RPZAPI.
 			RecordRpzCnameIpaddressAPI.
 			Delete(ctx, resourceRef).
@@ -409,7 +418,7 @@ func (r *RecordRpzCnameIpaddressResource) Delete(ctx context.Context, req resour
 }
 
 func (r *RecordRpzCnameIpaddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data RecordRpzCnameIpaddressModel
+	var data RecordRpzCnameIpaddressResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
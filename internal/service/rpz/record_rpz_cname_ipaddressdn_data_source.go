@@ -139,7 +139,7 @@ func (d *RecordRpzCnameIpaddressdnDataSource) Read(ctx context.Context, req data
 				ReturnFieldsPlus(readableAttributesForRecordRpzCnameIpaddressdn).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -0,0 +1,426 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAdAuthService = "ad_domain,comment,disabled,domain_controllers,name,nested_group_querying,timeout"
+
+func TestAccAdAuthServiceResource_basic(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "ad_domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.fqdn_or_ip", "2.2.4.1"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "nested_group_querying", "false"),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.auth_port", "389"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.encryption", "NONE"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_disappears(t *testing.T) {
+	resourceName := "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAdAuthServiceDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					testAccCheckAdAuthServiceDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_AdDomain(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ad_domain", "example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "corp.example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ad_domain", "corp.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Comment(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_comment"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceComment(name, domainControllers, "Comment for AD auth service"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Comment for AD auth service"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceComment(name, domainControllers, "Updated comment for AD auth service"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Updated comment for AD auth service"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Disabled(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_disabled"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceDisabled(name, domainControllers, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceDisabled(name, domainControllers, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_DomainControllers(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+	domainControllersUpdated := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.2",
+			"auth_port":  636,
+			"encryption": "SSL",
+			"comment":    "secondary domain controller",
+		},
+		{
+			"fqdn_or_ip": "2.2.4.3",
+			"disabled":   true,
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "example.com", domainControllers),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.fqdn_or_ip", "2.2.4.1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceBasicConfig(name, "example.com", domainControllersUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.fqdn_or_ip", "2.2.4.2"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.auth_port", "636"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.encryption", "SSL"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.0.comment", "secondary domain controller"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.1.fqdn_or_ip", "2.2.4.3"),
+					resource.TestCheckResourceAttr(resourceName, "domain_controllers.1.disabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_NestedGroupQuerying(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_nested_group_querying"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceNestedGroupQuerying(name, domainControllers, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "nested_group_querying", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceNestedGroupQuerying(name, domainControllers, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "nested_group_querying", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdAuthServiceResource_Timeout(t *testing.T) {
+	var resourceName = "nios_security_ad_auth_service.test_timeout"
+	var v security.AdAuthService
+	name := acctest.RandomNameWithPrefix("ad-auth-service")
+	domainControllers := []map[string]any{
+		{
+			"fqdn_or_ip": "2.2.4.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAdAuthServiceTimeout(name, domainControllers, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAdAuthServiceTimeout(name, domainControllers, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdAuthServiceExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "timeout", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckAdAuthServiceExists(ctx context.Context, resourceName string, v *security.AdAuthService) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			AdAuthServiceAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForAdAuthService).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetAdAuthServiceResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetAdAuthServiceResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckAdAuthServiceDestroy(ctx context.Context, v *security.AdAuthService) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			AdAuthServiceAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForAdAuthService).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckAdAuthServiceDisappears(ctx context.Context, v *security.AdAuthService) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			AdAuthServiceAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccAdAuthServiceBasicConfig(name, adDomain string, domainControllers []map[string]any) string {
+	domainControllersString := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test" {
+	name = %q
+	ad_domain = %q
+	domain_controllers = %s
+}
+`, name, adDomain, domainControllersString)
+}
+
+func testAccAdAuthServiceComment(name string, domainControllers []map[string]any, comment string) string {
+	domainControllersString := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_comment" {
+	name = %q
+	ad_domain = "example.com"
+	domain_controllers = %s
+	comment = %q
+}
+`, name, domainControllersString, comment)
+}
+
+func testAccAdAuthServiceDisabled(name string, domainControllers []map[string]any, disabled bool) string {
+	domainControllersString := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_disabled" {
+	name = %q
+	ad_domain = "example.com"
+	domain_controllers = %s
+	disabled = %t
+}
+`, name, domainControllersString, disabled)
+}
+
+func testAccAdAuthServiceNestedGroupQuerying(name string, domainControllers []map[string]any, nestedGroupQuerying bool) string {
+	domainControllersString := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_nested_group_querying" {
+	name = %q
+	ad_domain = "example.com"
+	domain_controllers = %s
+	nested_group_querying = %t
+}
+`, name, domainControllersString, nestedGroupQuerying)
+}
+
+func testAccAdAuthServiceTimeout(name string, domainControllers []map[string]any, timeout int) string {
+	domainControllersString := utils.ConvertSliceOfMapsToHCL(domainControllers)
+	return fmt.Sprintf(`
+resource "nios_security_ad_auth_service" "test_timeout" {
+	name = %q
+	ad_domain = "example.com"
+	domain_controllers = %s
+	timeout = %d
+}
+`, name, domainControllersString, timeout)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/infobloxopen/infoblox-nios-go-client/security"
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +35,12 @@ type AdmingroupResource struct {
 	client *niosclient.APIClient
 }
 
+// AdmingroupResourceModel describes the resource data model, extending AdmingroupModel with the operation timeouts.
+type AdmingroupResourceModel struct {
+	AdmingroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *AdmingroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "security_admin_group"
 }
@@ -42,6 +49,7 @@ func (r *AdmingroupResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an Admin Group.",
 		Attributes:          AdmingroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +74,7 @@ func (r *AdmingroupResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *AdmingroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var config AdmingroupModel
+	var config AdmingroupResourceModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -159,7 +167,7 @@ func (r *AdmingroupResource) ValidateConfig(ctx context.Context, req resource.Va
 
 func (r *AdmingroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data AdmingroupModel
+	var data AdmingroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -181,7 +189,7 @@ func (r *AdmingroupResource) Create(ctx context.Context, req resource.CreateRequ
 
 	var apiRes *security.CreateAdmingroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -228,7 +236,7 @@ func (r *AdmingroupResource) Create(ctx context.Context, req resource.CreateRequ
 
 func (r *AdmingroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data AdmingroupModel
+	var data AdmingroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -250,14 +258,14 @@ func (r *AdmingroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *security.GetAdmingroupResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.SecurityAPI.
 			AdmingroupAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForAdmingroup).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -312,7 +320,7 @@ func (r *AdmingroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *AdmingroupResource) ReadByExtAttrs(ctx context.Context, data *AdmingroupModel, resp *resource.ReadResponse) bool {
+func (r *AdmingroupResource) ReadByExtAttrs(ctx context.Context, data *AdmingroupResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -339,7 +347,7 @@ func (r *AdmingroupResource) ReadByExtAttrs(ctx context.Context, data *Admingrou
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForAdmingroup).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Admingroup by extattrs, got error: %s", err))
@@ -370,7 +378,7 @@ func (r *AdmingroupResource) ReadByExtAttrs(ctx context.Context, data *Admingrou
 
 func (r *AdmingroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data AdmingroupModel
+	var data AdmingroupResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -419,7 +427,7 @@ func (r *AdmingroupResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	var apiRes *security.UpdateAdmingroupResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -462,7 +470,7 @@ func (r *AdmingroupResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *AdmingroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data AdmingroupModel
+	var data AdmingroupResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -473,7 +481,7 @@ func (r *AdmingroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.SecurityAPI.
 			AdmingroupAPI.
 			Delete(ctx, resourceRef).
@@ -19,6 +19,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -38,6 +39,12 @@ type AdminuserResource struct {
 	client *niosclient.APIClient
 }
 
+// AdminuserResourceModel describes the resource data model, extending AdminuserModel with the operation timeouts.
+type AdminuserResourceModel struct {
+	AdminuserModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 type secretsHashState struct {
 	Password string `json:"password_hash"`
 }
@@ -50,6 +57,7 @@ func (r *AdminuserResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an Admin User.",
 		Attributes:          AdminuserResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -160,7 +168,7 @@ func (r *AdminuserResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 
 func (r *AdminuserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data AdminuserModel
+	var data AdminuserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -206,7 +214,7 @@ func (r *AdminuserResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "password_hash", hashedPassword)...)
 	}
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -260,7 +268,7 @@ func (r *AdminuserResource) Create(ctx context.Context, req resource.CreateReque
 
 func (r *AdminuserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data AdminuserModel
+	var data AdminuserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -282,14 +290,14 @@ func (r *AdminuserResource) Read(ctx context.Context, req resource.ReadRequest,
 		apiRes  *security.GetAdminuserResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.SecurityAPI.
 			AdminuserAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForAdminuser).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -348,7 +356,7 @@ func (r *AdminuserResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *AdminuserResource) ReadByExtAttrs(ctx context.Context, data *AdminuserModel, resp *resource.ReadResponse) bool {
+func (r *AdminuserResource) ReadByExtAttrs(ctx context.Context, data *AdminuserResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -375,7 +383,7 @@ func (r *AdminuserResource) ReadByExtAttrs(ctx context.Context, data *AdminuserM
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForAdminuser).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Adminuser by extattrs, got error: %s", err))
@@ -410,7 +418,7 @@ func (r *AdminuserResource) ReadByExtAttrs(ctx context.Context, data *AdminuserM
 
 func (r *AdminuserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data AdminuserModel
+	var data AdminuserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -468,7 +476,7 @@ func (r *AdminuserResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var apiRes *security.UpdateAdminuserResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -517,7 +525,7 @@ func (r *AdminuserResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *AdminuserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data AdminuserModel
+	var data AdminuserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -528,7 +536,7 @@ func (r *AdminuserResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.SecurityAPI.
 			AdminuserAPI.
 			Delete(ctx, resourceRef).
@@ -0,0 +1,125 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccApprovalworkflowDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_security_approval_workflow.test"
+	resourceName := "nios_security_approval_workflow.test"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckApprovalworkflowDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApprovalworkflowDataSourceConfigFilters(submitterGroup, approvalGroup),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					}, testAccCheckApprovalworkflowResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccApprovalworkflowDataSource_ExtAttrFilters(t *testing.T) {
+	dataSourceName := "data.nios_security_approval_workflow.test"
+	resourceName := "nios_security_approval_workflow.test"
+	var v security.Approvalworkflow
+	submitterGroup := acctest.RandomNameWithPrefix("submitter-group")
+	approvalGroup := acctest.RandomNameWithPrefix("approval-group")
+	extAttrValue := acctest.RandomNameWithPrefix("approval-workflow")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckApprovalworkflowDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApprovalworkflowDataSourceConfigExtAttrFilters(submitterGroup, approvalGroup, extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckApprovalworkflowExists(context.Background(), resourceName, &v),
+					}, testAccCheckApprovalworkflowResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckApprovalworkflowResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "approval_group", dataSourceName, "result.0.approval_group"),
+		resource.TestCheckResourceAttrPair(resourceName, "approval_notify_to", dataSourceName, "result.0.approval_notify_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "approved_notify_to", dataSourceName, "result.0.approved_notify_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "approver_comment", dataSourceName, "result.0.approver_comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_approval_notify", dataSourceName, "result.0.enable_approval_notify"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_approved_notify", dataSourceName, "result.0.enable_approved_notify"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_failed_notify", dataSourceName, "result.0.enable_failed_notify"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_notify_group", dataSourceName, "result.0.enable_notify_group"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_notify_user", dataSourceName, "result.0.enable_notify_user"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_rejected_notify", dataSourceName, "result.0.enable_rejected_notify"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_rescheduled_notify", dataSourceName, "result.0.enable_rescheduled_notify"),
+		resource.TestCheckResourceAttrPair(resourceName, "enable_succeeded_notify", dataSourceName, "result.0.enable_succeeded_notify"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "failed_notify_to", dataSourceName, "result.0.failed_notify_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "rejected_notify_to", dataSourceName, "result.0.rejected_notify_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "rescheduled_notify_to", dataSourceName, "result.0.rescheduled_notify_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "submitter_comment", dataSourceName, "result.0.submitter_comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "submitter_group", dataSourceName, "result.0.submitter_group"),
+		resource.TestCheckResourceAttrPair(resourceName, "succeeded_notify_to", dataSourceName, "result.0.succeeded_notify_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "ticket_number", dataSourceName, "result.0.ticket_number"),
+	}
+}
+
+func testAccApprovalworkflowDataSourceConfigFilters(submitterGroup, approvalGroup string) string {
+	config := `
+resource "nios_security_approval_workflow" "test" {
+	submitter_group = nios_security_admin_group.submitter_group.name
+	approval_group = nios_security_admin_group.approval_group.name
+}
+
+data "nios_security_approval_workflow" "test" {
+	filters = {
+		submitter_group = nios_security_approval_workflow.test.submitter_group
+	}
+}
+`
+	return strings.Join([]string{testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup), config}, "")
+}
+
+func testAccApprovalworkflowDataSourceConfigExtAttrFilters(submitterGroup, approvalGroup, extAttrsValue string) string {
+	config := fmt.Sprintf(`
+resource "nios_security_approval_workflow" "test" {
+	submitter_group = nios_security_admin_group.submitter_group.name
+	approval_group = nios_security_admin_group.approval_group.name
+	extattrs = {
+		Site = %q
+	}
+}
+
+data "nios_security_approval_workflow" "test" {
+	extattrfilters = {
+		Site = nios_security_approval_workflow.test.extattrs.Site
+	}
+}
+`, extAttrsValue)
+	return strings.Join([]string{testAccApprovalworkflowAdminGroups(submitterGroup, approvalGroup), config}, "")
+}
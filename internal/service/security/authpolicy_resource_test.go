@@ -0,0 +1,218 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForAuthpolicy = "admin_groups,auth_services,default_group,usage_type"
+
+func TestAccAuthpolicyResource_basic(t *testing.T) {
+	var resourceName = "nios_security_auth_policy.test"
+	var v security.Authpolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "auth_services.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "usage_type"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAuthpolicyResource_AuthServices(t *testing.T) {
+	var resourceName = "nios_security_auth_policy.test_auth_services"
+	var v security.Authpolicy
+	adAuthServiceName := acctest.RandomNameWithPrefix("ad-auth-service")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyAuthServices(adAuthServiceName, `[
+		data.nios_security_localuser_authservice.local.result[0].ref,
+		nios_security_ad_auth_service.test.ref,
+	]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_services.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.0", "data.nios_security_localuser_authservice.local", "result.0.ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.1", "nios_security_ad_auth_service.test", "ref"),
+				),
+			},
+			// Update the order and Read
+			{
+				Config: testAccAuthpolicyAuthServices(adAuthServiceName, `[
+		nios_security_ad_auth_service.test.ref,
+		data.nios_security_localuser_authservice.local.result[0].ref,
+	]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_services.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.0", "nios_security_ad_auth_service.test", "ref"),
+					resource.TestCheckResourceAttrPair(resourceName, "auth_services.1", "data.nios_security_localuser_authservice.local", "result.0.ref"),
+				),
+			},
+			// Restore the local service only, so that the AD service can be deleted
+			{
+				Config: testAccAuthpolicyAuthServices(adAuthServiceName, `[
+		data.nios_security_localuser_authservice.local.result[0].ref,
+	]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auth_services.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAuthpolicyResource_DefaultGroup(t *testing.T) {
+	var resourceName = "nios_security_auth_policy.test_default_group"
+	var v security.Authpolicy
+	adminGroup := acctest.RandomNameWithPrefix("admin-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyDefaultGroup(adminGroup, "nios_security_admin_group.test.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_group", adminGroup),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAuthpolicyDefaultGroup(adminGroup, `""`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_group", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAuthpolicyResource_UsageType(t *testing.T) {
+	var resourceName = "nios_security_auth_policy.test_usage_type"
+	var v security.Authpolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccAuthpolicyUsageType("AUTH_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "usage_type", "AUTH_ONLY"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccAuthpolicyUsageType("FULL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthpolicyExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "usage_type", "FULL"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckAuthpolicyExists(ctx context.Context, resourceName string, v *security.Authpolicy) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			AuthpolicyAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForAuthpolicy).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetAuthpolicyResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetAuthpolicyResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccAuthpolicyBasicConfig() string {
+	return `
+resource "nios_security_auth_policy" "test" {
+}
+`
+}
+
+func testAccAuthpolicyAuthServices(adAuthServiceName, authServices string) string {
+	return fmt.Sprintf(`
+data "nios_security_localuser_authservice" "local" {}
+
+resource "nios_security_ad_auth_service" "test" {
+	name = %q
+	ad_domain = "example.com"
+	domain_controllers = [
+		{
+			fqdn_or_ip = "2.2.4.10"
+		}
+	]
+}
+
+resource "nios_security_auth_policy" "test_auth_services" {
+	auth_services = %s
+}
+`, adAuthServiceName, authServices)
+}
+
+func testAccAuthpolicyDefaultGroup(adminGroup, defaultGroup string) string {
+	return fmt.Sprintf(`
+resource "nios_security_admin_group" "test" {
+	name = %q
+}
+
+resource "nios_security_auth_policy" "test_default_group" {
+	default_group = %s
+}
+`, adminGroup, defaultGroup)
+}
+
+func testAccAuthpolicyUsageType(usageType string) string {
+	return fmt.Sprintf(`
+resource "nios_security_auth_policy" "test_usage_type" {
+	usage_type = %q
+}
+`, usageType)
+}
@@ -132,7 +132,7 @@ func (d *CertificateAuthserviceDataSource) Read(ctx context.Context, req datasou
 				ReturnFieldsPlus(readableAttributesForCertificateAuthservice).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
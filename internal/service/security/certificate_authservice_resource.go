@@ -15,6 +15,7 @@ import (
 	"github.com/infobloxopen/infoblox-nios-go-client/security"
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +34,12 @@ type CertificateAuthserviceResource struct {
 	client *niosclient.APIClient
 }
 
+// CertificateAuthserviceResourceModel describes the resource data model, extending CertificateAuthserviceModel with the operation timeouts.
+type CertificateAuthserviceResourceModel struct {
+	CertificateAuthserviceModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *CertificateAuthserviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "security_certificate_authservice"
 }
@@ -41,6 +48,7 @@ func (r *CertificateAuthserviceResource) Schema(ctx context.Context, req resourc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Certificate Authentication Service.",
 		Attributes:          CertificateAuthserviceResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +74,7 @@ func (r *CertificateAuthserviceResource) Configure(ctx context.Context, req reso
 
 func (r *CertificateAuthserviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	//var diags diag.Diagnostics
-	var data CertificateAuthserviceModel
+	var data CertificateAuthserviceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -87,7 +95,7 @@ func (r *CertificateAuthserviceResource) Create(ctx context.Context, req resourc
 
 	var apiRes *security.CreateCertificateAuthserviceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -129,7 +137,7 @@ func (r *CertificateAuthserviceResource) Create(ctx context.Context, req resourc
 
 func (r *CertificateAuthserviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	//var diags diag.Diagnostics
-	var data CertificateAuthserviceModel
+	var data CertificateAuthserviceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,14 +153,14 @@ func (r *CertificateAuthserviceResource) Read(ctx context.Context, req resource.
 		apiRes  *security.GetCertificateAuthserviceResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.SecurityAPI.
 			CertificateAuthserviceAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForCertificateAuthservice).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -181,7 +189,7 @@ func (r *CertificateAuthserviceResource) Read(ctx context.Context, req resource.
 
 func (r *CertificateAuthserviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data CertificateAuthserviceModel
+	var data CertificateAuthserviceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -209,7 +217,7 @@ func (r *CertificateAuthserviceResource) Update(ctx context.Context, req resourc
 
 	var apiRes *security.UpdateCertificateAuthserviceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -242,7 +250,7 @@ func (r *CertificateAuthserviceResource) Update(ctx context.Context, req resourc
 }
 
 func (r *CertificateAuthserviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data CertificateAuthserviceModel
+	var data CertificateAuthserviceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -253,7 +261,7 @@ func (r *CertificateAuthserviceResource) Delete(ctx context.Context, req resourc
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.SecurityAPI.
 			CertificateAuthserviceAPI.
 			Delete(ctx, resourceRef).
@@ -281,16 +289,14 @@ func (r *CertificateAuthserviceResource) ImportState(ctx context.Context, req re
 // processOcspResponders processes certificate files in OCSP responders list
 func (r *CertificateAuthserviceResource) processOcspResponders(
 	ctx context.Context,
-	data *CertificateAuthserviceModel,
+	data *CertificateAuthserviceResourceModel,
 	diag *diag.Diagnostics,
 ) bool {
 	if data.OcspResponders.IsNull() || data.OcspResponders.IsUnknown() {
 		return true
 	}
 
-	baseUrl := r.client.SecurityAPI.Cfg.NIOSHostURL
-	username := r.client.SecurityAPI.Cfg.NIOSUsername
-	password := r.client.SecurityAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.SecurityAPI.Cfg)
 
 	var ocspResponders []CertificateAuthserviceOcspRespondersModel
 	diagResult := data.OcspResponders.ElementsAs(ctx, &ocspResponders, false)
@@ -302,7 +308,7 @@ func (r *CertificateAuthserviceResource) processOcspResponders(
 	for i, ocspResponder := range ocspResponders {
 		if !ocspResponder.CertificateFilePath.IsNull() && !ocspResponder.CertificateFilePath.IsUnknown() {
 			filePath := ocspResponder.CertificateFilePath.ValueString()
-			token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+			token, err := utils.UploadFileWithToken(ctx, r.client.SecurityAPI.Cfg.HTTPClient, baseUrl, filePath)
 			if err != nil {
 				diag.AddError(
 					"Client Error",
@@ -325,7 +331,7 @@ func (r *CertificateAuthserviceResource) processOcspResponders(
 }
 
 func (r *CertificateAuthserviceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data CertificateAuthserviceModel
+	var data CertificateAuthserviceResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
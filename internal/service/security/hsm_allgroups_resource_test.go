@@ -0,0 +1,92 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForHsmAllgroups = "groups"
+
+func TestAccHsmAllgroupsResource_Groups(t *testing.T) {
+	var resourceName = "nios_security_hsm_allgroups.test_groups"
+	var v security.HsmAllgroups
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmAllgroupsGroups(name, "[nios_security_hsm_entrustnshieldgroup.test.ref]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmAllgroupsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "groups.0", "nios_security_hsm_entrustnshieldgroup.test", "ref"),
+				),
+			},
+			// Clear the group list, so that the HSM group can be deleted
+			{
+				Config: testAccHsmAllgroupsGroups(name, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmAllgroupsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckHsmAllgroupsExists(ctx context.Context, resourceName string, v *security.HsmAllgroups) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			HsmAllgroupsAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForHsmAllgroups).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetHsmAllgroupsResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetHsmAllgroupsResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccHsmAllgroupsGroups(name, groups string) string {
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test" {
+	name = %q
+	key_server_ip = "10.130.0.1"
+	protection = "MODULE"
+	entrustnshield_hsm = [
+		{
+			remote_ip = "10.130.0.30"
+			remote_esn = "1234-5678-90AB"
+			keyhash = "0123456789abcdef0123456789abcdef01234567"
+		}
+	]
+}
+
+resource "nios_security_hsm_allgroups" "test_groups" {
+	groups = %s
+}
+`, name, groups)
+}
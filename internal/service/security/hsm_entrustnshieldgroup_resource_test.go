@@ -0,0 +1,439 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForHsmEntrustnshieldgroup = "card_name,comment,entrustnshield_hsm,key_server_ip,key_server_port,name,protection,status"
+
+func TestAccHsmEntrustnshieldgroupResource_basic(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.10",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.130.0.1", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "key_server_ip", "10.130.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "protection", "MODULE"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_ip", "10.130.0.10"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "key_server_port", "9004"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_port", "9004"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_disappears(t *testing.T) {
+	resourceName := "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.11",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHsmEntrustnshieldgroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.130.0.1", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					testAccCheckHsmEntrustnshieldgroupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_Comment(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_comment"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.12",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupComment(name, entrustnshieldHsm, "Entrust nShield HSM group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Entrust nShield HSM group"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupComment(name, entrustnshieldHsm, "Updated Entrust nShield HSM group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Updated Entrust nShield HSM group"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_EntrustnshieldHsm(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.13",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+	entrustnshieldHsmUpdated := []map[string]any{
+		{
+			"remote_ip":   "10.130.0.14",
+			"remote_port": 9005,
+			"remote_esn":  "ABCD-EF01-2345",
+			"keyhash":     "76543210fedcba9876543210fedcba9876543210",
+			"disable":     true,
+		},
+		{
+			"remote_ip":  "10.130.0.15",
+			"remote_esn": "1234-5678-90AC",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234568",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.130.0.1", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_ip", "10.130.0.13"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.130.0.1", "MODULE", entrustnshieldHsmUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_ip", "10.130.0.14"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_port", "9005"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.remote_esn", "ABCD-EF01-2345"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.0.disable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "entrustnshield_hsm.1.remote_ip", "10.130.0.15"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_KeyServerIp(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.16",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.130.0.1", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key_server_ip", "10.130.0.1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name, "10.130.0.2", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key_server_ip", "10.130.0.2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_KeyServerPort(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_key_server_port"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.17",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupKeyServerPort(name, entrustnshieldHsm, 9004),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key_server_port", "9004"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupKeyServerPort(name, entrustnshieldHsm, 9010),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "key_server_port", "9010"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_Name(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test"
+	var v security.HsmEntrustnshieldgroup
+	name1 := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	name2 := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.18",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name1, "10.130.0.1", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupBasicConfig(name2, "10.130.0.1", "MODULE", entrustnshieldHsm),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmEntrustnshieldgroupResource_Protection(t *testing.T) {
+	var resourceName = "nios_security_hsm_entrustnshieldgroup.test_protection"
+	var v security.HsmEntrustnshieldgroup
+	name := acctest.RandomNameWithPrefix("hsm-entrustnshieldgroup")
+	entrustnshieldHsm := []map[string]any{
+		{
+			"remote_ip":  "10.130.0.19",
+			"remote_esn": "1234-5678-90AB",
+			"keyhash":    "0123456789abcdef0123456789abcdef01234567",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupProtection(name, entrustnshieldHsm, "SOFTCARD", "softcard1", "pass-phrase-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "protection", "SOFTCARD"),
+					resource.TestCheckResourceAttr(resourceName, "card_name", "softcard1"),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase", "pass-phrase-1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmEntrustnshieldgroupProtection(name, entrustnshieldHsm, "SOFTCARD", "softcard2", "pass-phrase-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmEntrustnshieldgroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "card_name", "softcard2"),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase", "pass-phrase-2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckHsmEntrustnshieldgroupExists(ctx context.Context, resourceName string, v *security.HsmEntrustnshieldgroup) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			HsmEntrustnshieldgroupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForHsmEntrustnshieldgroup).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetHsmEntrustnshieldgroupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetHsmEntrustnshieldgroupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckHsmEntrustnshieldgroupDestroy(ctx context.Context, v *security.HsmEntrustnshieldgroup) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			HsmEntrustnshieldgroupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForHsmEntrustnshieldgroup).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckHsmEntrustnshieldgroupDisappears(ctx context.Context, v *security.HsmEntrustnshieldgroup) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			HsmEntrustnshieldgroupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccHsmEntrustnshieldgroupBasicConfig(name, keyServerIp, protection string, entrustnshieldHsm []map[string]any) string {
+	entrustnshieldHsmString := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test" {
+	name = %q
+	key_server_ip = %q
+	protection = %q
+	entrustnshield_hsm = %s
+}
+`, name, keyServerIp, protection, entrustnshieldHsmString)
+}
+
+func testAccHsmEntrustnshieldgroupComment(name string, entrustnshieldHsm []map[string]any, comment string) string {
+	entrustnshieldHsmString := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_comment" {
+	name = %q
+	key_server_ip = "10.130.0.1"
+	protection = "MODULE"
+	entrustnshield_hsm = %s
+	comment = %q
+}
+`, name, entrustnshieldHsmString, comment)
+}
+
+func testAccHsmEntrustnshieldgroupKeyServerPort(name string, entrustnshieldHsm []map[string]any, keyServerPort int) string {
+	entrustnshieldHsmString := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_key_server_port" {
+	name = %q
+	key_server_ip = "10.130.0.1"
+	protection = "MODULE"
+	entrustnshield_hsm = %s
+	key_server_port = %d
+}
+`, name, entrustnshieldHsmString, keyServerPort)
+}
+
+func testAccHsmEntrustnshieldgroupProtection(name string, entrustnshieldHsm []map[string]any, protection, cardName, passPhrase string) string {
+	entrustnshieldHsmString := utils.ConvertSliceOfMapsToHCL(entrustnshieldHsm)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_entrustnshieldgroup" "test_protection" {
+	name = %q
+	key_server_ip = "10.130.0.1"
+	entrustnshield_hsm = %s
+	protection = %q
+	card_name = %q
+	pass_phrase = %q
+}
+`, name, entrustnshieldHsmString, protection, cardName, passPhrase)
+}
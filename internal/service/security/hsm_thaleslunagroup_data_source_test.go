@@ -0,0 +1,78 @@
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+func TestAccHsmThaleslunagroupDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_security_hsm_thaleslunagroup.test"
+	resourceName := "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.120.0.20",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem"),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHsmThaleslunagroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmThaleslunagroupDataSourceConfigFilters(name, thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					}, testAccCheckHsmThaleslunagroupResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckHsmThaleslunagroupResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ref", dataSourceName, "result.0.ref"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "group_sn", dataSourceName, "result.0.group_sn"),
+		resource.TestCheckResourceAttrPair(resourceName, "hsm_version", dataSourceName, "result.0.hsm_version"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "status", dataSourceName, "result.0.status"),
+		resource.TestCheckResourceAttrPair(resourceName, "thalesluna.#", dataSourceName, "result.0.thalesluna.#"),
+		resource.TestCheckResourceAttrPair(resourceName, "thalesluna.0.name", dataSourceName, "result.0.thalesluna.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "thalesluna.0.partition_serial_number", dataSourceName, "result.0.thalesluna.0.partition_serial_number"),
+	}
+}
+
+func testAccHsmThaleslunagroupDataSourceConfigFilters(name string, thalesluna []map[string]any) string {
+	thaleslunaString := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test" {
+	name = %q
+	hsm_version = "Luna_7"
+	pass_phrase = "pass-phrase-1"
+	thalesluna = %s
+}
+
+data "nios_security_hsm_thaleslunagroup" "test" {
+	filters = {
+		name = nios_security_hsm_thaleslunagroup.test.name
+	}
+}
+`, name, thaleslunaString)
+}
@@ -0,0 +1,332 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForHsmThaleslunagroup = "comment,group_sn,hsm_version,name,status,thalesluna"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HsmThaleslunagroupResource{}
+var _ resource.ResourceWithImportState = &HsmThaleslunagroupResource{}
+
+func NewHsmThaleslunagroupResource() resource.Resource {
+	return &HsmThaleslunagroupResource{}
+}
+
+// HsmThaleslunagroupResource defines the resource implementation.
+type HsmThaleslunagroupResource struct {
+	client *niosclient.APIClient
+}
+
+// HsmThaleslunagroupResourceModel describes the resource data model, extending HsmThaleslunagroupModel with the operation timeouts.
+type HsmThaleslunagroupResourceModel struct {
+	HsmThaleslunagroupModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *HsmThaleslunagroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "security_hsm_thaleslunagroup"
+}
+
+func (r *HsmThaleslunagroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Thales Luna HSM group.",
+		Attributes:          HsmThaleslunagroupResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *HsmThaleslunagroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HsmThaleslunagroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HsmThaleslunagroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Process Thales Luna HSM devices
+	if !r.processThalesluna(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiRes *security.CreateHsmThaleslunagroupResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Create(ctx).
+			HsmThaleslunagroup(*payload).
+			ReturnFieldsPlus(readableAttributesForHsmThaleslunagroup).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		if retry.IsAlreadyExistsErr(err) {
+			// Resource already exists, import required
+			resp.Diagnostics.AddError(
+				"Resource Already Exists",
+				fmt.Sprintf("Resource already exists, error: %s.\nPlease import the existing resource into terraform state.", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HsmThaleslunagroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.CreateHsmThaleslunagroupResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HsmThaleslunagroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HsmThaleslunagroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *security.GetHsmThaleslunagroupResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForHsmThaleslunagroup).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HsmThaleslunagroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetHsmThaleslunagroupResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HsmThaleslunagroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data HsmThaleslunagroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Process Thales Luna HSM devices
+	if !r.processThalesluna(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var apiRes *security.UpdateHsmThaleslunagroupResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Update(ctx, resourceRef).
+			HsmThaleslunagroup(*payload).
+			ReturnFieldsPlus(readableAttributesForHsmThaleslunagroup).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HsmThaleslunagroup, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateHsmThaleslunagroupResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HsmThaleslunagroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HsmThaleslunagroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		httpRes, callErr := r.client.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Delete(ctx, resourceRef).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HsmThaleslunagroup, got error: %s", err))
+		return
+	}
+}
+
+// processThalesluna uploads the server certificate files of the Thales Luna HSM devices list
+func (r *HsmThaleslunagroupResource) processThalesluna(
+	ctx context.Context,
+	data *HsmThaleslunagroupResourceModel,
+	diag *diag.Diagnostics,
+) bool {
+	if data.Thalesluna.IsNull() || data.Thalesluna.IsUnknown() {
+		return true
+	}
+
+	baseUrl := utils.WAPIBaseURL(r.client.SecurityAPI.Cfg)
+
+	var devices []HsmThaleslunagroupThaleslunaModel
+	diagResult := data.Thalesluna.ElementsAs(ctx, &devices, false)
+	diag.Append(diagResult...)
+	if diag.HasError() {
+		return false
+	}
+
+	for i, device := range devices {
+		if !device.ServerCertFilePath.IsNull() && !device.ServerCertFilePath.IsUnknown() {
+			filePath := device.ServerCertFilePath.ValueString()
+			token, err := utils.UploadFileWithToken(ctx, r.client.SecurityAPI.Cfg.HTTPClient, baseUrl, filePath)
+			if err != nil {
+				diag.AddError(
+					"Client Error",
+					fmt.Sprintf("Unable to process server certificate file %s, got error: %s", filePath, err),
+				)
+				return false
+			}
+			devices[i].ServerCert = types.StringValue(token)
+		}
+	}
+
+	listValue, diagResult := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HsmThaleslunagroupThaleslunaAttrTypes}, devices)
+	diag.Append(diagResult...)
+	if diag.HasError() {
+		return false
+	}
+
+	data.Thalesluna = listValue
+	return true
+}
+
+func (r *HsmThaleslunagroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,338 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForHsmThaleslunagroup = "comment,group_sn,hsm_version,name,status,thalesluna"
+
+func TestAccHsmThaleslunagroupResource_basic(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.120.0.10",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem"),
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7", "pass-phrase-1", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "hsm_version", "Luna_7"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.name", "10.120.0.10"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.partition_serial_number", "1234567"),
+					resource.TestCheckResourceAttrSet(resourceName, "thalesluna.0.server_cert"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_disappears(t *testing.T) {
+	resourceName := "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.120.0.11",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem"),
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckHsmThaleslunagroupDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7", "pass-phrase-1", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					testAccCheckHsmThaleslunagroupDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_Comment(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test_comment"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.120.0.12",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem"),
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupComment(name, thalesluna, "Thales Luna HSM group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Thales Luna HSM group"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupComment(name, thalesluna, "Updated Thales Luna HSM group"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Updated Thales Luna HSM group"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_Name(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name1 := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	name2 := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.120.0.13",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem"),
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name1, "Luna_7", "pass-phrase-1", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name2, "Luna_7", "pass-phrase-1", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_PassPhrase(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.120.0.14",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem"),
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7", "pass-phrase-1", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase", "pass-phrase-1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7", "pass-phrase-2", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pass_phrase", "pass-phrase-2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccHsmThaleslunagroupResource_Thalesluna(t *testing.T) {
+	var resourceName = "nios_security_hsm_thaleslunagroup.test"
+	var v security.HsmThaleslunagroup
+	name := acctest.RandomNameWithPrefix("hsm-thaleslunagroup")
+	thalesluna := []map[string]any{
+		{
+			"name":                    "10.120.0.15",
+			"partition_serial_number": "1234567",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server.pem"),
+		},
+	}
+	thaleslunaUpdated := []map[string]any{
+		{
+			"name":                    "10.120.0.16",
+			"partition_serial_number": "7654321",
+			"server_cert_file_path":   filepath.Join(getHsmThaleslunagroupTestDataPath(), "server_updated.pem"),
+			"disable":                 true,
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7", "pass-phrase-1", thalesluna),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.name", "10.120.0.15"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.partition_serial_number", "1234567"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccHsmThaleslunagroupBasicConfig(name, "Luna_7", "pass-phrase-1", thaleslunaUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHsmThaleslunagroupExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.name", "10.120.0.16"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.partition_serial_number", "7654321"),
+					resource.TestCheckResourceAttr(resourceName, "thalesluna.0.disable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "thalesluna.0.server_cert"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckHsmThaleslunagroupExists(ctx context.Context, resourceName string, v *security.HsmThaleslunagroup) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForHsmThaleslunagroup).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetHsmThaleslunagroupResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetHsmThaleslunagroupResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckHsmThaleslunagroupDestroy(ctx context.Context, v *security.HsmThaleslunagroup) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForHsmThaleslunagroup).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckHsmThaleslunagroupDisappears(ctx context.Context, v *security.HsmThaleslunagroup) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			HsmThaleslunagroupAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccHsmThaleslunagroupBasicConfig(name, hsmVersion, passPhrase string, thalesluna []map[string]any) string {
+	thaleslunaString := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test" {
+	name = %q
+	hsm_version = %q
+	pass_phrase = %q
+	thalesluna = %s
+}
+`, name, hsmVersion, passPhrase, thaleslunaString)
+}
+
+func testAccHsmThaleslunagroupComment(name string, thalesluna []map[string]any, comment string) string {
+	thaleslunaString := utils.ConvertSliceOfMapsToHCL(thalesluna)
+	return fmt.Sprintf(`
+resource "nios_security_hsm_thaleslunagroup" "test_comment" {
+	name = %q
+	hsm_version = "Luna_7"
+	pass_phrase = "pass-phrase-1"
+	thalesluna = %s
+	comment = %q
+}
+`, name, thaleslunaString, comment)
+}
+
+func getHsmThaleslunagroupTestDataPath() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "../../testdata/nios_security_hsm_thaleslunagroup"
+	}
+	return filepath.Join(wd, "../../testdata/nios_security_hsm_thaleslunagroup")
+}
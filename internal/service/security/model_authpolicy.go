@@ -0,0 +1,106 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type AuthpolicyModel struct {
+	Ref          types.String `tfsdk:"ref"`
+	AdminGroups  types.List   `tfsdk:"admin_groups"`
+	AuthServices types.List   `tfsdk:"auth_services"`
+	DefaultGroup types.String `tfsdk:"default_group"`
+	UsageType    types.String `tfsdk:"usage_type"`
+}
+
+var AuthpolicyAttrTypes = map[string]attr.Type{
+	"ref":           types.StringType,
+	"admin_groups":  types.ListType{ElemType: types.StringType},
+	"auth_services": types.ListType{ElemType: types.StringType},
+	"default_group": types.StringType,
+	"usage_type":    types.StringType,
+}
+
+var AuthpolicyResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"admin_groups": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "List of names of local administration groups that are mapped to remote administration groups.",
+	},
+	"auth_services": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The ordered list of references to the local user, LDAP, RADIUS, TACACS+, Active Directory, certificate and SAML authentication services used to authenticate admins. Services are tried in the order of the list.",
+	},
+	"default_group": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The default admin group that provides authentication in case no valid group is found.",
+	},
+	"usage_type": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("FULL", "AUTH_ONLY"),
+		},
+		MarkdownDescription: "Remote policies usage.",
+	},
+}
+
+func (m *AuthpolicyModel) Expand(ctx context.Context, diags *diag.Diagnostics) *security.Authpolicy {
+	if m == nil {
+		return nil
+	}
+	to := &security.Authpolicy{
+		AdminGroups:  flex.ExpandFrameworkListString(ctx, m.AdminGroups, diags),
+		AuthServices: flex.ExpandFrameworkListString(ctx, m.AuthServices, diags),
+		DefaultGroup: flex.ExpandStringPointer(m.DefaultGroup),
+		UsageType:    flex.ExpandStringPointer(m.UsageType),
+	}
+	return to
+}
+
+func FlattenAuthpolicy(ctx context.Context, from *security.Authpolicy, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(AuthpolicyAttrTypes)
+	}
+	m := AuthpolicyModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, AuthpolicyAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *AuthpolicyModel) Flatten(ctx context.Context, from *security.Authpolicy, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = AuthpolicyModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AdminGroups = flex.FlattenFrameworkListString(ctx, from.AdminGroups, diags)
+	m.AuthServices = flex.FlattenFrameworkListString(ctx, from.AuthServices, diags)
+	m.DefaultGroup = flex.FlattenStringPointer(from.DefaultGroup)
+	m.UsageType = flex.FlattenStringPointer(from.UsageType)
+}
@@ -0,0 +1,68 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type HsmAllgroupsModel struct {
+	Ref    types.String `tfsdk:"ref"`
+	Groups types.List   `tfsdk:"groups"`
+}
+
+var HsmAllgroupsAttrTypes = map[string]attr.Type{
+	"ref":    types.StringType,
+	"groups": types.ListType{ElemType: types.StringType},
+}
+
+var HsmAllgroupsResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"groups": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Required:            true,
+		MarkdownDescription: "The list of HSM groups configured on the appliance.",
+	},
+}
+
+func (m *HsmAllgroupsModel) Expand(ctx context.Context, diags *diag.Diagnostics) *security.HsmAllgroups {
+	if m == nil {
+		return nil
+	}
+	to := &security.HsmAllgroups{
+		Groups: flex.ExpandFrameworkListString(ctx, m.Groups, diags),
+	}
+	return to
+}
+
+func FlattenHsmAllgroups(ctx context.Context, from *security.HsmAllgroups, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(HsmAllgroupsAttrTypes)
+	}
+	m := HsmAllgroupsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, HsmAllgroupsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *HsmAllgroupsModel) Flatten(ctx context.Context, from *security.HsmAllgroups, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = HsmAllgroupsModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Groups = flex.FlattenFrameworkListString(ctx, from.Groups, diags)
+}
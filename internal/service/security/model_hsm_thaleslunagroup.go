@@ -0,0 +1,158 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type HsmThaleslunagroupModel struct {
+	Ref        types.String `tfsdk:"ref"`
+	Comment    types.String `tfsdk:"comment"`
+	GroupSn    types.String `tfsdk:"group_sn"`
+	HsmVersion types.String `tfsdk:"hsm_version"`
+	Name       types.String `tfsdk:"name"`
+	PassPhrase types.String `tfsdk:"pass_phrase"`
+	Status     types.String `tfsdk:"status"`
+	Thalesluna types.List   `tfsdk:"thalesluna"`
+}
+
+var HsmThaleslunagroupAttrTypes = map[string]attr.Type{
+	"ref":         types.StringType,
+	"comment":     types.StringType,
+	"group_sn":    types.StringType,
+	"hsm_version": types.StringType,
+	"name":        types.StringType,
+	"pass_phrase": types.StringType,
+	"status":      types.StringType,
+	"thalesluna":  types.ListType{ElemType: types.ObjectType{AttrTypes: HsmThaleslunagroupThaleslunaAttrTypes}},
+}
+
+var HsmThaleslunagroupResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The HSM Thales Luna group comment.",
+	},
+	"group_sn": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The HSM Thales Luna group serial number.",
+	},
+	"hsm_version": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The HSM Thales Luna version.",
+	},
+	"name": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The HSM Thales Luna group name.",
+	},
+	"pass_phrase": schema.StringAttribute{
+		Required:            true,
+		Sensitive:           true,
+		MarkdownDescription: "The pass phrase used to unlock the HSM Thales Luna keystore.",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The status of all HSM Thales Luna devices in the group.",
+	},
+	"thalesluna": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: HsmThaleslunagroupThaleslunaResourceSchemaAttributes,
+		},
+		Required:            true,
+		MarkdownDescription: "The list of HSM Thales Luna devices.",
+	},
+}
+
+func (m *HsmThaleslunagroupModel) Expand(ctx context.Context, diags *diag.Diagnostics) *security.HsmThaleslunagroup {
+	if m == nil {
+		return nil
+	}
+	to := &security.HsmThaleslunagroup{
+		Comment:    flex.ExpandStringPointer(m.Comment),
+		HsmVersion: flex.ExpandStringPointer(m.HsmVersion),
+		Name:       flex.ExpandStringPointer(m.Name),
+		PassPhrase: flex.ExpandStringPointer(m.PassPhrase),
+		Thalesluna: flex.ExpandFrameworkListNestedBlock(ctx, m.Thalesluna, diags, ExpandHsmThaleslunagroupThalesluna),
+	}
+	return to
+}
+
+func FlattenHsmThaleslunagroup(ctx context.Context, from *security.HsmThaleslunagroup, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(HsmThaleslunagroupAttrTypes)
+	}
+	m := HsmThaleslunagroupModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, HsmThaleslunagroupAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *HsmThaleslunagroupModel) Flatten(ctx context.Context, from *security.HsmThaleslunagroup, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = HsmThaleslunagroupModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.GroupSn = flex.FlattenStringPointer(from.GroupSn)
+	m.HsmVersion = flex.FlattenStringPointer(from.HsmVersion)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Status = flex.FlattenStringPointer(from.Status)
+	// Get flattened devices from API response and preserve server certificate file paths
+	flattenedDevices := flex.FlattenFrameworkListNestedBlock(ctx, from.Thalesluna, HsmThaleslunagroupThaleslunaAttrTypes, diags, FlattenHsmThaleslunagroupThalesluna)
+	m.Thalesluna = preserveThaleslunaServerCertPaths(ctx, m.Thalesluna, flattenedDevices, diags)
+}
+
+func preserveThaleslunaServerCertPaths(ctx context.Context,
+	originalDevicesList types.List,
+	flattenedDevicesList types.List,
+	diags *diag.Diagnostics) types.List {
+
+	// Exit early if there are no devices to process
+	if originalDevicesList.IsNull() || originalDevicesList.IsUnknown() ||
+		flattenedDevicesList.IsNull() || flattenedDevicesList.IsUnknown() {
+		return flattenedDevicesList
+	}
+
+	// Extract original devices
+	var originalDevices []HsmThaleslunagroupThaleslunaModel
+	diags.Append(originalDevicesList.ElementsAs(ctx, &originalDevices, false)...)
+
+	// Extract flattened devices
+	var updatedDevices []HsmThaleslunagroupThaleslunaModel
+	diags.Append(flattenedDevicesList.ElementsAs(ctx, &updatedDevices, false)...)
+
+	// Update each device with its corresponding file path and token, if available
+	for i := range updatedDevices {
+		if i < len(originalDevices) {
+			updatedDevices[i].ServerCertFilePath = originalDevices[i].ServerCertFilePath
+			if updatedDevices[i].ServerCert.IsNull() {
+				updatedDevices[i].ServerCert = originalDevices[i].ServerCert
+			}
+		}
+	}
+
+	// Create updated list value
+	if len(updatedDevices) > 0 {
+		updatedList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HsmThaleslunagroupThaleslunaAttrTypes}, updatedDevices)
+		diags.Append(d...)
+		return updatedList
+	}
+
+	return flattenedDevicesList
+}
@@ -0,0 +1,71 @@
+package security
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type LocaluserAuthserviceModel struct {
+	Ref      types.String `tfsdk:"ref"`
+	Comment  types.String `tfsdk:"comment"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Name     types.String `tfsdk:"name"`
+}
+
+var LocaluserAuthserviceAttrTypes = map[string]attr.Type{
+	"ref":      types.StringType,
+	"comment":  types.StringType,
+	"disabled": types.BoolType,
+	"name":     types.StringType,
+}
+
+var LocaluserAuthserviceResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The local user authentication service comment.",
+	},
+	"disabled": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Flag that indicates whether the local user authentication service is enabled or not.",
+	},
+	"name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the local user authentication service.",
+	},
+}
+
+func FlattenLocaluserAuthservice(ctx context.Context, from *security.LocaluserAuthservice, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(LocaluserAuthserviceAttrTypes)
+	}
+	m := LocaluserAuthserviceModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, LocaluserAuthserviceAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *LocaluserAuthserviceModel) Flatten(ctx context.Context, from *security.LocaluserAuthservice, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = LocaluserAuthserviceModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disabled = types.BoolPointerValue(from.Disabled)
+	m.Name = flex.FlattenStringPointer(from.Name)
+}
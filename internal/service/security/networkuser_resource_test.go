@@ -0,0 +1,308 @@
+package security_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/security"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForNetworkuser = "address,address_object,data_source,data_source_ip,domainname,first_seen_time,guid,last_seen_time,last_updated_time,logon_id,logout_time,name,network,network_view,user_status"
+
+func TestAccNetworkuserResource_basic(t *testing.T) {
+	var resourceName = "nios_security_network_user.test"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name, "10.20.0.1", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "address", "10.20.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "domainname", "example.com"),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "network_view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "data_source", "API"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_disappears(t *testing.T) {
+	resourceName := "nios_security_network_user.test"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkuserDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkuserBasicConfig(name, "10.20.0.2", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					testAccCheckNetworkuserDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Address(t *testing.T) {
+	var resourceName = "nios_security_network_user.test"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name, "10.20.0.3", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address", "10.20.0.3"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name, "10.20.0.4", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address", "10.20.0.4"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Domainname(t *testing.T) {
+	var resourceName = "nios_security_network_user.test"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name, "10.20.0.5", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domainname", "example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name, "10.20.0.5", "corp.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domainname", "corp.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Guid(t *testing.T) {
+	var resourceName = "nios_security_network_user.test_guid"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserGuid(name, "10.20.0.6", "S-1-5-21-1004"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guid", "S-1-5-21-1004"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserGuid(name, "10.20.0.6", "S-1-5-21-1005"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guid", "S-1-5-21-1005"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_LogonId(t *testing.T) {
+	var resourceName = "nios_security_network_user.test_logon_id"
+	var v security.Networkuser
+	name := acctest.RandomNameWithPrefix("network-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserLogonId(name, "10.20.0.7", "logon-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "logon_id", "logon-1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserLogonId(name, "10.20.0.7", "logon-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "logon_id", "logon-2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkuserResource_Name(t *testing.T) {
+	var resourceName = "nios_security_network_user.test"
+	var v security.Networkuser
+	name1 := acctest.RandomNameWithPrefix("network-user")
+	name2 := acctest.RandomNameWithPrefix("network-user")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name1, "10.20.0.8", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkuserBasicConfig(name2, "10.20.0.8", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkuserExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckNetworkuserExists(ctx context.Context, resourceName string, v *security.Networkuser) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.SecurityAPI.
+			NetworkuserAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForNetworkuser).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetNetworkuserResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetNetworkuserResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckNetworkuserDestroy(ctx context.Context, v *security.Networkuser) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.SecurityAPI.
+			NetworkuserAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForNetworkuser).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckNetworkuserDisappears(ctx context.Context, v *security.Networkuser) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.SecurityAPI.
+			NetworkuserAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccNetworkuserBasicConfig(name, address, domainname string) string {
+	return fmt.Sprintf(`
+resource "nios_security_network_user" "test" {
+	name = %q
+	address = %q
+	domainname = %q
+}
+`, name, address, domainname)
+}
+
+func testAccNetworkuserGuid(name, address, guid string) string {
+	return fmt.Sprintf(`
+resource "nios_security_network_user" "test_guid" {
+	name = %q
+	address = %q
+	domainname = "example.com"
+	guid = %q
+}
+`, name, address, guid)
+}
+
+func testAccNetworkuserLogonId(name, address, logonId string) string {
+	return fmt.Sprintf(`
+resource "nios_security_network_user" "test_logon_id" {
+	name = %q
+	address = %q
+	domainname = "example.com"
+	logon_id = %q
+}
+`, name, address, logonId)
+}
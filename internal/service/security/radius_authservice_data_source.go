@@ -132,7 +132,7 @@ func (d *RadiusAuthserviceDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForRadiusAuthservice).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
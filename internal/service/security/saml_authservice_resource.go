@@ -17,6 +17,7 @@ import (
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -35,6 +36,12 @@ type SamlAuthserviceResource struct {
 	client *niosclient.APIClient
 }
 
+// SamlAuthserviceResourceModel describes the resource data model, extending SamlAuthserviceModel with the operation timeouts.
+type SamlAuthserviceResourceModel struct {
+	SamlAuthserviceModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SamlAuthserviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "security_saml_authservice"
 }
@@ -43,6 +50,7 @@ func (r *SamlAuthserviceResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an SAML Authservice.",
 		Attributes:          SamlAuthserviceResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -67,7 +75,7 @@ func (r *SamlAuthserviceResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *SamlAuthserviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data SamlAuthserviceModel
+	var data SamlAuthserviceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -88,7 +96,7 @@ func (r *SamlAuthserviceResource) Create(ctx context.Context, req resource.Creat
 
 	var apiRes *security.CreateSamlAuthserviceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -129,7 +137,7 @@ func (r *SamlAuthserviceResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *SamlAuthserviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data SamlAuthserviceModel
+	var data SamlAuthserviceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -145,14 +153,14 @@ func (r *SamlAuthserviceResource) Read(ctx context.Context, req resource.ReadReq
 		apiRes  *security.GetSamlAuthserviceResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.SecurityAPI.
 			SamlAuthserviceAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSamlAuthservice).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -182,7 +190,7 @@ func (r *SamlAuthserviceResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *SamlAuthserviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SamlAuthserviceModel
+	var data SamlAuthserviceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -210,7 +218,7 @@ func (r *SamlAuthserviceResource) Update(ctx context.Context, req resource.Updat
 
 	var apiRes *security.UpdateSamlAuthserviceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -243,7 +251,7 @@ func (r *SamlAuthserviceResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *SamlAuthserviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SamlAuthserviceModel
+	var data SamlAuthserviceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -254,7 +262,7 @@ func (r *SamlAuthserviceResource) Delete(ctx context.Context, req resource.Delet
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.SecurityAPI.
 			SamlAuthserviceAPI.
 			Delete(ctx, resourceRef).
@@ -281,16 +289,14 @@ func (r *SamlAuthserviceResource) ImportState(ctx context.Context, req resource.
 
 func (r *SamlAuthserviceResource) processIdpMetadata(
 	ctx context.Context,
-	data *SamlAuthserviceModel,
+	data *SamlAuthserviceResourceModel,
 	diag *diag.Diagnostics,
 ) bool {
 	if data.Idp.IsNull() || data.Idp.IsUnknown() {
 		return true
 	}
 
-	baseUrl := r.client.SecurityAPI.Cfg.NIOSHostURL
-	username := r.client.SecurityAPI.Cfg.NIOSUsername
-	password := r.client.SecurityAPI.Cfg.NIOSPassword
+	baseUrl := utils.WAPIBaseURL(r.client.SecurityAPI.Cfg)
 
 	var idp SamlAuthserviceIdpModel
 	diagResult := data.Idp.As(ctx, &idp, basetypes.ObjectAsOptions{})
@@ -301,7 +307,7 @@ func (r *SamlAuthserviceResource) processIdpMetadata(
 
 	if !idp.MetadataFilePath.IsNull() && !idp.MetadataFilePath.IsUnknown() {
 		filePath := idp.MetadataFilePath.ValueString()
-		token, err := utils.UploadFileWithToken(ctx, baseUrl, filePath, username, password)
+		token, err := utils.UploadFileWithToken(ctx, r.client.SecurityAPI.Cfg.HTTPClient, baseUrl, filePath)
 		if err != nil {
 			diag.AddError(
 				"Client Error",
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/security"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -34,6 +36,12 @@ type SnmpuserResource struct {
 	client *niosclient.APIClient
 }
 
+// SnmpuserResourceModel describes the resource data model, extending SnmpuserModel with the operation timeouts.
+type SnmpuserResourceModel struct {
+	SnmpuserModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SnmpuserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "security_snmp_user"
 }
@@ -42,6 +50,7 @@ func (r *SnmpuserResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an SNMPv3 User.",
 		Attributes:          SnmpuserResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -66,7 +75,7 @@ func (r *SnmpuserResource) Configure(ctx context.Context, req resource.Configure
 }
 
 func (r *SnmpuserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data SnmpuserModel
+	var data SnmpuserResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -109,7 +118,7 @@ func (r *SnmpuserResource) ValidateConfig(ctx context.Context, req resource.Vali
 
 func (r *SnmpuserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var diags diag.Diagnostics
-	var data SnmpuserModel
+	var data SnmpuserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -131,7 +140,7 @@ func (r *SnmpuserResource) Create(ctx context.Context, req resource.CreateReques
 
 	var apiRes *security.CreateSnmpuserResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -178,7 +187,7 @@ func (r *SnmpuserResource) Create(ctx context.Context, req resource.CreateReques
 
 func (r *SnmpuserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var diags diag.Diagnostics
-	var data SnmpuserModel
+	var data SnmpuserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -200,14 +209,14 @@ func (r *SnmpuserResource) Read(ctx context.Context, req resource.ReadRequest, r
 		apiRes  *security.GetSnmpuserResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.SecurityAPI.
 			SnmpuserAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSnmpuser).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -262,7 +271,7 @@ func (r *SnmpuserResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *SnmpuserResource) ReadByExtAttrs(ctx context.Context, data *SnmpuserModel, resp *resource.ReadResponse) bool {
+func (r *SnmpuserResource) ReadByExtAttrs(ctx context.Context, data *SnmpuserResourceModel, resp *resource.ReadResponse) bool {
 	var diags diag.Diagnostics
 
 	if data.ExtAttrsAll.IsNull() {
@@ -289,7 +298,7 @@ func (r *SnmpuserResource) ReadByExtAttrs(ctx context.Context, data *SnmpuserMod
 		Extattrfilter(idMap).
 		ReturnAsObject(1).
 		ReturnFieldsPlus(readableAttributesForSnmpuser).
-		ProxySearch(config.GetProxySearch()).
+		ProxySearch(config.GetProxySearch(ctx)).
 		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Snmpuser by extattrs, got error: %s", err))
@@ -320,7 +329,7 @@ func (r *SnmpuserResource) ReadByExtAttrs(ctx context.Context, data *SnmpuserMod
 
 func (r *SnmpuserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SnmpuserModel
+	var data SnmpuserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -369,7 +378,7 @@ func (r *SnmpuserResource) Update(ctx context.Context, req resource.UpdateReques
 
 	var apiRes *security.UpdateSnmpuserResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -412,7 +421,7 @@ func (r *SnmpuserResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *SnmpuserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SnmpuserModel
+	var data SnmpuserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -423,7 +432,7 @@ func (r *SnmpuserResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.SecurityAPI.
 			SnmpuserAPI.
 			Delete(ctx, resourceRef).
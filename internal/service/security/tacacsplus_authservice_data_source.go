@@ -132,7 +132,7 @@ func (d *TacacsplusAuthserviceDataSource) Read(ctx context.Context, req datasour
 				ReturnFieldsPlus(readableAttributesForTacacsplusAuthservice).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/security"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type TacacsplusAuthserviceResource struct {
 	client *niosclient.APIClient
 }
 
+// TacacsplusAuthserviceResourceModel describes the resource data model, extending TacacsplusAuthserviceModel with the operation timeouts.
+type TacacsplusAuthserviceResourceModel struct {
+	TacacsplusAuthserviceModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *TacacsplusAuthserviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "security_tacacsplus_authservice"
 }
@@ -41,6 +49,7 @@ func (r *TacacsplusAuthserviceResource) Schema(ctx context.Context, req resource
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Tacacsplus Authentication Service.",
 		Attributes:          TacacsplusAuthserviceResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -65,7 +74,7 @@ func (r *TacacsplusAuthserviceResource) Configure(ctx context.Context, req resou
 }
 
 func (r *TacacsplusAuthserviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data TacacsplusAuthserviceModel
+	var data TacacsplusAuthserviceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -81,7 +90,7 @@ func (r *TacacsplusAuthserviceResource) Create(ctx context.Context, req resource
 
 	var apiRes *security.CreateTacacsplusAuthserviceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -122,7 +131,7 @@ func (r *TacacsplusAuthserviceResource) Create(ctx context.Context, req resource
 }
 
 func (r *TacacsplusAuthserviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data TacacsplusAuthserviceModel
+	var data TacacsplusAuthserviceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -138,14 +147,14 @@ func (r *TacacsplusAuthserviceResource) Read(ctx context.Context, req resource.R
 		apiRes  *security.GetTacacsplusAuthserviceResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.SecurityAPI.
 			TacacsplusAuthserviceAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForTacacsplusAuthservice).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -175,7 +184,7 @@ func (r *TacacsplusAuthserviceResource) Read(ctx context.Context, req resource.R
 
 func (r *TacacsplusAuthserviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data TacacsplusAuthserviceModel
+	var data TacacsplusAuthserviceResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -199,7 +208,7 @@ func (r *TacacsplusAuthserviceResource) Update(ctx context.Context, req resource
 
 	var apiRes *security.UpdateTacacsplusAuthserviceResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -232,7 +241,7 @@ func (r *TacacsplusAuthserviceResource) Update(ctx context.Context, req resource
 }
 
 func (r *TacacsplusAuthserviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data TacacsplusAuthserviceModel
+	var data TacacsplusAuthserviceResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -243,7 +252,7 @@ func (r *TacacsplusAuthserviceResource) Delete(ctx context.Context, req resource
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.SecurityAPI.
 			TacacsplusAuthserviceAPI.
 			Delete(ctx, resourceRef).
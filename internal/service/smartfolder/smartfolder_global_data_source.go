@@ -132,7 +132,7 @@ func (d *SmartfolderGlobalDataSource) Read(ctx context.Context, req datasource.R
 				ReturnFieldsPlus(readableAttributesForSmartfolderGlobal).
 				Paging(paging).
 				MaxResults(maxResults).
-				ProxySearch(config.GetProxySearch())
+				ProxySearch(config.GetProxySearch(ctx))
 
 			// Add page ID if provided
 			if pageID != "" {
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
 	"github.com/infobloxopen/infoblox-nios-go-client/smartfolder"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/config"
 	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -33,6 +35,12 @@ type SmartfolderGlobalResource struct {
 	client *niosclient.APIClient
 }
 
+// SmartfolderGlobalResourceModel describes the resource data model, extending SmartfolderGlobalModel with the operation timeouts.
+type SmartfolderGlobalResourceModel struct {
+	SmartfolderGlobalModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
 func (r *SmartfolderGlobalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + "smartfolder_global"
 }
@@ -41,6 +49,7 @@ func (r *SmartfolderGlobalResource) Schema(ctx context.Context, req resource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Global Smartfolder.",
 		Attributes:          SmartfolderGlobalResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
 	}
 }
 
@@ -65,7 +74,7 @@ func (r *SmartfolderGlobalResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *SmartfolderGlobalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data SmartfolderGlobalModel
+	var data SmartfolderGlobalResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -81,7 +90,7 @@ func (r *SmartfolderGlobalResource) Create(ctx context.Context, req resource.Cre
 
 	var apiRes *smartfolder.CreateSmartfolderGlobalResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -122,7 +131,7 @@ func (r *SmartfolderGlobalResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *SmartfolderGlobalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data SmartfolderGlobalModel
+	var data SmartfolderGlobalResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -138,14 +147,14 @@ func (r *SmartfolderGlobalResource) Read(ctx context.Context, req resource.ReadR
 		apiRes  *smartfolder.GetSmartfolderGlobalResponse
 	)
 
-	err := retry.Do(ctx, nil, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
 		var callErr error
 		apiRes, httpRes, callErr = r.client.SmartFolderAPI.
 			SmartfolderGlobalAPI.
 			Read(ctx, resourceRef).
 			ReturnFieldsPlus(readableAttributesForSmartfolderGlobal).
 			ReturnAsObject(1).
-			ProxySearch(config.GetProxySearch()).
+			ProxySearch(config.GetProxySearch(ctx)).
 			Execute()
 
 		if httpRes != nil {
@@ -175,7 +184,7 @@ func (r *SmartfolderGlobalResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *SmartfolderGlobalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var diags diag.Diagnostics
-	var data SmartfolderGlobalModel
+	var data SmartfolderGlobalResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -199,7 +208,7 @@ func (r *SmartfolderGlobalResource) Update(ctx context.Context, req resource.Upd
 
 	var apiRes *smartfolder.UpdateSmartfolderGlobalResponse
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		var (
 			httpRes *http.Response
 			callErr error
@@ -232,7 +241,7 @@ func (r *SmartfolderGlobalResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *SmartfolderGlobalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data SmartfolderGlobalModel
+	var data SmartfolderGlobalResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -243,7 +252,7 @@ func (r *SmartfolderGlobalResource) Delete(ctx context.Context, req resource.Del
 
 	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
 
-	err := retry.Do(ctx, retry.TransientErrors, func(ctx context.Context) (int, error) {
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
 		httpRes, callErr := r.client.SmartFolderAPI.
 			SmartfolderGlobalAPI.
 			Delete(ctx, resourceRef).
@@ -0,0 +1,106 @@
+package threatinsight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatinsight"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type ThreatinsightAllowlistModel struct {
+	Ref     types.String `tfsdk:"ref"`
+	Comment types.String `tfsdk:"comment"`
+	Disable types.Bool   `tfsdk:"disable"`
+	Fqdn    types.String `tfsdk:"fqdn"`
+	Type    types.String `tfsdk:"type"`
+}
+
+var ThreatinsightAllowlistAttrTypes = map[string]attr.Type{
+	"ref":     types.StringType,
+	"comment": types.StringType,
+	"disable": types.BoolType,
+	"fqdn":    types.StringType,
+	"type":    types.StringType,
+}
+
+var ThreatinsightAllowlistResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+			stringvalidator.LengthAtMost(256),
+		},
+		MarkdownDescription: "The descriptive comment for the threat insight allowlist.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines whether the threat insight allowlist is disabled.",
+	},
+	"fqdn": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The FQDN of the threat insight allowlist.",
+	},
+	"type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The type of the threat insight allowlist.",
+	},
+}
+
+func (m *ThreatinsightAllowlistModel) Expand(ctx context.Context, diags *diag.Diagnostics) *threatinsight.ThreatinsightAllowlist {
+	if m == nil {
+		return nil
+	}
+	to := &threatinsight.ThreatinsightAllowlist{
+		Comment: flex.ExpandStringPointer(m.Comment),
+		Disable: flex.ExpandBoolPointer(m.Disable),
+		Fqdn:    flex.ExpandStringPointer(m.Fqdn),
+	}
+	return to
+}
+
+func FlattenThreatinsightAllowlist(ctx context.Context, from *threatinsight.ThreatinsightAllowlist, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ThreatinsightAllowlistAttrTypes)
+	}
+	m := ThreatinsightAllowlistModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ThreatinsightAllowlistAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ThreatinsightAllowlistModel) Flatten(ctx context.Context, from *threatinsight.ThreatinsightAllowlist, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ThreatinsightAllowlistModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.Fqdn = flex.FlattenStringPointer(from.Fqdn)
+	m.Type = flex.FlattenStringPointer(from.Type)
+}
@@ -0,0 +1,99 @@
+package threatinsight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatinsight"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type ThreatinsightCloudclientModel struct {
+	Ref              types.String `tfsdk:"ref"`
+	BlacklistRpzList types.List   `tfsdk:"blacklist_rpz_list"`
+	Enable           types.Bool   `tfsdk:"enable"`
+	ForceRefresh     types.Bool   `tfsdk:"force_refresh"`
+	Interval         types.Int64  `tfsdk:"interval"`
+}
+
+var ThreatinsightCloudclientAttrTypes = map[string]attr.Type{
+	"ref":                types.StringType,
+	"blacklist_rpz_list": types.ListType{ElemType: types.StringType},
+	"enable":             types.BoolType,
+	"force_refresh":      types.BoolType,
+	"interval":           types.Int64Type,
+}
+
+var ThreatinsightCloudclientResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"blacklist_rpz_list": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The RPZs to which you apply newly detected domains through the Infoblox Threat Insight Cloud Client.",
+	},
+	"enable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines whether the Threat Insight in Cloud Client is enabled.",
+	},
+	"force_refresh": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Force a refresh if at least one RPZ is configured. The refresh is requested each time the settings are created or updated with this flag set.",
+	},
+	"interval": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		MarkdownDescription: "The time interval (in seconds) for requesting newly detected domains by the Infoblox Threat Insight Cloud Client and applying them to the list of configured RPZs.",
+	},
+}
+
+func (m *ThreatinsightCloudclientModel) Expand(ctx context.Context, diags *diag.Diagnostics) *threatinsight.ThreatinsightCloudclient {
+	if m == nil {
+		return nil
+	}
+	to := &threatinsight.ThreatinsightCloudclient{
+		BlacklistRpzList: flex.ExpandFrameworkListString(ctx, m.BlacklistRpzList, diags),
+		Enable:           flex.ExpandBoolPointer(m.Enable),
+		ForceRefresh:     flex.ExpandBoolPointer(m.ForceRefresh),
+		Interval:         flex.ExpandInt64Pointer(m.Interval),
+	}
+	return to
+}
+
+func FlattenThreatinsightCloudclient(ctx context.Context, from *threatinsight.ThreatinsightCloudclient, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ThreatinsightCloudclientAttrTypes)
+	}
+	m := ThreatinsightCloudclientModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ThreatinsightCloudclientAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ThreatinsightCloudclientModel) Flatten(ctx context.Context, from *threatinsight.ThreatinsightCloudclient, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ThreatinsightCloudclientModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.BlacklistRpzList = flex.FlattenFrameworkListString(ctx, from.BlacklistRpzList, diags)
+	m.Enable = types.BoolPointerValue(from.Enable)
+	m.Interval = flex.FlattenInt64Pointer(from.Interval)
+}
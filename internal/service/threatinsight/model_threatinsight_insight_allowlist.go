@@ -0,0 +1,57 @@
+package threatinsight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatinsight"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type ThreatinsightInsightAllowlistModel struct {
+	Ref     types.String `tfsdk:"ref"`
+	Version types.String `tfsdk:"version"`
+}
+
+var ThreatinsightInsightAllowlistAttrTypes = map[string]attr.Type{
+	"ref":     types.StringType,
+	"version": types.StringType,
+}
+
+var ThreatinsightInsightAllowlistResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"version": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The version of the threat insight allowlist.",
+	},
+}
+
+func FlattenThreatinsightInsightAllowlist(ctx context.Context, from *threatinsight.ThreatinsightInsightAllowlist, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ThreatinsightInsightAllowlistAttrTypes)
+	}
+	m := ThreatinsightInsightAllowlistModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ThreatinsightInsightAllowlistAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ThreatinsightInsightAllowlistModel) Flatten(ctx context.Context, from *threatinsight.ThreatinsightInsightAllowlist, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ThreatinsightInsightAllowlistModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Version = flex.FlattenStringPointer(from.Version)
+}
@@ -0,0 +1,57 @@
+package threatinsight
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatinsight"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type ThreatinsightModulesetModel struct {
+	Ref     types.String `tfsdk:"ref"`
+	Version types.String `tfsdk:"version"`
+}
+
+var ThreatinsightModulesetAttrTypes = map[string]attr.Type{
+	"ref":     types.StringType,
+	"version": types.StringType,
+}
+
+var ThreatinsightModulesetResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"version": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The version number of the threat insight module set.",
+	},
+}
+
+func FlattenThreatinsightModuleset(ctx context.Context, from *threatinsight.ThreatinsightModuleset, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ThreatinsightModulesetAttrTypes)
+	}
+	m := ThreatinsightModulesetModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ThreatinsightModulesetAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ThreatinsightModulesetModel) Flatten(ctx context.Context, from *threatinsight.ThreatinsightModuleset, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ThreatinsightModulesetModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Version = flex.FlattenStringPointer(from.Version)
+}
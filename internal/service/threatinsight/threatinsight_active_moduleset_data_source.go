@@ -0,0 +1,104 @@
+package threatinsight
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ThreatinsightActiveModulesetDataSource{}
+
+func NewThreatinsightActiveModulesetDataSource() datasource.DataSource {
+	return &ThreatinsightActiveModulesetDataSource{}
+}
+
+// ThreatinsightActiveModulesetDataSource defines the data source implementation.
+// It reads the module set currently installed on the Grid, as reported by the Grid Threat Insight properties.
+type ThreatinsightActiveModulesetDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *ThreatinsightActiveModulesetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "threatinsight_active_moduleset"
+}
+
+func (d *ThreatinsightActiveModulesetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the Threat Insight module set currently installed on the Grid.",
+		Attributes:          utils.DataSourceAttributeMap(ThreatinsightModulesetResourceSchemaAttributes, &resp.Diagnostics),
+	}
+}
+
+func (d *ThreatinsightActiveModulesetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ThreatinsightActiveModulesetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ThreatinsightModulesetModel
+
+	gridRes, _, err := d.client.GridAPI.
+		GridThreatinsightAPI.
+		List(ctx).
+		ReturnFields("current_moduleset").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch(ctx)).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GridThreatinsight, got error: %s", err))
+		return
+	}
+
+	grids := gridRes.ListGridThreatinsightResponseObject.GetResult()
+	if len(grids) == 0 || grids[0].GetCurrentModuleset() == "" {
+		resp.Diagnostics.AddError("Client Error", "No Threat Insight module set is installed on the Grid")
+		return
+	}
+	version := grids[0].GetCurrentModuleset()
+
+	apiRes, _, err := d.client.ThreatInsightAPI.
+		ThreatinsightModulesetAPI.
+		List(ctx).
+		Filters(map[string]interface{}{"version": version}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForThreatinsightModuleset).
+		ProxySearch(config.GetProxySearch(ctx)).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ThreatinsightModuleset, got error: %s", err))
+		return
+	}
+
+	res := apiRes.ListThreatinsightModulesetResponseObject.GetResult()
+	if len(res) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the installed Threat Insight module set %s", version))
+		return
+	}
+
+	data.Flatten(ctx, &res[0], &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
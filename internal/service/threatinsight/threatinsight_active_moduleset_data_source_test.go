@@ -0,0 +1,43 @@
+package threatinsight_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccThreatinsightActiveModulesetDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_threatinsight_active_moduleset.test"
+	modulesetDataSourceName := "data.nios_threatinsight_moduleset.installed"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatinsightActiveModulesetDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", modulesetDataSourceName, "result.0.version"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccThreatinsightActiveModulesetDataSourceConfig() string {
+	return `
+data "nios_threatinsight_active_moduleset" "test" {}
+
+data "nios_threatinsight_moduleset" "installed" {
+  filters = {
+    version = data.nios_threatinsight_active_moduleset.test.version
+  }
+}
+`
+}
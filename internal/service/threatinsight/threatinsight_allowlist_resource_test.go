@@ -0,0 +1,249 @@
+package threatinsight_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatinsight"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForThreatinsightAllowlist = "comment,disable,fqdn,type"
+
+func TestAccThreatinsightAllowlistResource_basic(t *testing.T) {
+	var resourceName = "nios_threatinsight_allowlist.test"
+	var v threatinsight.ThreatinsightAllowlist
+	fqdn := acctest.RandomNameWithPrefix("allowlist") + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatinsightAllowlistBasicConfig(fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatinsightAllowlistResource_disappears(t *testing.T) {
+	resourceName := "nios_threatinsight_allowlist.test"
+	var v threatinsight.ThreatinsightAllowlist
+	fqdn := acctest.RandomNameWithPrefix("allowlist") + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckThreatinsightAllowlistDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatinsightAllowlistBasicConfig(fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					testAccCheckThreatinsightAllowlistDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccThreatinsightAllowlistResource_Comment(t *testing.T) {
+	var resourceName = "nios_threatinsight_allowlist.test_comment"
+	var v threatinsight.ThreatinsightAllowlist
+	fqdn := acctest.RandomNameWithPrefix("allowlist") + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatinsightAllowlistComment(fqdn, "This is a comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a comment"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatinsightAllowlistComment(fqdn, "This is an updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated comment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatinsightAllowlistResource_Disable(t *testing.T) {
+	var resourceName = "nios_threatinsight_allowlist.test_disable"
+	var v threatinsight.ThreatinsightAllowlist
+	fqdn := acctest.RandomNameWithPrefix("allowlist") + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatinsightAllowlistDisable(fqdn, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatinsightAllowlistDisable(fqdn, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatinsightAllowlistResource_Fqdn(t *testing.T) {
+	var resourceName = "nios_threatinsight_allowlist.test_fqdn"
+	var v threatinsight.ThreatinsightAllowlist
+	fqdn := acctest.RandomNameWithPrefix("allowlist") + ".example.com"
+	updatedFqdn := acctest.RandomNameWithPrefix("allowlist") + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatinsightAllowlistFqdn(fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatinsightAllowlistFqdn(updatedFqdn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightAllowlistExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", updatedFqdn),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckThreatinsightAllowlistExists(ctx context.Context, resourceName string, v *threatinsight.ThreatinsightAllowlist) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.ThreatInsightAPI.
+			ThreatinsightAllowlistAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForThreatinsightAllowlist).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetThreatinsightAllowlistResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetThreatinsightAllowlistResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckThreatinsightAllowlistDestroy(ctx context.Context, v *threatinsight.ThreatinsightAllowlist) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.ThreatInsightAPI.
+			ThreatinsightAllowlistAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForThreatinsightAllowlist).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckThreatinsightAllowlistDisappears(ctx context.Context, v *threatinsight.ThreatinsightAllowlist) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.ThreatInsightAPI.
+			ThreatinsightAllowlistAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccThreatinsightAllowlistBasicConfig(fqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_threatinsight_allowlist" "test" {
+  fqdn = %q
+}
+`, fqdn)
+}
+
+func testAccThreatinsightAllowlistComment(fqdn, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_threatinsight_allowlist" "test_comment" {
+  fqdn    = %q
+  comment = %q
+}
+`, fqdn, comment)
+}
+
+func testAccThreatinsightAllowlistDisable(fqdn, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_threatinsight_allowlist" "test_disable" {
+  fqdn    = %q
+  disable = %s
+}
+`, fqdn, disable)
+}
+
+func testAccThreatinsightAllowlistFqdn(fqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_threatinsight_allowlist" "test_fqdn" {
+  fqdn = %q
+}
+`, fqdn)
+}
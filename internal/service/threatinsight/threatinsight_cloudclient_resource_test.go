@@ -0,0 +1,106 @@
+package threatinsight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatinsight"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForThreatinsightCloudclient = "blacklist_rpz_list,enable,interval"
+
+func TestAccThreatinsightCloudclientResource_basic(t *testing.T) {
+	var resourceName = "nios_threatinsight_cloudclient.test"
+	var v threatinsight.ThreatinsightCloudclient
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatinsightCloudclientBasicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightCloudclientExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "enable"),
+					resource.TestCheckResourceAttrSet(resourceName, "interval"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatinsightCloudclientResource_Interval(t *testing.T) {
+	var resourceName = "nios_threatinsight_cloudclient.test_interval"
+	var v threatinsight.ThreatinsightCloudclient
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatinsightCloudclientInterval(600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightCloudclientExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "interval", "600"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatinsightCloudclientInterval(1200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatinsightCloudclientExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "interval", "1200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckThreatinsightCloudclientExists(ctx context.Context, resourceName string, v *threatinsight.ThreatinsightCloudclient) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.ThreatInsightAPI.
+			ThreatinsightCloudclientAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForThreatinsightCloudclient).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetThreatinsightCloudclientResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetThreatinsightCloudclientResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccThreatinsightCloudclientBasicConfig() string {
+	return `
+resource "nios_threatinsight_cloudclient" "test" {
+}
+`
+}
+
+func testAccThreatinsightCloudclientInterval(interval int) string {
+	return fmt.Sprintf(`
+resource "nios_threatinsight_cloudclient" "test_interval" {
+  interval = %d
+}
+`, interval)
+}
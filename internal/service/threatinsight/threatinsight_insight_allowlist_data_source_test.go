@@ -0,0 +1,35 @@
+package threatinsight_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccThreatinsightInsightAllowlistDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_threatinsight_insight_allowlist.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatinsightInsightAllowlistDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.version"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccThreatinsightInsightAllowlistDataSourceConfig() string {
+	return `
+data "nios_threatinsight_insight_allowlist" "test" {}
+`
+}
@@ -0,0 +1,35 @@
+package threatinsight_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccThreatinsightModulesetDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_threatinsight_moduleset.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatinsightModulesetDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.version"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccThreatinsightModulesetDataSourceConfig() string {
+	return `
+data "nios_threatinsight_moduleset" "test" {}
+`
+}
@@ -0,0 +1,101 @@
+package threatprotection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatprotection"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type ThreatprotectionGridRuleConfigModel struct {
+	Action      types.String `tfsdk:"action"`
+	LogSeverity types.String `tfsdk:"log_severity"`
+	Params      types.List   `tfsdk:"params"`
+}
+
+var ThreatprotectionGridRuleConfigAttrTypes = map[string]attr.Type{
+	"action":       types.StringType,
+	"log_severity": types.StringType,
+	"params":       types.ListType{ElemType: types.ObjectType{AttrTypes: ThreatprotectiongridruleconfigParamsAttrTypes}},
+}
+
+var ThreatprotectionGridRuleConfigResourceSchemaAttributes = map[string]schema.Attribute{
+	"action": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("ALERT", "DROP", "PASS"),
+		},
+		MarkdownDescription: "The rule action.",
+	},
+	"log_severity": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("CRITICAL", "INFORMATIONAL", "MAJOR", "WARNING"),
+		},
+		MarkdownDescription: "The rule log severity.",
+	},
+	"params": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ThreatprotectiongridruleconfigParamsResourceSchemaAttributes,
+		},
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The threat protection rule parameters.",
+	},
+}
+
+func ExpandThreatprotectionGridRuleConfig(ctx context.Context, o types.Object, diags *diag.Diagnostics) *threatprotection.ThreatprotectionGridRuleConfig {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m ThreatprotectionGridRuleConfigModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *ThreatprotectionGridRuleConfigModel) Expand(ctx context.Context, diags *diag.Diagnostics) *threatprotection.ThreatprotectionGridRuleConfig {
+	if m == nil {
+		return nil
+	}
+	to := &threatprotection.ThreatprotectionGridRuleConfig{
+		Action:      flex.ExpandStringPointer(m.Action),
+		LogSeverity: flex.ExpandStringPointer(m.LogSeverity),
+		Params:      flex.ExpandFrameworkListNestedBlock(ctx, m.Params, diags, ExpandThreatprotectiongridruleconfigParams),
+	}
+	return to
+}
+
+func FlattenThreatprotectionGridRuleConfig(ctx context.Context, from *threatprotection.ThreatprotectionGridRuleConfig, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ThreatprotectionGridRuleConfigAttrTypes)
+	}
+	m := ThreatprotectionGridRuleConfigModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ThreatprotectionGridRuleConfigAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ThreatprotectionGridRuleConfigModel) Flatten(ctx context.Context, from *threatprotection.ThreatprotectionGridRuleConfig, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ThreatprotectionGridRuleConfigModel{}
+	}
+	m.Action = flex.FlattenStringPointer(from.Action)
+	m.LogSeverity = flex.FlattenStringPointer(from.LogSeverity)
+	m.Params = flex.FlattenFrameworkListNestedBlock(ctx, from.Params, ThreatprotectiongridruleconfigParamsAttrTypes, diags, FlattenThreatprotectiongridruleconfigParams)
+}
@@ -0,0 +1,228 @@
+package threatprotection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatprotection"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+	importmod "github.com/infobloxopen/terraform-provider-nios/internal/planmodifiers/import"
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+type ThreatprotectionProfileModel struct {
+	Ref                             types.String `tfsdk:"ref"`
+	Comment                         types.String `tfsdk:"comment"`
+	CurrentRuleset                  types.String `tfsdk:"current_ruleset"`
+	DisableMultipleDnsTcpRequest    types.Bool   `tfsdk:"disable_multiple_dns_tcp_request"`
+	EventsPerSecondPerRule          types.Int64  `tfsdk:"events_per_second_per_rule"`
+	ExtAttrs                        types.Map    `tfsdk:"extattrs"`
+	ExtAttrsAll                     types.Map    `tfsdk:"extattrs_all"`
+	Members                         types.List   `tfsdk:"members"`
+	Name                            types.String `tfsdk:"name"`
+	SourceMember                    types.String `tfsdk:"source_member"`
+	SourceProfile                   types.String `tfsdk:"source_profile"`
+	UseCurrentRuleset               types.Bool   `tfsdk:"use_current_ruleset"`
+	UseDisableMultipleDnsTcpRequest types.Bool   `tfsdk:"use_disable_multiple_dns_tcp_request"`
+	UseEventsPerSecondPerRule       types.Bool   `tfsdk:"use_events_per_second_per_rule"`
+}
+
+var ThreatprotectionProfileAttrTypes = map[string]attr.Type{
+	"ref":                                  types.StringType,
+	"comment":                              types.StringType,
+	"current_ruleset":                      types.StringType,
+	"disable_multiple_dns_tcp_request":     types.BoolType,
+	"events_per_second_per_rule":           types.Int64Type,
+	"extattrs":                             types.MapType{ElemType: types.StringType},
+	"extattrs_all":                         types.MapType{ElemType: types.StringType},
+	"members":                              types.ListType{ElemType: types.StringType},
+	"name":                                 types.StringType,
+	"source_member":                        types.StringType,
+	"source_profile":                       types.StringType,
+	"use_current_ruleset":                  types.BoolType,
+	"use_disable_multiple_dns_tcp_request": types.BoolType,
+	"use_events_per_second_per_rule":       types.BoolType,
+}
+
+var ThreatprotectionProfileResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+			stringvalidator.LengthAtMost(256),
+		},
+		MarkdownDescription: "The comment for the Threat Protection profile.",
+	},
+	"current_ruleset": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("use_current_ruleset")),
+		},
+		MarkdownDescription: "The version of the ruleset used by the Threat Protection profile.",
+	},
+	"disable_multiple_dns_tcp_request": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("use_disable_multiple_dns_tcp_request")),
+		},
+		MarkdownDescription: "Determines if multiple BIND responses via TCP connection are disabled.",
+	},
+	"events_per_second_per_rule": schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("use_events_per_second_per_rule")),
+			int64validator.Between(0, 1000000),
+		},
+		MarkdownDescription: "The number of events logged per second per rule.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Extensible attributes associated with the object.",
+		ElementType:         types.StringType,
+		Default:             mapdefault.StaticValue(types.MapNull(types.StringType)),
+		Validators: []validator.Map{
+			mapvalidator.SizeAtLeast(1),
+		},
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		MarkdownDescription: "Extensible attributes associated with the object , including default attributes.",
+		ElementType:         types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			importmod.AssociateInternalId(),
+		},
+	},
+	"members": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The list of members that are associated with the profile.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			customvalidator.ValidateTrimmedString(),
+		},
+		MarkdownDescription: "The name of the Threat Protection profile.",
+	},
+	"source_member": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("source_profile")),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
+		MarkdownDescription: "The member whose profile settings and rules are cloned when the profile is created.",
+	},
+	"source_profile": schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
+		MarkdownDescription: "The profile whose settings and rules are cloned when the profile is created.",
+	},
+	"use_current_ruleset": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Use flag for: current_ruleset",
+	},
+	"use_disable_multiple_dns_tcp_request": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Use flag for: disable_multiple_dns_tcp_request",
+	},
+	"use_events_per_second_per_rule": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Use flag for: events_per_second_per_rule",
+	},
+}
+
+func (m *ThreatprotectionProfileModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *threatprotection.ThreatprotectionProfile {
+	if m == nil {
+		return nil
+	}
+	to := &threatprotection.ThreatprotectionProfile{
+		Comment:                         flex.ExpandStringPointer(m.Comment),
+		CurrentRuleset:                  flex.ExpandStringPointer(m.CurrentRuleset),
+		DisableMultipleDnsTcpRequest:    flex.ExpandBoolPointer(m.DisableMultipleDnsTcpRequest),
+		EventsPerSecondPerRule:          flex.ExpandInt64Pointer(m.EventsPerSecondPerRule),
+		ExtAttrs:                        ExpandExtAttrs(ctx, m.ExtAttrs, diags),
+		Members:                         flex.ExpandFrameworkListString(ctx, m.Members, diags),
+		Name:                            flex.ExpandStringPointer(m.Name),
+		UseCurrentRuleset:               flex.ExpandBoolPointer(m.UseCurrentRuleset),
+		UseDisableMultipleDnsTcpRequest: flex.ExpandBoolPointer(m.UseDisableMultipleDnsTcpRequest),
+		UseEventsPerSecondPerRule:       flex.ExpandBoolPointer(m.UseEventsPerSecondPerRule),
+	}
+	if isCreate {
+		to.SourceMember = flex.ExpandStringPointer(m.SourceMember)
+		to.SourceProfile = flex.ExpandStringPointer(m.SourceProfile)
+	}
+	return to
+}
+
+func FlattenThreatprotectionProfile(ctx context.Context, from *threatprotection.ThreatprotectionProfile, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ThreatprotectionProfileAttrTypes)
+	}
+	m := ThreatprotectionProfileModel{}
+	m.Flatten(ctx, from, diags)
+	m.ExtAttrsAll = types.MapNull(types.StringType)
+	t, d := types.ObjectValueFrom(ctx, ThreatprotectionProfileAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ThreatprotectionProfileModel) Flatten(ctx context.Context, from *threatprotection.ThreatprotectionProfile, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ThreatprotectionProfileModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.CurrentRuleset = flex.FlattenStringPointer(from.CurrentRuleset)
+	m.DisableMultipleDnsTcpRequest = types.BoolPointerValue(from.DisableMultipleDnsTcpRequest)
+	m.EventsPerSecondPerRule = flex.FlattenInt64Pointer(from.EventsPerSecondPerRule)
+	m.ExtAttrs = FlattenExtAttrs(ctx, m.ExtAttrs, from.ExtAttrs, diags)
+	m.Members = flex.FlattenFrameworkListString(ctx, from.Members, diags)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.UseCurrentRuleset = types.BoolPointerValue(from.UseCurrentRuleset)
+	m.UseDisableMultipleDnsTcpRequest = types.BoolPointerValue(from.UseDisableMultipleDnsTcpRequest)
+	m.UseEventsPerSecondPerRule = types.BoolPointerValue(from.UseEventsPerSecondPerRule)
+}
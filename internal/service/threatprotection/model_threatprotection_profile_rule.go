@@ -0,0 +1,139 @@
+package threatprotection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatprotection"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/flex"
+)
+
+type ThreatprotectionProfileRuleModel struct {
+	Ref        types.String `tfsdk:"ref"`
+	Config     types.Object `tfsdk:"config"`
+	Disable    types.Bool   `tfsdk:"disable"`
+	Profile    types.String `tfsdk:"profile"`
+	Rule       types.String `tfsdk:"rule"`
+	Sid        types.Int64  `tfsdk:"sid"`
+	UseConfig  types.Bool   `tfsdk:"use_config"`
+	UseDisable types.Bool   `tfsdk:"use_disable"`
+}
+
+var ThreatprotectionProfileRuleAttrTypes = map[string]attr.Type{
+	"ref":         types.StringType,
+	"config":      types.ObjectType{AttrTypes: ThreatprotectionProfileRuleConfigAttrTypes},
+	"disable":     types.BoolType,
+	"profile":     types.StringType,
+	"rule":        types.StringType,
+	"sid":         types.Int64Type,
+	"use_config":  types.BoolType,
+	"use_disable": types.BoolType,
+}
+
+var ThreatprotectionProfileRuleResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the object.",
+	},
+	"config": schema.SingleNestedAttribute{
+		Attributes: ThreatprotectionProfileRuleConfigResourceSchemaAttributes,
+		Optional:   true,
+		Computed:   true,
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(path.MatchRoot("use_config")),
+		},
+		MarkdownDescription: "The rule configuration overridden for the profile.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("use_disable")),
+		},
+		MarkdownDescription: "Determines if the rule is disabled for the profile.",
+	},
+	"profile": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the Threat Protection profile.",
+	},
+	"rule": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The rule object name.",
+	},
+	"sid": schema.Int64Attribute{
+		Required: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The snort rule ID of the rule.",
+	},
+	"use_config": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Use flag for: config",
+	},
+	"use_disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Use flag for: disable",
+	},
+}
+
+func (m *ThreatprotectionProfileRuleModel) Expand(ctx context.Context, diags *diag.Diagnostics) *threatprotection.ThreatprotectionProfileRule {
+	if m == nil {
+		return nil
+	}
+	to := &threatprotection.ThreatprotectionProfileRule{
+		Config:     ExpandThreatprotectionProfileRuleConfig(ctx, m.Config, diags),
+		Disable:    flex.ExpandBoolPointer(m.Disable),
+		UseConfig:  flex.ExpandBoolPointer(m.UseConfig),
+		UseDisable: flex.ExpandBoolPointer(m.UseDisable),
+	}
+	return to
+}
+
+func FlattenThreatprotectionProfileRule(ctx context.Context, from *threatprotection.ThreatprotectionProfileRule, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ThreatprotectionProfileRuleAttrTypes)
+	}
+	m := ThreatprotectionProfileRuleModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ThreatprotectionProfileRuleAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ThreatprotectionProfileRuleModel) Flatten(ctx context.Context, from *threatprotection.ThreatprotectionProfileRule, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ThreatprotectionProfileRuleModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Config = FlattenThreatprotectionProfileRuleConfig(ctx, from.Config, diags)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.Profile = flex.FlattenStringPointer(from.Profile)
+	m.Rule = flex.FlattenStringPointer(from.Rule)
+	m.Sid = flex.FlattenInt64Pointer(from.Sid)
+	m.UseConfig = types.BoolPointerValue(from.UseConfig)
+	m.UseDisable = types.BoolPointerValue(from.UseDisable)
+}
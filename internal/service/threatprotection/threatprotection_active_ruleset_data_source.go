@@ -0,0 +1,104 @@
+package threatprotection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ThreatprotectionActiveRulesetDataSource{}
+
+func NewThreatprotectionActiveRulesetDataSource() datasource.DataSource {
+	return &ThreatprotectionActiveRulesetDataSource{}
+}
+
+// ThreatprotectionActiveRulesetDataSource defines the data source implementation.
+// It reads the ruleset currently used by the Grid, as set in the Grid Threat Protection properties.
+type ThreatprotectionActiveRulesetDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *ThreatprotectionActiveRulesetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "threatprotection_active_ruleset"
+}
+
+func (d *ThreatprotectionActiveRulesetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the Threat Protection ruleset currently used by the Grid.",
+		Attributes:          utils.DataSourceAttributeMap(ThreatprotectionRulesetResourceSchemaAttributes, &resp.Diagnostics),
+	}
+}
+
+func (d *ThreatprotectionActiveRulesetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ThreatprotectionActiveRulesetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ThreatprotectionRulesetModel
+
+	gridRes, _, err := d.client.GridAPI.
+		GridThreatprotectionAPI.
+		List(ctx).
+		ReturnFields("current_ruleset").
+		ReturnAsObject(1).
+		ProxySearch(config.GetProxySearch(ctx)).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GridThreatprotection, got error: %s", err))
+		return
+	}
+
+	grids := gridRes.ListGridThreatprotectionResponseObject.GetResult()
+	if len(grids) == 0 || grids[0].GetCurrentRuleset() == "" {
+		resp.Diagnostics.AddError("Client Error", "No Threat Protection ruleset is active on the Grid")
+		return
+	}
+	version := grids[0].GetCurrentRuleset()
+
+	apiRes, _, err := d.client.ThreatProtectionAPI.
+		ThreatprotectionRulesetAPI.
+		List(ctx).
+		Filters(map[string]interface{}{"version": version}).
+		ReturnAsObject(1).
+		ReturnFieldsPlus(readableAttributesForThreatprotectionRuleset).
+		ProxySearch(config.GetProxySearch(ctx)).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ThreatprotectionRuleset, got error: %s", err))
+		return
+	}
+
+	res := apiRes.ListThreatprotectionRulesetResponseObject.GetResult()
+	if len(res) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the active Threat Protection ruleset %s", version))
+		return
+	}
+
+	data.Flatten(ctx, &res[0], &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+package threatprotection_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccThreatprotectionActiveRulesetDataSource_basic(t *testing.T) {
+	dataSourceName := "data.nios_threatprotection_active_ruleset.test"
+
+	ruleset, _ := testAccThreatprotectionGridRuleTemplate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatprotectionActiveRulesetDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "version", ruleset),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ref"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccThreatprotectionActiveRulesetDataSourceConfig() string {
+	return `
+data "nios_threatprotection_active_ruleset" "test" {}
+`
+}
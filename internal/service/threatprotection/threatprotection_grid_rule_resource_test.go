@@ -0,0 +1,254 @@
+package threatprotection_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatprotection"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForThreatprotectionGridRule = "allowed_actions,category,comment,config,description,disabled,is_factory_reset_enabled,name,ruleset,sid,template,type"
+
+func TestAccThreatprotectionGridRuleResource_basic(t *testing.T) {
+	var resourceName = "nios_threatprotection_grid_rule.test"
+	var v threatprotection.ThreatprotectionGridRule
+
+	ruleset, template := testAccThreatprotectionGridRuleTemplate(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionGridRuleBasicConfig(ruleset, template),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionGridRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ruleset", ruleset),
+					resource.TestCheckResourceAttrSet(resourceName, "sid"),
+					// Test default values
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatprotectionGridRuleResource_disappears(t *testing.T) {
+	resourceName := "nios_threatprotection_grid_rule.test"
+	var v threatprotection.ThreatprotectionGridRule
+
+	ruleset, template := testAccThreatprotectionGridRuleTemplate(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckThreatprotectionGridRuleDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatprotectionGridRuleBasicConfig(ruleset, template),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionGridRuleExists(context.Background(), resourceName, &v),
+					testAccCheckThreatprotectionGridRuleDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccThreatprotectionGridRuleResource_Comment(t *testing.T) {
+	var resourceName = "nios_threatprotection_grid_rule.test_comment"
+	var v threatprotection.ThreatprotectionGridRule
+
+	ruleset, template := testAccThreatprotectionGridRuleTemplate(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionGridRuleComment(ruleset, template, "This is a comment."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionGridRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a comment."),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatprotectionGridRuleComment(ruleset, template, "This is an updated comment."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionGridRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated comment."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatprotectionGridRuleResource_Disabled(t *testing.T) {
+	var resourceName = "nios_threatprotection_grid_rule.test_disabled"
+	var v threatprotection.ThreatprotectionGridRule
+
+	ruleset, template := testAccThreatprotectionGridRuleTemplate(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionGridRuleDisabled(ruleset, template, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionGridRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatprotectionGridRuleDisabled(ruleset, template, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionGridRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccThreatprotectionGridRuleTemplate returns the version of the active ruleset and the reference of a rule template
+func testAccThreatprotectionGridRuleTemplate(t *testing.T) (string, string) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	acctest.PreCheck(t)
+	gridRes, _, err := acctest.NIOSClient.GridAPI.
+		GridThreatprotectionAPI.
+		List(context.Background()).
+		ReturnFields("current_ruleset").
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		t.Fatalf("Unable to read the Grid Threat Protection properties: %s", err)
+	}
+	grids := gridRes.ListGridThreatprotectionResponseObject.GetResult()
+	if len(grids) == 0 || grids[0].GetCurrentRuleset() == "" {
+		t.Skip("No Threat Protection ruleset is active on the Grid")
+	}
+
+	templateRes, _, err := acctest.NIOSClient.ThreatProtectionAPI.
+		ThreatprotectionRuletemplateAPI.
+		List(context.Background()).
+		MaxResults(1).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		t.Fatalf("Unable to list Threat Protection rule templates: %s", err)
+	}
+	templates := templateRes.ListThreatprotectionRuletemplateResponseObject.GetResult()
+	if len(templates) == 0 {
+		t.Skip("No Threat Protection rule template available")
+	}
+	return grids[0].GetCurrentRuleset(), templates[0].GetRef()
+}
+
+func testAccCheckThreatprotectionGridRuleExists(ctx context.Context, resourceName string, v *threatprotection.ThreatprotectionGridRule) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionGridRuleAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionGridRule).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetThreatprotectionGridRuleResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetThreatprotectionGridRuleResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckThreatprotectionGridRuleDestroy(ctx context.Context, v *threatprotection.ThreatprotectionGridRule) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionGridRuleAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionGridRule).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckThreatprotectionGridRuleDisappears(ctx context.Context, v *threatprotection.ThreatprotectionGridRule) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionGridRuleAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccThreatprotectionGridRuleBasicConfig(ruleset, template string) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_grid_rule" "test" {
+  ruleset  = %q
+  template = %q
+}
+`, ruleset, template)
+}
+
+func testAccThreatprotectionGridRuleComment(ruleset, template, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_grid_rule" "test_comment" {
+  ruleset  = %q
+  template = %q
+  comment  = %q
+}
+`, ruleset, template, comment)
+}
+
+func testAccThreatprotectionGridRuleDisabled(ruleset, template string, disabled bool) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_grid_rule" "test_disabled" {
+  ruleset  = %q
+  template = %q
+  disabled = %t
+}
+`, ruleset, template, disabled)
+}
@@ -0,0 +1,268 @@
+package threatprotection_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatprotection"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForThreatprotectionProfile = "comment,current_ruleset,disable_multiple_dns_tcp_request,events_per_second_per_rule,extattrs,members,name,use_current_ruleset,use_disable_multiple_dns_tcp_request,use_events_per_second_per_rule"
+
+func TestAccThreatprotectionProfileResource_basic(t *testing.T) {
+	var resourceName = "nios_threatprotection_profile.test"
+	var v threatprotection.ThreatprotectionProfile
+
+	name := acctest.RandomNameWithPrefix("example-tp-profile-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionProfileBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					// Test default values
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "use_current_ruleset", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_disable_multiple_dns_tcp_request", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_events_per_second_per_rule", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatprotectionProfileResource_disappears(t *testing.T) {
+	resourceName := "nios_threatprotection_profile.test"
+	var v threatprotection.ThreatprotectionProfile
+
+	name := acctest.RandomNameWithPrefix("example-tp-profile-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckThreatprotectionProfileDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatprotectionProfileBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					testAccCheckThreatprotectionProfileDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccThreatprotectionProfileResource_Comment(t *testing.T) {
+	var resourceName = "nios_threatprotection_profile.test_comment"
+	var v threatprotection.ThreatprotectionProfile
+
+	name := acctest.RandomNameWithPrefix("example-tp-profile-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionProfileComment(name, "This is a comment."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a comment."),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatprotectionProfileComment(name, "This is an updated comment."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated comment."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatprotectionProfileResource_EventsPerSecondPerRule(t *testing.T) {
+	var resourceName = "nios_threatprotection_profile.test_events_per_second_per_rule"
+	var v threatprotection.ThreatprotectionProfile
+
+	name := acctest.RandomNameWithPrefix("example-tp-profile-")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionProfileEventsPerSecondPerRule(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "events_per_second_per_rule", "10"),
+					resource.TestCheckResourceAttr(resourceName, "use_events_per_second_per_rule", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatprotectionProfileEventsPerSecondPerRule(name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "events_per_second_per_rule", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatprotectionProfileResource_ExtAttrs(t *testing.T) {
+	var resourceName = "nios_threatprotection_profile.test_extattrs"
+	var v threatprotection.ThreatprotectionProfile
+
+	name := acctest.RandomNameWithPrefix("example-tp-profile-")
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionProfileExtAttrs(name, map[string]string{
+					"Site": extAttrValue1,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatprotectionProfileExtAttrs(name, map[string]string{
+					"Site": extAttrValue2,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckThreatprotectionProfileExists(ctx context.Context, resourceName string, v *threatprotection.ThreatprotectionProfile) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionProfileAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionProfile).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetThreatprotectionProfileResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetThreatprotectionProfileResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckThreatprotectionProfileDestroy(ctx context.Context, v *threatprotection.ThreatprotectionProfile) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionProfileAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionProfile).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckThreatprotectionProfileDisappears(ctx context.Context, v *threatprotection.ThreatprotectionProfile) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionProfileAPI.
+			Delete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccThreatprotectionProfileBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_profile" "test" {
+  name = %q
+}
+`, name)
+}
+
+func testAccThreatprotectionProfileComment(name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_profile" "test_comment" {
+  name    = %q
+  comment = %q
+}
+`, name, comment)
+}
+
+func testAccThreatprotectionProfileEventsPerSecondPerRule(name string, eventsPerSecondPerRule int) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_profile" "test_events_per_second_per_rule" {
+  name                           = %q
+  events_per_second_per_rule     = %d
+  use_events_per_second_per_rule = true
+}
+`, name, eventsPerSecondPerRule)
+}
+
+func testAccThreatprotectionProfileExtAttrs(name string, extAttrs map[string]string) string {
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		extattrsStr += fmt.Sprintf("    %s = %q\n", k, v)
+	}
+	extattrsStr += "  }"
+	return fmt.Sprintf(`
+resource "nios_threatprotection_profile" "test_extattrs" {
+  name     = %q
+  extattrs = %s
+}
+`, name, extattrsStr)
+}
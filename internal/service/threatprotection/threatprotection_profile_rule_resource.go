@@ -0,0 +1,316 @@
+package threatprotection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/threatprotection"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/config"
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+	"github.com/infobloxopen/terraform-provider-nios/internal/timeouts"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForThreatprotectionProfileRule = "config,disable,profile,rule,sid,use_config,use_disable"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ThreatprotectionProfileRuleResource{}
+var _ resource.ResourceWithImportState = &ThreatprotectionProfileRuleResource{}
+
+func NewThreatprotectionProfileRuleResource() resource.Resource {
+	return &ThreatprotectionProfileRuleResource{}
+}
+
+// ThreatprotectionProfileRuleResource defines the resource implementation.
+type ThreatprotectionProfileRuleResource struct {
+	client *niosclient.APIClient
+}
+
+// ThreatprotectionProfileRuleResourceModel describes the resource data model, extending ThreatprotectionProfileRuleModel with the operation timeouts.
+type ThreatprotectionProfileRuleResourceModel struct {
+	ThreatprotectionProfileRuleModel
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (r *ThreatprotectionProfileRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "threatprotection_profile_rule"
+}
+
+func (r *ThreatprotectionProfileRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the overrides of a Threat Protection rule in a profile.",
+		Attributes:          ThreatprotectionProfileRuleResourceSchemaAttributes,
+		Blocks:              timeouts.Blocks(),
+	}
+}
+
+func (r *ThreatprotectionProfileRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create takes over the rule of the profile, profile rules exist for every rule of the ruleset
+// and cannot be created, the rule is looked up by profile and rule ID and the overrides are applied to it.
+func (r *ThreatprotectionProfileRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ThreatprotectionProfileRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var listRes *threatprotection.ListThreatprotectionProfileRuleResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		listRes, httpRes, callErr = r.client.ThreatProtectionAPI.
+			ThreatprotectionProfileRuleAPI.
+			List(ctx).
+			Filters(map[string]interface{}{
+				"profile": data.Profile.ValueString(),
+				"sid":     data.Sid.ValueInt64(),
+			}).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ThreatprotectionProfileRule, got error: %s", err))
+		return
+	}
+
+	results := listRes.ListThreatprotectionProfileRuleResponseObject.GetResult()
+	if len(results) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the rule %d in the Threat Protection profile %s", data.Sid.ValueInt64(), data.Profile.ValueString()))
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(results[0].GetRef())
+
+	var apiRes *threatprotection.UpdateThreatprotectionProfileRuleResponse
+
+	err = retry.DoWithTimeout(ctx, timeouts.Create(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.ThreatProtectionAPI.
+			ThreatprotectionProfileRuleAPI.
+			Update(ctx, resourceRef).
+			ThreatprotectionProfileRule(*payload).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionProfileRule).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ThreatprotectionProfileRule, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateThreatprotectionProfileRuleResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ThreatprotectionProfileRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ThreatprotectionProfileRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var (
+		httpRes *http.Response
+		apiRes  *threatprotection.GetThreatprotectionProfileRuleResponse
+	)
+
+	err := retry.DoWithTimeout(ctx, timeouts.Read(ctx, data.Timeouts, retry.Timeout(ctx)), nil, func(ctx context.Context) (int, error) {
+		var callErr error
+		apiRes, httpRes, callErr = r.client.ThreatProtectionAPI.
+			ThreatprotectionProfileRuleAPI.
+			Read(ctx, resourceRef).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionProfileRule).
+			ReturnAsObject(1).
+			ProxySearch(config.GetProxySearch(ctx)).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	// Handle not found case
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ThreatprotectionProfileRule, got error: %s", err))
+		return
+	}
+
+	res := apiRes.GetThreatprotectionProfileRuleResponseObjectAsResult.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ThreatprotectionProfileRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var diags diag.Diagnostics
+	var data ThreatprotectionProfileRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	payload := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	var apiRes *threatprotection.UpdateThreatprotectionProfileRuleResponse
+
+	err := retry.DoWithTimeout(ctx, timeouts.Update(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		var (
+			httpRes *http.Response
+			callErr error
+		)
+		apiRes, httpRes, callErr = r.client.ThreatProtectionAPI.
+			ThreatprotectionProfileRuleAPI.
+			Update(ctx, resourceRef).
+			ThreatprotectionProfileRule(*payload).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionProfileRule).
+			ReturnAsObject(1).
+			Execute()
+
+		if httpRes != nil {
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ThreatprotectionProfileRule, got error: %s", err))
+		return
+	}
+
+	res := apiRes.UpdateThreatprotectionProfileRuleResponseAsObject.GetResult()
+
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the overrides of the profile rule, which then inherits the rule settings of the ruleset again.
+func (r *ThreatprotectionProfileRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ThreatprotectionProfileRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceRef := utils.ExtractResourceRef(data.Ref.ValueString())
+
+	payload := threatprotection.ThreatprotectionProfileRule{
+		UseConfig:  utils.Ptr(false),
+		UseDisable: utils.Ptr(false),
+	}
+
+	err := retry.DoWithTimeout(ctx, timeouts.Delete(ctx, data.Timeouts, retry.Timeout(ctx)), retry.TransientErrors, func(ctx context.Context) (int, error) {
+		_, httpRes, callErr := r.client.ThreatProtectionAPI.
+			ThreatprotectionProfileRuleAPI.
+			Update(ctx, resourceRef).
+			ThreatprotectionProfileRule(payload).
+			Execute()
+
+		if httpRes != nil {
+			if httpRes.StatusCode == http.StatusNotFound {
+				return 0, nil
+			}
+			return httpRes.StatusCode, callErr
+		}
+		return 0, callErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ThreatprotectionProfileRule, got error: %s", err))
+		return
+	}
+}
+
+func (r *ThreatprotectionProfileRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,175 @@
+package threatprotection_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/threatprotection"
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForThreatprotectionProfileRule = "config,disable,profile,rule,sid,use_config,use_disable"
+
+func TestAccThreatprotectionProfileRuleResource_basic(t *testing.T) {
+	var resourceName = "nios_threatprotection_profile_rule.test"
+	var v threatprotection.ThreatprotectionProfileRule
+
+	profile := acctest.RandomNameWithPrefix("example-tp-profile-")
+	sid := testAccThreatprotectionRuleSid(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckThreatprotectionProfileRuleReset(context.Background(), &v),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionProfileRuleBasicConfig(profile, sid),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "profile", profile),
+					resource.TestCheckResourceAttr(resourceName, "sid", fmt.Sprintf("%d", sid)),
+					// Test default values
+					resource.TestCheckResourceAttr(resourceName, "use_config", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccThreatprotectionProfileRuleResource_Disable(t *testing.T) {
+	var resourceName = "nios_threatprotection_profile_rule.test_disable"
+	var v threatprotection.ThreatprotectionProfileRule
+
+	profile := acctest.RandomNameWithPrefix("example-tp-profile-")
+	sid := testAccThreatprotectionRuleSid(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccThreatprotectionProfileRuleDisable(profile, sid, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "use_disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccThreatprotectionProfileRuleDisable(profile, sid, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThreatprotectionProfileRuleExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccThreatprotectionRuleSid returns the rule ID of a rule of the active ruleset, present in every profile
+func testAccThreatprotectionRuleSid(t *testing.T) int64 {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	acctest.PreCheck(t)
+	apiRes, _, err := acctest.NIOSClient.ThreatProtectionAPI.
+		ThreatprotectionRuleAPI.
+		List(context.Background()).
+		ReturnFields("sid").
+		MaxResults(1).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		t.Fatalf("Unable to list Threat Protection rules: %s", err)
+	}
+	rules := apiRes.ListThreatprotectionRuleResponseObject.GetResult()
+	if len(rules) == 0 {
+		t.Skip("No Threat Protection rule available")
+	}
+	return rules[0].GetSid()
+}
+
+func testAccCheckThreatprotectionProfileRuleExists(ctx context.Context, resourceName string, v *threatprotection.ThreatprotectionProfileRule) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionProfileRuleAPI.
+			Read(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionProfileRule).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.GetThreatprotectionProfileRuleResponseObjectAsResult.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetThreatprotectionProfileRuleResponseObjectAsResult.GetResult()
+		return nil
+	}
+}
+
+// testAccCheckThreatprotectionProfileRuleReset verifies the overrides were removed from the rule, profile rules are never deleted
+func testAccCheckThreatprotectionProfileRuleReset(ctx context.Context, v *threatprotection.ThreatprotectionProfileRule) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		apiRes, _, err := acctest.NIOSClient.ThreatProtectionAPI.
+			ThreatprotectionProfileRuleAPI.
+			Read(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFieldsPlus(readableAttributesForThreatprotectionProfileRule).
+			Execute()
+		if err != nil {
+			// The profile was destroyed along with its rules
+			return nil
+		}
+		res := apiRes.GetThreatprotectionProfileRuleResponseObjectAsResult.GetResult()
+		if res.GetUseConfig() || res.GetUseDisable() {
+			return fmt.Errorf("expected the overrides of the rule to be removed")
+		}
+		return nil
+	}
+}
+
+func testAccThreatprotectionProfileRuleBasicConfig(profile string, sid int64) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_profile" "test" {
+  name = %q
+}
+
+resource "nios_threatprotection_profile_rule" "test" {
+  profile = nios_threatprotection_profile.test.name
+  sid     = %d
+}
+`, profile, sid)
+}
+
+func testAccThreatprotectionProfileRuleDisable(profile string, sid int64, disable bool) string {
+	return fmt.Sprintf(`
+resource "nios_threatprotection_profile" "test" {
+  name = %q
+}
+
+resource "nios_threatprotection_profile_rule" "test_disable" {
+  profile     = nios_threatprotection_profile.test.name
+  sid         = %d
+  disable     = %t
+  use_disable = true
+}
+`, profile, sid, disable)
+}
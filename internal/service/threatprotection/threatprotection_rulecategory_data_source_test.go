@@ -0,0 +1,43 @@
+package threatprotection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccThreatprotectionRulecategoryDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_threatprotection_rulecategory.test"
+
+	ruleset, _ := testAccThreatprotectionGridRuleTemplate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatprotectionRulecategoryDataSourceConfigFilters(ruleset),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.ruleset", ruleset),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccThreatprotectionRulecategoryDataSourceConfigFilters(ruleset string) string {
+	return fmt.Sprintf(`
+data "nios_threatprotection_rulecategory" "test" {
+  filters = {
+	ruleset = %q
+  }
+}
+`, ruleset)
+}
@@ -0,0 +1,44 @@
+package threatprotection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccThreatprotectionRulesetDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_threatprotection_ruleset.test"
+
+	ruleset, _ := testAccThreatprotectionGridRuleTemplate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThreatprotectionRulesetDataSourceConfigFilters(ruleset),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.version", ruleset),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.ref"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.0.add_type"),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccThreatprotectionRulesetDataSourceConfigFilters(version string) string {
+	return fmt.Sprintf(`
+data "nios_threatprotection_ruleset" "test" {
+  filters = {
+	version = %q
+  }
+}
+`, version)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/infobloxopen/infoblox-nios-go-client/rir"
 	"github.com/infobloxopen/infoblox-nios-go-client/security"
 	"github.com/infobloxopen/terraform-provider-nios/internal/acctest"
+	"github.com/infobloxopen/terraform-provider-nios/internal/transport"
 	"github.com/infobloxopen/terraform-provider-nios/internal/utils"
 )
 
@@ -40,6 +41,10 @@ type UploadInitResponse struct {
 
 var pipelineEnvFile *os.File
 
+// tlsConfig is used by the helper HTTP clients, it is built in main from NIOS_SSL_VERIFY and NIOS_CA_CERT_FILE
+// the same way the provider builds it from ssl_verify and ca_cert_file
+var tlsConfig *tls.Config
+
 func writePipelineEnvVar(key, value string) error {
 	if pipelineEnvFile == nil {
 		return fmt.Errorf("pipeline.env file is not initialized")
@@ -177,7 +182,7 @@ func UploadInit(host, wapiVer, username, password string) (*UploadInitResponse,
 
 	client := &http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // intentional for lab/CI grids with self-signed certs
+			TLSClientConfig: tlsConfig,
 		},
 	}
 
@@ -243,7 +248,7 @@ func UploadFile(host, wapiVer, username, password, url, filePath string) error {
 
 	client := &http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // intentional for lab/CI grids with self-signed certs
+			TLSClientConfig: tlsConfig,
 		},
 	}
 
@@ -286,7 +291,7 @@ func UploadCertificate(host, wapiVer, username, password, certificateUsage, memb
 
 	client := &http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // intentional for lab/CI grids with self-signed certs
+			TLSClientConfig: tlsConfig,
 		},
 	}
 
@@ -334,7 +339,7 @@ func UploadEcoSystemTemplates(host, wapiVer, username, password, token string) e
 
 	client := &http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // intentional for lab/CI grids with self-signed certs
+			TLSClientConfig: tlsConfig,
 		},
 	}
 
@@ -500,7 +505,7 @@ func FetchAndStoreCertificateRef(host, wapiVer, username, password, envVarName,
 
 	client := &http.Client{
 		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // intentional for lab/CI grids with self-signed certs
+			TLSClientConfig: tlsConfig,
 		},
 	}
 
@@ -1875,10 +1880,19 @@ func main() {
 	wapiVer := strings.TrimSpace(firstNonEmpty(os.Getenv("NIOS_WAPI_VERSION"), "v2.13.6"))
 	username := strings.TrimSpace(firstNonEmpty(os.Getenv("NIOS_USERNAME")))
 	password := strings.TrimSpace(firstNonEmpty(os.Getenv("NIOS_PASSWORD")))
+	sslVerify := strings.EqualFold(strings.TrimSpace(os.Getenv("NIOS_SSL_VERIFY")), "true")
+	caCertFile := strings.TrimSpace(os.Getenv("NIOS_CA_CERT_FILE"))
 
 	if host == "" || wapiVer == "" || username == "" || password == "" {
 		fmt.Println("Missing required NIOS configuration. Ensure host, WAPI version, username, and password are set.")
-		fmt.Println("Supported env vars: NIOS_HOST_URL (or NIOS_HOST), NIOS_WAPI_VERSION, NIOS_USERNAME, NIOS_PASSWORD")
+		fmt.Println("Supported env vars: NIOS_HOST_URL (or NIOS_HOST), NIOS_WAPI_VERSION, NIOS_USERNAME, NIOS_PASSWORD, NIOS_SSL_VERIFY, NIOS_CA_CERT_FILE")
+		return
+	}
+
+	var err error
+	tlsConfig, err = transport.NewTLSConfig(transport.TLSSettings{SslVerify: sslVerify, CACertFile: caCertFile})
+	if err != nil {
+		fmt.Printf("Invalid TLS configuration: %v\n", err)
 		return
 	}
 
@@ -1912,6 +1926,7 @@ func main() {
 		option.WithNIOSPassword(password),
 		option.WithDebug(true),
 	)
+	utils.SetWAPIVersion(apiClient, wapiVer)
 
 	hostnames, err := ResolveAndStoreGridHostnames(apiClient.GridAPI)
 	if err != nil {
@@ -0,0 +1,124 @@
+package timeouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	customvalidator "github.com/infobloxopen/terraform-provider-nios/internal/validator"
+)
+
+const (
+	createKey = "create"
+	readKey   = "read"
+	updateKey = "update"
+	deleteKey = "delete"
+)
+
+// Model describes the `timeouts` attribute of a resource.
+type Model struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+var AttrTypes = map[string]attr.Type{
+	createKey: types.StringType,
+	readKey:   types.StringType,
+	updateKey: types.StringType,
+	deleteKey: types.StringType,
+}
+
+// Blocks returns the `timeouts` block that is added to every resource schema.
+func Blocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"timeouts": schema.SingleNestedBlock{
+			Attributes: map[string]schema.Attribute{
+				createKey: operationAttribute(createKey),
+				readKey:   operationAttribute(readKey),
+				updateKey: operationAttribute(updateKey),
+				deleteKey: operationAttribute(deleteKey),
+			},
+			MarkdownDescription: "Timeouts for the operations on this resource. Each timeout bounds the time spent retrying the operation after transient errors and defaults to the provider `retry_timeout` when not set.",
+		},
+	}
+}
+
+func operationAttribute(operation string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			customvalidator.ValidateDuration(),
+		},
+		MarkdownDescription: "Timeout for the " + operation + " operation, as a duration string such as `30s`, `10m` or `1h`.",
+	}
+}
+
+// Null returns a null `timeouts` value.
+func Null() types.Object {
+	return types.ObjectNull(AttrTypes)
+}
+
+// Create returns the create timeout configured in obj, or def if it is not set.
+func Create(ctx context.Context, obj types.Object, def time.Duration) time.Duration {
+	return operationTimeout(ctx, obj, createKey, def)
+}
+
+// Read returns the read timeout configured in obj, or def if it is not set.
+func Read(ctx context.Context, obj types.Object, def time.Duration) time.Duration {
+	return operationTimeout(ctx, obj, readKey, def)
+}
+
+// Update returns the update timeout configured in obj, or def if it is not set.
+func Update(ctx context.Context, obj types.Object, def time.Duration) time.Duration {
+	return operationTimeout(ctx, obj, updateKey, def)
+}
+
+// Delete returns the delete timeout configured in obj, or def if it is not set.
+func Delete(ctx context.Context, obj types.Object, def time.Duration) time.Duration {
+	return operationTimeout(ctx, obj, deleteKey, def)
+}
+
+// operationTimeout parses the timeout of the given operation.
+// Values are validated at plan time, so an unparsable value falls back to def.
+func operationTimeout(ctx context.Context, obj types.Object, operation string, def time.Duration) time.Duration {
+	if obj.IsNull() || obj.IsUnknown() {
+		return def
+	}
+
+	var m Model
+	var diags diag.Diagnostics
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return def
+	}
+
+	var value types.String
+	switch operation {
+	case createKey:
+		value = m.Create
+	case readKey:
+		value = m.Read
+	case updateKey:
+		value = m.Update
+	case deleteKey:
+		value = m.Delete
+	}
+
+	if value.IsNull() || value.IsUnknown() {
+		return def
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
@@ -0,0 +1,69 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func timeoutsValue(t *testing.T, create, update string) types.Object {
+	t.Helper()
+	value := func(s string) attr.Value {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+	obj, diags := types.ObjectValue(AttrTypes, map[string]attr.Value{
+		createKey: value(create),
+		readKey:   types.StringNull(),
+		updateKey: value(update),
+		deleteKey: types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return obj
+}
+
+// TestOperationTimeouts tests that configured timeouts are used and the default applies otherwise
+func TestOperationTimeouts(t *testing.T) {
+	ctx := context.Background()
+	def := 60 * time.Second
+
+	obj := timeoutsValue(t, "30m", "1h30m")
+	if got := Create(ctx, obj, def); got != 30*time.Minute {
+		t.Errorf("Create() = %s, want 30m", got)
+	}
+	if got := Update(ctx, obj, def); got != 90*time.Minute {
+		t.Errorf("Update() = %s, want 1h30m", got)
+	}
+	if got := Read(ctx, obj, def); got != def {
+		t.Errorf("Read() = %s, want default %s", got, def)
+	}
+	if got := Delete(ctx, obj, def); got != def {
+		t.Errorf("Delete() = %s, want default %s", got, def)
+	}
+}
+
+// TestOperationTimeouts_NullOrInvalid tests that a missing block or an invalid value falls back to the default
+func TestOperationTimeouts_NullOrInvalid(t *testing.T) {
+	ctx := context.Background()
+	def := 60 * time.Second
+
+	if got := Create(ctx, Null(), def); got != def {
+		t.Errorf("Create() with null timeouts = %s, want default %s", got, def)
+	}
+	if got := Create(ctx, types.ObjectUnknown(AttrTypes), def); got != def {
+		t.Errorf("Create() with unknown timeouts = %s, want default %s", got, def)
+	}
+	if got := Create(ctx, timeoutsValue(t, "soon", ""), def); got != def {
+		t.Errorf("Create() with invalid timeout = %s, want default %s", got, def)
+	}
+	if got := Create(ctx, timeoutsValue(t, "-5m", ""), def); got != def {
+		t.Errorf("Create() with negative timeout = %s, want default %s", got, def)
+	}
+}
@@ -0,0 +1,128 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ibapAuthCookie is the session cookie returned by NIOS after a successful authentication.
+const ibapAuthCookie = "ibapauth"
+
+// AuthTransport authenticates WAPI requests.
+// Requests are sent with basic auth unless the cookie jar holds a valid NIOS session cookie,
+// in which case the session is reused. Only one request at a time logs in, concurrent requests
+// wait for it and reuse the session it opened, so that remote authentication servers such as
+// RADIUS, LDAP or TACACS+ see a single login. A request rejected with 401 while relying on the
+// session cookie is retried once with basic auth.
+type AuthTransport struct {
+	Username  string
+	Password  string
+	Jar       http.CookieJar
+	Transport http.RoundTripper
+
+	login sync.Mutex
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	session := sessionCookie(t.Jar, req.URL)
+	if session == nil {
+		return t.roundTripLogin(req, "")
+	}
+
+	resp, err := t.Transport.RoundTrip(req.Clone(req.Context()))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || t.Username == "" {
+		return resp, err
+	}
+
+	// The session expired on the server side, authenticate again
+	authReq := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return resp, nil
+		}
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return resp, nil
+		}
+		authReq.Body = body
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	return t.roundTripLogin(authReq, session.Value)
+}
+
+// roundTripLogin sends the request with basic auth to open a new session, replacing the
+// rejected session if any. Requests waiting for the login in progress reuse the session
+// it opened once it is stored in the jar.
+func (t *AuthTransport) roundTripLogin(req *http.Request, rejectedSession string) (*http.Response, error) {
+	t.login.Lock()
+	defer t.login.Unlock()
+
+	authReq := req.Clone(req.Context())
+	authReq.Header.Del("Cookie")
+	if session := sessionCookie(t.Jar, req.URL); session != nil && session.Value != rejectedSession {
+		// Another request opened a session while this one was waiting
+		for _, cookie := range t.Jar.Cookies(req.URL) {
+			authReq.AddCookie(cookie)
+		}
+		return t.Transport.RoundTrip(authReq)
+	}
+
+	t.setBasicAuth(authReq)
+	resp, err := t.Transport.RoundTrip(authReq)
+	if err == nil && t.Jar != nil {
+		// Store the session before releasing the waiting requests, the HTTP client only does it
+		// once the response is returned
+		if cookies := resp.Cookies(); len(cookies) > 0 {
+			t.Jar.SetCookies(req.URL, cookies)
+		}
+	}
+	return resp, err
+}
+
+func (t *AuthTransport) setBasicAuth(req *http.Request) {
+	if t.Username != "" && t.Password != "" {
+		req.SetBasicAuth(t.Username, t.Password)
+	}
+}
+
+// sessionCookie returns the NIOS session cookie held in the jar for the URL if it has not expired yet
+func sessionCookie(jar http.CookieJar, u *url.URL) *http.Cookie {
+	if jar == nil {
+		return nil
+	}
+	for _, cookie := range jar.Cookies(u) {
+		if cookie.Name == ibapAuthCookie && sessionCookieValid(cookie, time.Now()) {
+			return cookie
+		}
+	}
+	return nil
+}
+
+// sessionCookieValid checks the ctime and timeout fields encoded in the ibapauth cookie value.
+func sessionCookieValid(cookie *http.Cookie, now time.Time) bool {
+	fields := make(map[string]string)
+	for _, part := range strings.Split(cookie.Value, ",") {
+		if kv := strings.SplitN(part, "=", 2); len(kv) == 2 {
+			fields[kv[0]] = kv[1]
+		}
+	}
+
+	ctime, err := strconv.ParseInt(fields["ctime"], 10, 64)
+	if err != nil {
+		return false
+	}
+	timeout, err := strconv.ParseInt(fields["timeout"], 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return now.Before(time.Unix(ctime, 0).Add(time.Duration(timeout) * time.Second))
+}
@@ -0,0 +1,253 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultBatchWindow is how long a read waits for other reads of the same object type
+	DefaultBatchWindow = 25 * time.Millisecond
+	// DefaultBatchSize is the maximum number of reads sent in one multi-request
+	DefaultBatchSize = 100
+)
+
+// objectReadRegex matches the path of a read by reference, e.g. /wapi/v2.13.6/record:a/ZG5z...:example.com/default
+var objectReadRegex = regexp.MustCompile(`^(.*/wapi/v[0-9.]+)/([^/]+)/(.+)$`)
+
+// BatchTransport coalesces the concurrent reads by reference of an object type into one
+// WAPI multi-request sent to the request object. The results are fanned back out to the
+// waiting requests as if they had been sent one by one. A multi-request is aborted by NIOS
+// as soon as one of its reads fails, in which case the reads are sent individually so that
+// each gets its own response, for example a 404 for an object deleted outside of Terraform.
+type BatchTransport struct {
+	Transport http.RoundTripper
+	Window    time.Duration
+	MaxSize   int
+
+	mu      sync.Mutex
+	pending map[string]*readBatch
+}
+
+// readBatch holds the reads of an object type waiting to be sent
+type readBatch struct {
+	requestURL string
+	calls      []*batchedRead
+}
+
+// batchedRead is a read waiting for its result
+type batchedRead struct {
+	req      *http.Request
+	object   string
+	args     map[string]string
+	asObject bool
+	done     chan batchResult
+}
+
+type batchResult struct {
+	resp *http.Response
+	err  error
+}
+
+// multiRequestCall is an entry of the body of the WAPI request object
+type multiRequestCall struct {
+	Method string            `json:"method"`
+	Object string            `json:"object"`
+	Args   map[string]string `json:"args,omitempty"`
+}
+
+// NewBatchTransport wraps next with a BatchTransport using the default window and batch size
+func NewBatchTransport(next http.RoundTripper) *BatchTransport {
+	return &BatchTransport{
+		Transport: next,
+		Window:    DefaultBatchWindow,
+		MaxSize:   DefaultBatchSize,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *BatchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	call, key, requestURL := newBatchedRead(req)
+	if call == nil {
+		return t.Transport.RoundTrip(req)
+	}
+
+	t.mu.Lock()
+	if t.pending == nil {
+		t.pending = make(map[string]*readBatch)
+	}
+	batch, ok := t.pending[key]
+	if !ok {
+		batch = &readBatch{requestURL: requestURL}
+		t.pending[key] = batch
+		time.AfterFunc(t.Window, func() { t.flush(key, batch) })
+	}
+	batch.calls = append(batch.calls, call)
+	full := len(batch.calls) >= t.MaxSize
+	t.mu.Unlock()
+
+	if full {
+		t.flush(key, batch)
+	}
+
+	select {
+	case result := <-call.done:
+		return result.resp, result.err
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+// newBatchedRead returns the batched read of a GET request by reference, or nil if the request cannot be batched
+func newBatchedRead(req *http.Request) (*batchedRead, string, string) {
+	if req.Method != http.MethodGet {
+		return nil, "", ""
+	}
+	matches := objectReadRegex.FindStringSubmatch(req.URL.Path)
+	if matches == nil {
+		return nil, "", ""
+	}
+
+	call := &batchedRead{
+		req:    req,
+		object: matches[2] + "/" + matches[3],
+		args:   make(map[string]string),
+		done:   make(chan batchResult, 1),
+	}
+	for name, values := range req.URL.Query() {
+		if name == "_return_as_object" {
+			call.asObject = len(values) > 0 && values[0] == "1"
+			continue
+		}
+		if len(values) > 0 {
+			call.args[name] = values[0]
+		}
+	}
+
+	requestURL := *req.URL
+	requestURL.Path = matches[1] + "/request"
+	requestURL.RawPath = ""
+	requestURL.RawQuery = ""
+	return call, requestURL.Scheme + "://" + requestURL.Host + matches[1] + "|" + matches[2], requestURL.String()
+}
+
+// flush sends the batch if it is still pending
+func (t *BatchTransport) flush(key string, batch *readBatch) {
+	t.mu.Lock()
+	if t.pending[key] != batch {
+		t.mu.Unlock()
+		return
+	}
+	delete(t.pending, key)
+	t.mu.Unlock()
+
+	if len(batch.calls) == 1 {
+		t.sendIndividually(batch.calls)
+		return
+	}
+
+	results, err := t.sendMultiRequest(batch)
+	if err != nil {
+		tflog.Debug(batch.calls[0].req.Context(), fmt.Sprintf("WAPI multi-request of %d reads failed, sending them individually: %s", len(batch.calls), err.Error()))
+		t.sendIndividually(batch.calls)
+		return
+	}
+	for i, call := range batch.calls {
+		call.done <- batchResult{resp: call.response(results[i])}
+	}
+}
+
+// sendIndividually sends each read on its own, concurrently
+func (t *BatchTransport) sendIndividually(calls []*batchedRead) {
+	for _, call := range calls {
+		go func(call *batchedRead) {
+			resp, err := t.Transport.RoundTrip(call.req)
+			call.done <- batchResult{resp: resp, err: err}
+		}(call)
+	}
+}
+
+// sendMultiRequest sends the reads of the batch in one multi-request and returns their results in order
+func (t *BatchTransport) sendMultiRequest(batch *readBatch) ([]json.RawMessage, error) {
+	body := make([]multiRequestCall, len(batch.calls))
+	for i, call := range batch.calls {
+		body[i] = multiRequestCall{Method: http.MethodGet, Object: call.object, Args: call.args}
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The multi-request outlives the individual reads, it only keeps the values of the first context
+	first := batch.calls[0].req
+	req, err := http.NewRequestWithContext(context.WithoutCancel(first.Context()), http.MethodPost, batch.requestURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = first.Header.Clone()
+	req.Header.Set("Content-Type", "application/json")
+
+	tflog.Debug(first.Context(), fmt.Sprintf("Sending %d reads in one WAPI multi-request", len(batch.calls)))
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var results []json.RawMessage
+	if err := json.Unmarshal(respBody, &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(batch.calls) {
+		return nil, fmt.Errorf("expected %d results, got %d", len(batch.calls), len(results))
+	}
+	return results, nil
+}
+
+// response builds the response the read would have received on its own
+func (c *batchedRead) response(result json.RawMessage) *http.Response {
+	// A read by reference returns a single object
+	var list []json.RawMessage
+	if json.Unmarshal(result, &list) == nil && len(list) == 1 {
+		result = list[0]
+	}
+	body := []byte(result)
+	if c.asObject {
+		body, _ = json.Marshal(map[string]json.RawMessage{"result": result})
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       c.req,
+	}
+}
+
+// isMultiRequest reports whether the request is sent to the WAPI request object
+func isMultiRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && path.Base(req.URL.Path) == "request"
+}
@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+// Settings holds the provider configuration needed to build the shared HTTP client.
+type Settings struct {
+	Username string
+	Password string
+	ProxyURL string
+	TLS      TLSSettings
+	// CloudAPIHostURL is the URL of the Cloud Platform member write requests are sent to, if any
+	CloudAPIHostURL string
+	// MaxConcurrentRequests and RequestsPerSecond limit the load put on the Grid, 0 means unlimited
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+	// BatchReads coalesces concurrent reads by reference into WAPI multi-requests
+	BatchReads bool
+}
+
+// NewHTTPClient builds the HTTP client shared by every WAPI service client and helper of the provider.
+// The transport chain is: [read batching ->] rate and concurrency limits -> Retry-After recording -> [cloud API routing ->] authentication -> TLS/proxy aware base transport.
+// All requests to the Grid Master share one cookie jar, so the NIOS session cookie is reused across services.
+func NewHTTPClient(settings Settings) (*http.Client, error) {
+	tlsConfig, err := NewTLSConfig(settings.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	baseTransport := &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	if proxyURL := strings.TrimSpace(settings.ProxyURL); proxyURL != "" {
+		parsedURL, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %s: %w", proxyURL, err)
+		}
+		baseTransport.Proxy = http.ProxyURL(parsedURL)
+	}
+
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create cookie jar: %w", err)
+	}
+
+	authTransport := &AuthTransport{
+		Username:  settings.Username,
+		Password:  settings.Password,
+		Jar:       jar,
+		Transport: baseTransport,
+	}
+
+	var transport http.RoundTripper = authTransport
+	if cloudURL := strings.TrimSpace(settings.CloudAPIHostURL); cloudURL != "" {
+		parsedURL, err := url.Parse(cloudURL)
+		if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" {
+			return nil, fmt.Errorf("invalid cloud API host URL %s", cloudURL)
+		}
+		cloudJar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create cookie jar: %w", err)
+		}
+		transport = &CloudAPITransport{
+			HostURL: parsedURL,
+			Jar:     cloudJar,
+			Grid:    authTransport,
+			Cloud: &AuthTransport{
+				Username:  settings.Username,
+				Password:  settings.Password,
+				Jar:       cloudJar,
+				Transport: baseTransport,
+			},
+		}
+	}
+
+	transport = NewLimitTransport(retry.NewRetryAfterTransport(transport), settings.MaxConcurrentRequests, settings.RequestsPerSecond)
+	if settings.BatchReads {
+		transport = NewBatchTransport(transport)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Jar:       jar,
+	}, nil
+}
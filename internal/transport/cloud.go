@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CloudAPITransport sends WAPI write requests to a Cloud Platform member through the cloud API.
+// Objects created or updated through a Cloud Platform member are delegated to it, NIOS records
+// the delegation in the cloud_info field of the object. Reads and function calls keep using the
+// Grid Master. The cloud member has its own session, kept in a separate cookie jar, so that its
+// session cookie does not replace the one of the Grid Master.
+type CloudAPITransport struct {
+	HostURL *url.URL
+	// Jar holds the session cookie of the cloud member
+	Jar http.CookieJar
+	// Grid is used for the requests sent to the Grid Master
+	Grid http.RoundTripper
+	// Cloud is used for the requests sent to the cloud member
+	Cloud http.RoundTripper
+}
+
+// gridMasterKey is the context key marking the requests that are always sent to the Grid Master
+type gridMasterKey struct{}
+
+// WithGridMaster returns a copy of ctx whose requests are sent to the Grid Master even when a Cloud
+// Platform member is configured. The provider uses it for its own setup writes, such as the Terraform
+// Internal ID extensible attribute definition, which are Grid level objects that are never delegated.
+func WithGridMaster(ctx context.Context) context.Context {
+	return context.WithValue(ctx, gridMasterKey{}, true)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CloudAPITransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isLogout(req) {
+		t.logout(req)
+		return t.Grid.RoundTrip(req)
+	}
+	if !isCloudAPIRequest(req) {
+		return t.Grid.RoundTrip(req)
+	}
+	return t.roundTripCloud(req)
+}
+
+// logout closes the session of the cloud member along with the one of the Grid Master
+func (t *CloudAPITransport) logout(req *http.Request) {
+	cloudURL := *req.URL
+	cloudURL.Scheme = t.HostURL.Scheme
+	cloudURL.Host = t.HostURL.Host
+	if sessionCookie(t.Jar, &cloudURL) == nil {
+		return
+	}
+	if resp, err := t.roundTripCloud(req); err == nil {
+		_ = resp.Body.Close()
+	}
+}
+
+// roundTripCloud sends the request to the cloud member with the session of the cloud member
+func (t *CloudAPITransport) roundTripCloud(req *http.Request) (*http.Response, error) {
+	cloudReq := req.Clone(req.Context())
+	cloudReq.URL.Scheme = t.HostURL.Scheme
+	cloudReq.URL.Host = t.HostURL.Host
+	cloudReq.Host = ""
+	// The cookies added by the HTTP client belong to the Grid Master
+	cloudReq.Header.Del("Cookie")
+	for _, cookie := range t.Jar.Cookies(cloudReq.URL) {
+		cloudReq.AddCookie(cookie)
+	}
+
+	resp, err := t.Cloud.RoundTrip(cloudReq)
+	if err != nil {
+		return resp, err
+	}
+	if cookies := resp.Cookies(); len(cookies) > 0 {
+		t.Jar.SetCookies(cloudReq.URL, cookies)
+		resp.Header.Del("Set-Cookie")
+	}
+	return resp, nil
+}
+
+// isCloudAPIRequest reports whether the request creates, updates or deletes an object.
+// Function calls such as fileop or restartservices are only served by the Grid Master,
+// as are the multi-requests batching reads and the requests marked with WithGridMaster.
+func isCloudAPIRequest(req *http.Request) bool {
+	if gridMaster, _ := req.Context().Value(gridMasterKey{}).(bool); gridMaster {
+		return false
+	}
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+		return !req.URL.Query().Has("_function") && !isLogout(req) && !isMultiRequest(req)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,115 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LimitTransport caps the number of WAPI requests in flight and the rate at which they are sent.
+// Every service client shares the same HTTP client, so the limits apply to the whole provider
+// connection regardless of the Terraform parallelism. Requests that have to wait are logged.
+type LimitTransport struct {
+	Transport http.RoundTripper
+
+	slots    chan struct{}
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewLimitTransport wraps next with a LimitTransport.
+// A maxConcurrent or requestsPerSecond of 0 disables the corresponding limit.
+func NewLimitTransport(next http.RoundTripper, maxConcurrent int, requestsPerSecond float64) *LimitTransport {
+	t := &LimitTransport{Transport: next}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+
+	release := func() {}
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() { once.Do(func() { <-t.slots }) }
+	}
+
+	if err := t.waitForRate(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	if wait := time.Since(start); wait >= time.Millisecond {
+		tflog.Debug(ctx, fmt.Sprintf("Throttled WAPI request %s %s, waited %s", req.Method, req.URL.Path, wait.Round(time.Millisecond)))
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	// The request is in flight until its response has been read, so the slot is held until the body is closed
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnClose calls release once the wrapped response body is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// waitForRate reserves the next send slot and waits until it is reached
+func (t *LimitTransport) waitForRate(ctx context.Context) error {
+	if t.interval <= 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	now := time.Now()
+	slot := t.next
+	if slot.Before(now) {
+		slot = now
+	}
+	t.next = slot.Add(t.interval)
+	t.mu.Unlock()
+
+	wait := time.Until(slot)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// session is a NIOS session opened by the HTTP client of a Grid connection
+type session struct {
+	httpClient *http.Client
+	wapiURL    string
+}
+
+var sessions struct {
+	sync.Mutex
+	entries []session
+}
+
+// RegisterSession records the HTTP client of a Grid connection so that its NIOS session is
+// closed by CloseSessions when the provider shuts down.
+func RegisterSession(httpClient *http.Client, wapiURL string) {
+	sessions.Lock()
+	defer sessions.Unlock()
+	for _, s := range sessions.entries {
+		if s.httpClient == httpClient {
+			return
+		}
+	}
+	sessions.entries = append(sessions.entries, session{httpClient: httpClient, wapiURL: wapiURL})
+}
+
+// CloseSessions logs out of the NIOS sessions of every registered HTTP client
+func CloseSessions(ctx context.Context) {
+	sessions.Lock()
+	entries := sessions.entries
+	sessions.entries = nil
+	sessions.Unlock()
+
+	for _, s := range entries {
+		if err := Logout(ctx, s.httpClient, s.wapiURL); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Unable to log out of %s: %s", s.wapiURL, err.Error()))
+		}
+	}
+}
+
+// Logout ends the NIOS session held in the cookie jar of the HTTP client.
+// It does nothing if the client has no open session, to avoid logging in only to log out.
+func Logout(ctx context.Context, httpClient *http.Client, wapiURL string) error {
+	logoutURL, err := url.Parse(wapiURL + "/logout")
+	if err != nil {
+		return fmt.Errorf("invalid WAPI URL %s: %w", wapiURL, err)
+	}
+	if sessionCookie(httpClient.Jar, logoutURL) == nil {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, logoutURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("error creating logout request: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making logout request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("logout request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+	return nil
+}
+
+// isLogout reports whether the request ends the NIOS session
+func isLogout(req *http.Request) bool {
+	return req.Method == http.MethodPost && path.Base(req.URL.Path) == "logout"
+}
@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// TLSSettings holds the TLS related provider configuration.
+type TLSSettings struct {
+	// SslVerify enables verification of the NIOS server certificate.
+	SslVerify bool
+	// CACertFile is the path to a PEM encoded CA bundle used to verify the NIOS server certificate.
+	CACertFile string
+	// CACertPEM is a PEM encoded CA bundle used to verify the NIOS server certificate.
+	CACertPEM string
+	// ClientCert and ClientKey are the PEM encoded client certificate and key used for certificate based authentication.
+	ClientCert string
+	ClientKey  string
+}
+
+// NewTLSConfig builds the TLS configuration used by every HTTP client of the provider.
+func NewTLSConfig(settings TLSSettings) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: !settings.SslVerify, //nolint:gosec // verification is controlled by the ssl_verify provider attribute
+	}
+
+	caPEM := []byte(settings.CACertPEM)
+	if settings.CACertFile != "" {
+		data, err := os.ReadFile(settings.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate file %s: %w", settings.CACertFile, err)
+		}
+		caPEM = data
+	}
+
+	if len(caPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid PEM encoded certificates found in the CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if settings.ClientCert != "" || settings.ClientKey != "" {
+		if settings.ClientCert == "" || settings.ClientKey == "" {
+			return nil, errors.New("both client certificate and client key must be set for certificate based authentication")
+		}
+		cert, err := tls.X509KeyPair([]byte(settings.ClientCert), []byte(settings.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
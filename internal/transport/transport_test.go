@@ -0,0 +1,557 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestNewTLSConfig_Verification tests that server certificates are verified only when ssl_verify is enabled
+func TestNewTLSConfig_Verification(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	tests := []struct {
+		name      string
+		settings  TLSSettings
+		expectErr bool
+	}{
+		{name: "verification disabled", settings: TLSSettings{}},
+		{name: "verification without CA", settings: TLSSettings{SslVerify: true}, expectErr: true},
+		{name: "verification with CA", settings: TLSSettings{SslVerify: true, CACertPEM: caPEM}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient, err := NewHTTPClient(Settings{TLS: tt.settings})
+			if err != nil {
+				t.Fatalf("Expected no error building the client, got: %v", err)
+			}
+
+			resp, err := httpClient.Get(server.URL)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+			if tt.expectErr && err == nil {
+				t.Error("Expected certificate verification error, got none")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("Expected no error, got: %v", err)
+			}
+		})
+	}
+}
+
+// TestNewTLSConfig_InvalidInput tests that invalid certificate settings are rejected
+func TestNewTLSConfig_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings TLSSettings
+	}{
+		{name: "invalid CA PEM", settings: TLSSettings{CACertPEM: "not a certificate"}},
+		{name: "missing CA file", settings: TLSSettings{CACertFile: "/nonexistent/ca.pem"}},
+		{name: "client cert without key", settings: TLSSettings{ClientCert: "cert"}},
+		{name: "invalid client key pair", settings: TLSSettings{ClientCert: "cert", ClientKey: "key"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTLSConfig(tt.settings); err == nil {
+				t.Error("Expected error, got none")
+			}
+		})
+	}
+}
+
+// TestAuthTransport_ReusesSession tests that basic auth is only sent until a valid session cookie is received
+func TestAuthTransport_ReusesSession(t *testing.T) {
+	var basicAuthCount int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); ok {
+			basicAuthCount++
+			http.SetCookie(w, &http.Cookie{
+				Name:  ibapAuthCookie,
+				Value: fmt.Sprintf("ctime=%d,timeout=600,user=admin", time.Now().Unix()),
+			})
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(Settings{Username: "admin", Password: "secret"})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		resp, err := httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if basicAuthCount != 1 {
+		t.Errorf("Expected basic auth to be sent once, got: %d", basicAuthCount)
+	}
+}
+
+// TestCloudAPITransport_RoutesWrites tests that writes are sent to the cloud member with its own session
+func TestCloudAPITransport_RoutesWrites(t *testing.T) {
+	sessionHandler := func(user string, requests *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			*requests = append(*requests, r.Method+" "+r.URL.RequestURI())
+			if cookie, err := r.Cookie(ibapAuthCookie); err == nil && !strings.Contains(cookie.Value, "user="+user) {
+				t.Errorf("Unexpected session cookie %q sent to %s", cookie.Value, user)
+			}
+			if _, _, ok := r.BasicAuth(); ok {
+				http.SetCookie(w, &http.Cookie{
+					Name:  ibapAuthCookie,
+					Value: fmt.Sprintf("ctime=%d,timeout=600,user=%s", time.Now().Unix(), user),
+				})
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+
+	var gridRequests, cloudRequests []string
+	gridServer := httptest.NewServer(sessionHandler("grid", &gridRequests))
+	defer gridServer.Close()
+	cloudServer := httptest.NewServer(sessionHandler("cloud", &cloudRequests))
+	defer cloudServer.Close()
+
+	httpClient, err := NewHTTPClient(Settings{Username: "admin", Password: "secret", CloudAPIHostURL: cloudServer.URL})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+
+	for _, r := range []struct{ method, path string }{
+		{method: http.MethodGet, path: "/wapi/v2.13.6/record:a"},
+		{method: http.MethodPost, path: "/wapi/v2.13.6/record:a"},
+		{method: http.MethodPost, path: "/wapi/v2.13.6/fileop?_function=uploadinit"},
+		{method: http.MethodPut, path: "/wapi/v2.13.6/record:a/ZG5z:a"},
+		{method: http.MethodGet, path: "/wapi/v2.13.6/record:a/ZG5z:a"},
+		{method: http.MethodDelete, path: "/wapi/v2.13.6/record:a/ZG5z:a"},
+	} {
+		req, err := http.NewRequest(r.method, gridServer.URL+r.path, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	expectedGrid := []string{"GET /wapi/v2.13.6/record:a", "POST /wapi/v2.13.6/fileop?_function=uploadinit", "GET /wapi/v2.13.6/record:a/ZG5z:a"}
+	expectedCloud := []string{"POST /wapi/v2.13.6/record:a", "PUT /wapi/v2.13.6/record:a/ZG5z:a", "DELETE /wapi/v2.13.6/record:a/ZG5z:a"}
+	if !slices.Equal(gridRequests, expectedGrid) {
+		t.Errorf("Expected Grid Master requests %v, got %v", expectedGrid, gridRequests)
+	}
+	if !slices.Equal(cloudRequests, expectedCloud) {
+		t.Errorf("Expected cloud member requests %v, got %v", expectedCloud, cloudRequests)
+	}
+}
+
+// TestCloudAPITransport_DelegatedMemberWrites tests that writes setting cloud_info.delegated_member
+// reach the cloud member unchanged while reading the delegation back is served by the Grid Master
+func TestCloudAPITransport_DelegatedMemberWrites(t *testing.T) {
+	type delegatedMember struct {
+		Name     string `json:"name"`
+		Ipv4addr string `json:"ipv4addr"`
+	}
+	type payload struct {
+		CloudInfo struct {
+			DelegatedMember delegatedMember `json:"delegated_member"`
+		} `json:"cloud_info"`
+	}
+
+	var (
+		mu              sync.Mutex
+		gridRequests    []string
+		cloudRequests   []string
+		cloudDelegation []delegatedMember
+	)
+	gridServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gridRequests = append(gridRequests, r.Method+" "+r.URL.RequestURI())
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer gridServer.Close()
+	cloudServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body payload
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Expected a JSON body on the cloud member, got: %v", err)
+		}
+		mu.Lock()
+		cloudRequests = append(cloudRequests, r.Method+" "+r.URL.RequestURI())
+		cloudDelegation = append(cloudDelegation, body.CloudInfo.DelegatedMember)
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer cloudServer.Close()
+
+	httpClient, err := NewHTTPClient(Settings{CloudAPIHostURL: cloudServer.URL})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+
+	body := `{"name": "a.example.com", "ipv4addr": "10.0.0.1", "cloud_info": {"delegated_member": {"name": "cloud-member.example.com", "ipv4addr": "10.0.0.10"}}}`
+	for _, r := range []struct{ method, path string }{
+		{method: http.MethodPost, path: "/wapi/v2.13.6/record:a"},
+		{method: http.MethodPut, path: "/wapi/v2.13.6/record:a/ZG5z:a"},
+	} {
+		req, err := http.NewRequest(r.method, gridServer.URL+r.path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	resp, err := httpClient.Get(gridServer.URL + "/wapi/v2.13.6/record:a/ZG5z:a?_return_fields=cloud_info")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	expectedCloud := []string{"POST /wapi/v2.13.6/record:a", "PUT /wapi/v2.13.6/record:a/ZG5z:a"}
+	expectedGrid := []string{"GET /wapi/v2.13.6/record:a/ZG5z:a?_return_fields=cloud_info"}
+	if !slices.Equal(cloudRequests, expectedCloud) {
+		t.Errorf("Expected cloud member requests %v, got %v", expectedCloud, cloudRequests)
+	}
+	if !slices.Equal(gridRequests, expectedGrid) {
+		t.Errorf("Expected Grid Master requests %v, got %v", expectedGrid, gridRequests)
+	}
+	for _, member := range cloudDelegation {
+		if member.Name != "cloud-member.example.com" || member.Ipv4addr != "10.0.0.10" {
+			t.Errorf("Expected the delegated member to reach the cloud member, got %+v", member)
+		}
+	}
+}
+
+// TestCloudAPITransport_GridMasterWrites tests that writes marked with WithGridMaster are not sent to the cloud member
+func TestCloudAPITransport_GridMasterWrites(t *testing.T) {
+	var gridRequests, cloudRequests int32
+	gridServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&gridRequests, 1)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer gridServer.Close()
+	cloudServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&cloudRequests, 1)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer cloudServer.Close()
+
+	httpClient, err := NewHTTPClient(Settings{CloudAPIHostURL: cloudServer.URL})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(WithGridMaster(context.Background()), http.MethodPost, gridServer.URL+"/wapi/v2.13.6/extensibleattributedef", strings.NewReader(`{"name": "Terraform Internal ID"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if gridRequests != 1 || cloudRequests != 0 {
+		t.Errorf("Expected the write to be sent to the Grid Master only, got %d Grid Master and %d cloud member requests", gridRequests, cloudRequests)
+	}
+}
+
+// TestLimitTransport tests that the concurrency cap and the request rate are enforced
+func TestLimitTransport(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(Settings{MaxConcurrentRequests: 2, RequestsPerSecond: 100})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+
+	const requests = 10
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := httpClient.Get(server.URL)
+			if err != nil {
+				t.Errorf("Expected no error, got: %v", err)
+				return
+			}
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+	// 10 requests at 100 requests per second cannot complete in less than 90ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected the request rate to be limited, 10 requests took %s", elapsed)
+	}
+}
+
+// TestLimitTransport_HoldsSlotUntilBodyClosed tests that a request keeps its concurrency slot while its response body is streamed
+func TestLimitTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		// Send the headers first and stream the body afterwards, like a large list response
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"result": []}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(Settings{MaxConcurrentRequests: 1})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := httpClient.Get(server.URL)
+			if err != nil {
+				t.Errorf("Expected no error, got: %v", err)
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 1 {
+		t.Errorf("Expected at most 1 request in flight while its body is read, got %d", maxInFlight)
+	}
+}
+
+// TestAuthTransport_SessionLifecycle tests that concurrent requests log in once, that a session
+// rejected by the server is refreshed and that the session is closed on logout
+func TestAuthTransport_SessionLifecycle(t *testing.T) {
+	var mu sync.Mutex
+	var logins, logouts int
+	sessions := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, _, ok := r.BasicAuth(); ok {
+			logins++
+			value := fmt.Sprintf("ctime=%d,timeout=600,user=admin,session=%d", time.Now().Unix(), logins)
+			sessions[value] = true
+			http.SetCookie(w, &http.Cookie{Name: ibapAuthCookie, Value: value, Path: "/"})
+		} else if cookie, err := r.Cookie(ibapAuthCookie); err != nil || !sessions[cookie.Value] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/wapi/v2.13.6/logout" {
+			logouts++
+			cookie, _ := r.Cookie(ibapAuthCookie)
+			delete(sessions, cookie.Value)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(Settings{Username: "admin", Password: "secret"})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+	get := func() {
+		resp, err := httpClient.Get(server.URL + "/wapi/v2.13.6/grid")
+		if err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+			return
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Expected status 200, got %d", resp.StatusCode)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			get()
+		}()
+	}
+	wg.Wait()
+	if logins != 1 {
+		t.Errorf("Expected concurrent requests to log in once, got %d logins", logins)
+	}
+
+	// The server drops the session, the next request logs in again
+	mu.Lock()
+	clear(sessions)
+	mu.Unlock()
+	get()
+	if logins != 2 {
+		t.Errorf("Expected the rejected session to be refreshed, got %d logins", logins)
+	}
+
+	RegisterSession(httpClient, server.URL+"/wapi/v2.13.6")
+	CloseSessions(context.Background())
+	if logouts != 1 || len(sessions) != 0 {
+		t.Errorf("Expected the session to be closed, got %d logouts and %d open sessions", logouts, len(sessions))
+	}
+	if logins != 2 {
+		t.Errorf("Expected logout to reuse the session, got %d logins", logins)
+	}
+}
+
+// TestBatchTransport tests that concurrent reads are sent in one multi-request and sent
+// individually when the multi-request fails
+func TestBatchTransport(t *testing.T) {
+	var mu sync.Mutex
+	var multiRequests, reads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodPost && r.URL.Path == "/wapi/v2.13.6/request" {
+			multiRequests++
+			var calls []multiRequestCall
+			if err := json.NewDecoder(r.Body).Decode(&calls); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			results := make([]map[string]string, len(calls))
+			for i, call := range calls {
+				if strings.Contains(call.Object, "missing") {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				results[i] = map[string]string{"_ref": call.Object, "view": call.Args["_return_fields+"]}
+			}
+			_ = json.NewEncoder(w).Encode(results)
+			return
+		}
+		reads++
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"result": {"_ref": %q, "view": %q}}`, strings.TrimPrefix(r.URL.Path, "/wapi/v2.13.6/"), r.URL.Query().Get("_return_fields+"))
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(Settings{BatchReads: true})
+	if err != nil {
+		t.Fatalf("Expected no error building the client, got: %v", err)
+	}
+
+	readAll := func(refs []string) []string {
+		bodies := make([]string, len(refs))
+		var wg sync.WaitGroup
+		for i, ref := range refs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := httpClient.Get(fmt.Sprintf("%s/wapi/v2.13.6/record:a/%s?_return_fields%%2B=view&_return_as_object=1", server.URL, url.PathEscape(ref)))
+				if err != nil {
+					t.Errorf("Expected no error, got: %v", err)
+					return
+				}
+				defer func() { _ = resp.Body.Close() }()
+				body, _ := io.ReadAll(resp.Body)
+				bodies[i] = fmt.Sprintf("%d %s", resp.StatusCode, strings.TrimSpace(string(body)))
+			}()
+		}
+		wg.Wait()
+		return bodies
+	}
+
+	bodies := readAll([]string{"ZG5z:a.example.com/default", "ZG5z:b.example.com/default", "ZG5z:c.example.com/default"})
+	if multiRequests != 1 || reads != 0 {
+		t.Errorf("Expected a single multi-request, got %d multi-requests and %d reads", multiRequests, reads)
+	}
+	for _, body := range bodies {
+		if !strings.HasPrefix(body, `200 {"result":{"_ref":"record:a/ZG5z:`) || !strings.Contains(body, `"view":"view"`) {
+			t.Errorf("Unexpected response %s", body)
+		}
+	}
+
+	bodies = readAll([]string{"ZG5z:a.example.com/default", "ZG5z:missing.example.com/default"})
+	if multiRequests != 2 || reads != 2 {
+		t.Errorf("Expected the reads to be sent individually, got %d multi-requests and %d reads", multiRequests, reads)
+	}
+	if !strings.HasPrefix(bodies[0], "200 ") || !strings.HasPrefix(bodies[1], "404 ") {
+		t.Errorf("Expected statuses 200 and 404, got %v", bodies)
+	}
+}
+
+// TestSessionCookieValid tests the expiry check of the ibapauth cookie
+func TestSessionCookieValid(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		value    string
+		expected bool
+	}{
+		{name: "valid", value: fmt.Sprintf("ctime=%d,timeout=600", now.Unix()), expected: true},
+		{name: "expired", value: fmt.Sprintf("ctime=%d,timeout=600", now.Add(-time.Hour).Unix()), expected: false},
+		{name: "malformed", value: "garbage", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sessionCookieValid(&http.Cookie{Value: tt.value}, now); got != tt.expected {
+				t.Errorf("Expected %v, got: %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// DNSKEYProtocol is the only protocol value allowed in a DNSKEY record, see RFC 4034 section 2.1.2
+const DNSKEYProtocol = 3
+
+// DNSKEYFlags returns the flags of a zone key, with the secure entry point bit set for key signing keys
+func DNSKEYFlags(keyType string) int64 {
+	if strings.EqualFold(keyType, "KSK") {
+		return 257
+	}
+	return 256
+}
+
+// DSDigest computes the digest of a DS record for the given DNSKEY, see RFC 4034 section 5.1.4
+// The public key is expected in base64 as published in the DNSKEY record, and the digest is returned in upper case hex
+func DSDigest(owner string, flags, protocol, algorithm int64, publicKey string, digestType int64) (string, error) {
+	var h hash.Hash
+	switch digestType {
+	case 1:
+		h = sha1.New()
+	case 2:
+		h = sha256.New()
+	case 4:
+		h = sha512.New384()
+	default:
+		return "", fmt.Errorf("unsupported DS digest type %d", digestType)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(publicKey), ""))
+	if err != nil {
+		return "", fmt.Errorf("error decoding public key: %w", err)
+	}
+
+	name, err := canonicalWireName(owner)
+	if err != nil {
+		return "", err
+	}
+
+	rdata := make([]byte, 4, 4+len(key))
+	binary.BigEndian.PutUint16(rdata[0:2], uint16(flags))
+	rdata[2] = byte(protocol)
+	rdata[3] = byte(algorithm)
+	rdata = append(rdata, key...)
+
+	h.Write(name)
+	h.Write(rdata)
+	return strings.ToUpper(hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// ZoneOwnerName returns the owner name of the apex records of a zone.
+// NIOS returns the fqdn of reverse zones in address/cidr format, which is converted to the in-addr.arpa or
+// ip6.arpa name. IPv4 zones with a netmask longer than 24 bits are named after their RFC 2317 prefix,
+// which defaults to the last octet and the netmask, such as 128/26. Forward zones are returned unchanged.
+func ZoneOwnerName(fqdn, prefix string) (string, error) {
+	ip, network, err := net.ParseCIDR(fqdn)
+	if err != nil {
+		return fqdn, nil
+	}
+	ones, _ := network.Mask.Size()
+
+	if ip4 := network.IP.To4(); ip4 != nil {
+		var labels []string
+		switch {
+		case ones > 24:
+			if prefix == "" {
+				prefix = fmt.Sprintf("%d/%d", ip4[3], ones)
+			}
+			labels = append(labels, prefix)
+			ones = 24
+		case ones%8 != 0:
+			return "", fmt.Errorf("unsupported netmask in reverse zone %s", fqdn)
+		}
+		for i := ones/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(ip4[i])))
+		}
+		return strings.Join(append(labels, "in-addr.arpa"), "."), nil
+	}
+
+	if ones%4 != 0 {
+		return "", fmt.Errorf("unsupported prefix length in reverse zone %s", fqdn)
+	}
+	nibbles := hex.EncodeToString(ip.Mask(network.Mask).To16())[:ones/4]
+	labels := make([]string, 0, len(nibbles)+1)
+	for i := len(nibbles) - 1; i >= 0; i-- {
+		labels = append(labels, string(nibbles[i]))
+	}
+	return strings.Join(append(labels, "ip6.arpa"), "."), nil
+}
+
+// canonicalWireName encodes a domain name in the lower case wire format used for DNSSEC digests
+func canonicalWireName(name string) ([]byte, error) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	var wire []byte
+	if name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if label == "" || len(label) > 63 {
+				return nil, fmt.Errorf("invalid domain name %q", name)
+			}
+			wire = append(wire, byte(len(label)))
+			wire = append(wire, label...)
+		}
+	}
+	return append(wire, 0), nil
+}
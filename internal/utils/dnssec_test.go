@@ -0,0 +1,90 @@
+package utils
+
+import "testing"
+
+// TestDSDigest checks the digests against the examples of RFC 4034 section 5.4 and RFC 4509 section 2.3
+func TestDSDigest(t *testing.T) {
+	publicKey := "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw=="
+	tests := []struct {
+		name       string
+		owner      string
+		digestType int64
+		expected   string
+	}{
+		{
+			name:       "SHA-1",
+			owner:      "dskey.example.com.",
+			digestType: 1,
+			expected:   "2BB183AF5F22588179A53B0A98631FAD1A292118",
+		},
+		{
+			name:       "SHA-256",
+			owner:      "dskey.example.com",
+			digestType: 2,
+			expected:   "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+		},
+		{
+			name:       "reverse zone",
+			owner:      "1.168.192.in-addr.arpa.",
+			digestType: 2,
+			expected:   "FE6BBE4918D5A234743DF373CC50632E6B6D3CD01FE7097FF02AB6D8A9D0A810",
+		},
+		{
+			name:       "upper case owner",
+			owner:      "DSKEY.Example.COM",
+			digestType: 2,
+			expected:   "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digest, err := DSDigest(tt.owner, 256, DNSKEYProtocol, 5, publicKey, tt.digestType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if digest != tt.expected {
+				t.Errorf("expected digest %s, got %s", tt.expected, digest)
+			}
+		})
+	}
+
+	if _, err := DSDigest("dskey.example.com", 256, DNSKEYProtocol, 5, publicKey, 3); err == nil {
+		t.Error("expected an error for an unsupported digest type")
+	}
+}
+
+// TestZoneOwnerName checks the conversion of reverse zones from address/cidr format to their arpa name
+func TestZoneOwnerName(t *testing.T) {
+	tests := []struct {
+		name     string
+		fqdn     string
+		prefix   string
+		expected string
+	}{
+		{name: "forward zone", fqdn: "example.com", expected: "example.com"},
+		{name: "IPv4 /24", fqdn: "192.168.1.0/24", expected: "1.168.192.in-addr.arpa"},
+		{name: "IPv4 /16", fqdn: "10.20.0.0/16", expected: "20.10.in-addr.arpa"},
+		{name: "IPv4 /8", fqdn: "10.0.0.0/8", expected: "10.in-addr.arpa"},
+		{name: "IPv4 RFC 2317 default prefix", fqdn: "192.168.1.128/26", expected: "128/26.1.168.192.in-addr.arpa"},
+		{name: "IPv4 RFC 2317 prefix", fqdn: "192.168.1.128/26", prefix: "128-189", expected: "128-189.1.168.192.in-addr.arpa"},
+		{name: "IPv6 /32", fqdn: "2001:db8::/32", expected: "8.b.d.0.1.0.0.2.ip6.arpa"},
+		{name: "IPv6 /64", fqdn: "2001:db8:1:2::/64", expected: "2.0.0.0.1.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, err := ZoneOwnerName(tt.fqdn, tt.prefix)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if owner != tt.expected {
+				t.Errorf("expected owner %s, got %s", tt.expected, owner)
+			}
+		})
+	}
+
+	if _, err := ZoneOwnerName("10.16.0.0/12", ""); err == nil {
+		t.Error("expected an error for a netmask that is not on an octet boundary")
+	}
+}
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"io"
 	"net/http"
@@ -29,13 +28,12 @@ func ConfigureGridDNSResolver(ctx context.Context, gridClient *grid.APIClient, d
 	}
 
 	// Extract configuration from grid client for restart services call
-	baseURL := gridClient.Cfg.NIOSHostURL
-	username := gridClient.Cfg.NIOSUsername
-	password := gridClient.Cfg.NIOSPassword
+	httpClient := gridClient.Cfg.HTTPClient
+	wapiURL := WAPIBaseURL(gridClient.Cfg)
 
 	// Restart grid services
 	tflog.Debug(ctx, "Restarting grid services")
-	err = restartGridServices(ctx, baseURL, username, password, gridRef)
+	err = restartGridServices(ctx, httpClient, wapiURL, gridRef)
 	if err != nil {
 		return fmt.Errorf("error restarting grid services: %w", err)
 	}
@@ -88,22 +86,14 @@ func updateGridDNSResolverUsingClient(ctx context.Context, gridClient *grid.APIC
 }
 
 // restartGridServices restarts the grid services using the WAPI function call
-func restartGridServices(ctx context.Context, baseURL, username, password, gridRef string) error {
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		},
-	}
-
-	restartURL := fmt.Sprintf("%s/wapi/v2.13.6/%s?_function=restartservices", baseURL, gridRef)
+func restartGridServices(ctx context.Context, httpClient *http.Client, wapiURL, gridRef string) error {
+	restartURL := fmt.Sprintf("%s/%s?_function=restartservices", wapiURL, gridRef)
 
 	req, err := http.NewRequestWithContext(ctx, "POST", restartURL, nil)
 	if err != nil {
 		return fmt.Errorf("error creating restart services request: %w", err)
 	}
 
-	req.SetBasicAuth(username, password)
-
 	tflog.Debug(ctx, fmt.Sprintf("Making restart services request to: %s", restartURL))
 	resp, err := httpClient.Do(req)
 	if err != nil {
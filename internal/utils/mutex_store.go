@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"slices"
 	"sync"
 )
 
@@ -43,3 +44,17 @@ func (s *mutexStore) Lock(key string) {
 func (s *mutexStore) Unlock(key string) {
 	s.get(key).Unlock()
 }
+
+// LockKeys locks the mutexes of the given keys and returns a function unlocking them.
+// The keys are locked in sorted order, so that callers locking overlapping keys cannot deadlock.
+func (s *mutexStore) LockKeys(keys []string) func() {
+	sorted := slices.Compact(slices.Sorted(slices.Values(keys)))
+	for _, key := range sorted {
+		s.Lock(key)
+	}
+	return func() {
+		for i := len(sorted) - 1; i >= 0; i-- {
+			s.Unlock(sorted[i])
+		}
+	}
+}
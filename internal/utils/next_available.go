@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// refRegex matches WAPI object references, e.g. network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default
+var refRegex = regexp.MustCompile(`^[a-z][a-z0-9:_]*/[^/:]+:`)
+
+// nextAvailableFuncPrefix is the prefix of the next available function strings, e.g. func:nextavailableip:10.0.0.0/24,default
+const nextAvailableFuncPrefix = "func:nextavailable"
+
+// NextAvailableKeys returns the keys of the networks and ranges a WAPI payload allocates from,
+// either through a func_call invoking a next_available function or a func:nextavailable string.
+// Allocations from the same network share the same key whichever form they use.
+func NextAvailableKeys(payload any) []string {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+
+	keys := make(map[string]struct{})
+	collectNextAvailableKeys(value, keys)
+
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func collectNextAvailableKeys(value any, keys map[string]struct{}) {
+	switch v := value.(type) {
+	case map[string]any:
+		if function, ok := v["_object_function"].(string); ok && strings.HasPrefix(function, "next_available") {
+			object, _ := v["_object"].(string)
+			parameters, _ := v["_object_parameters"].(map[string]any)
+			keys[objectFunctionKey(object, parameters)] = struct{}{}
+		}
+		for _, item := range v {
+			collectNextAvailableKeys(item, keys)
+		}
+	case []any:
+		for _, item := range v {
+			collectNextAvailableKeys(item, keys)
+		}
+	case string:
+		if strings.HasPrefix(v, nextAvailableFuncPrefix) {
+			keys[funcStringKey(v)] = struct{}{}
+		}
+	}
+}
+
+// objectFunctionKey returns the key of a func_call searching the object to allocate from with its parameters
+func objectFunctionKey(object string, parameters map[string]any) string {
+	if network, ok := parameters["network"].(string); ok && len(parameters) <= 2 {
+		view, _ := parameters["network_view"].(string)
+		return networkKey(network, view)
+	}
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = fmt.Sprintf("%s=%v", name, parameters[name])
+	}
+	return fmt.Sprintf("%s:%s", object, strings.Join(pairs, ","))
+}
+
+// funcStringKey returns the key of a func:nextavailable string, whose arguments are a network
+// in CIDR notation or the reference of the network or range, optionally followed by the view
+func funcStringKey(value string) string {
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 {
+		return value
+	}
+	args := strings.Split(parts[2], ",")
+	if refRegex.MatchString(args[0]) {
+		return refKey(args[0])
+	}
+	view := ""
+	if len(args) > 1 {
+		view = args[1]
+	}
+	return networkKey(args[0], view)
+}
+
+// refKey returns the key of an object reference. The references of networks end with the network
+// in CIDR notation and its view, e.g. network/ZG5z...:10.0.0.0/24/default, so they share the key of
+// the CIDR form. Other references, such as ranges, are keyed by the reference itself.
+func refKey(ref string) string {
+	objectType, rest, _ := strings.Cut(ref, "/")
+	switch objectType {
+	case "network", "ipv6network", "networkcontainer", "ipv6networkcontainer":
+		_, name, _ := strings.Cut(rest, ":")
+		if address, rest, ok := strings.Cut(name, "/"); ok {
+			if prefix, view, ok := strings.Cut(rest, "/"); ok && view != "" {
+				return networkKey(address+"/"+prefix, view)
+			}
+		}
+	}
+	return "ref:" + ref
+}
+
+func networkKey(network, view string) string {
+	if view == "" {
+		view = "default"
+	}
+	return fmt.Sprintf("network:%s,%s", network, view)
+}
+
+// LockNextAvailable serializes the allocations of a WAPI payload with the other allocations from the
+// same networks and ranges made by this provider, so that parallel resources get distinct addresses.
+// The returned function releases the locks.
+// No WAPI side reservation is made: NIOS allocates the address in the same request that creates the
+// object, and does not offer a reservation call for the next_available functions, so the lock only
+// covers the allocations of this provider process.
+func LockNextAvailable(payload any) func() {
+	return GlobalMutexStore.LockKeys(NextAvailableKeys(payload))
+}
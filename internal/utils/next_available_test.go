@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/infobloxopen/infoblox-nios-go-client/dns"
+)
+
+// TestNextAvailableKeys tests that allocations from the same network share a key whichever form they use
+func TestNextAvailableKeys(t *testing.T) {
+	function := "next_available_ip"
+	object := "network"
+	tests := []struct {
+		name     string
+		payload  any
+		expected []string
+	}{
+		{
+			name: "func_call",
+			payload: dns.RecordA{FuncCall: &dns.FuncCall{
+				AttributeName:    "ipv4addr",
+				ObjectFunction:   &function,
+				Object:           &object,
+				ObjectParameters: map[string]any{"network": "10.0.0.0/24", "network_view": "default"},
+			}},
+			expected: []string{"network:10.0.0.0/24,default"},
+		},
+		{
+			name: "func strings",
+			payload: map[string]any{"ipv4addrs": []any{
+				map[string]any{"ipv4addr": "func:nextavailableip:10.0.0.0/24"},
+				map[string]any{"ipv4addr": "func:nextavailableip:10.0.0.0/24,default"},
+				map[string]any{"ipv6addr": "func:nextavailableip:2001:db8::/64,lab"},
+			}},
+			expected: []string{"network:10.0.0.0/24,default", "network:2001:db8::/64,lab"},
+		},
+		{
+			name: "network reference",
+			payload: map[string]any{"ipv4addrs": []any{
+				map[string]any{"ipv4addr": "func:nextavailableip:network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default"},
+				map[string]any{"ipv4addr": "func:nextavailableip:10.0.0.0/24"},
+				map[string]any{"ipv6addr": "func:nextavailableip:ipv6network/ZG5zLm5ldHdvcmskMjAwMTpkYjg6Oi82NC8x:2001:db8::/64/lab"},
+			}},
+			expected: []string{"network:10.0.0.0/24,default", "network:2001:db8::/64,lab"},
+		},
+		{
+			name:     "range reference",
+			payload:  map[string]any{"ipv4addr": "func:nextavailableip:range/ZG5zLmRoY3BfcmFuZ2UkMTAuMC4wLjEwLzEwLjAuMC4yMC8vLzAv:10.0.0.10-10.0.0.20/default"},
+			expected: []string{"ref:range/ZG5zLmRoY3BfcmFuZ2UkMTAuMC4wLjEwLzEwLjAuMC4yMC8vLzAv:10.0.0.10-10.0.0.20/default"},
+		},
+		{
+			name:     "no allocation",
+			payload:  dns.RecordA{},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NextAvailableKeys(tt.payload); !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestLockKeys tests that allocations locking the same keys are serialized
+func TestLockKeys(t *testing.T) {
+	store := newMutexStore()
+	allocated := make(map[int]bool)
+	next := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := store.LockKeys([]string{"network:10.0.0.0/24,default", "network:10.0.0.0/24,default"})
+			defer unlock()
+			// Read the next available address and allocate it in two steps, like a WAPI search followed by a create
+			address := next
+			if allocated[address] {
+				t.Errorf("Address %d allocated twice", address)
+			}
+			allocated[address] = true
+			next = address + 1
+		}()
+	}
+	wg.Wait()
+
+	if len(allocated) != 100 {
+		t.Errorf("Expected 100 distinct addresses, got %d", len(allocated))
+	}
+}
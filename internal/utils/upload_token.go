@@ -3,7 +3,6 @@ package utils
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -22,24 +21,18 @@ type UploadInitResponse struct {
 }
 
 // GenerateUploadToken generates an upload token and URL by calling the NIOS uploadinit API
-func GenerateUploadToken(ctx context.Context, baseURL, username, password string) (*UploadInitResponse, error) {
+// wapiURL is the versioned WAPI base URL, see WAPIBaseURL
+func GenerateUploadToken(ctx context.Context, httpClient *http.Client, wapiURL string) (*UploadInitResponse, error) {
 	var uploadInitResponse UploadInitResponse
-	// Extract configuration values using reflection for flexibility
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		},
-	}
 
 	// Generate upload token and URL by calling uploadinit
-	uploadInitURL := fmt.Sprintf("%s/wapi/v2.13.6/fileop?_function=uploadinit", baseURL)
+	uploadInitURL := fmt.Sprintf("%s/fileop?_function=uploadinit", wapiURL)
 	req, err := http.NewRequestWithContext(ctx, "POST", uploadInitURL, bytes.NewReader([]byte("{}")))
 	if err != nil {
 		return &uploadInitResponse, fmt.Errorf("error creating uploadinit request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(username, password)
 
 	tflog.Debug(ctx, fmt.Sprintf("Making uploadinit request to: %s", uploadInitURL))
 	resp, err := httpClient.Do(req)
@@ -62,12 +55,7 @@ func GenerateUploadToken(ctx context.Context, baseURL, username, password string
 }
 
 // UploadFile uploads a file to the Infoblox NIOS server using the provided upload URL.
-func UploadFile(ctx context.Context, uploadURL, filePath, username, password string) error {
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		},
-	}
+func UploadFile(ctx context.Context, httpClient *http.Client, uploadURL, filePath string) error {
 	file, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("error opening the file: %w", err)
@@ -98,7 +86,6 @@ func UploadFile(ctx context.Context, uploadURL, filePath, username, password str
 	}
 
 	uploadReq.Header.Set("Content-Type", writer.FormDataContentType())
-	uploadReq.SetBasicAuth(username, password)
 
 	tflog.Debug(ctx, fmt.Sprintf("Uploading the file to: %s", uploadURL))
 	uploadResp, err := httpClient.Do(uploadReq)
@@ -117,15 +104,16 @@ func UploadFile(ctx context.Context, uploadURL, filePath, username, password str
 
 // UploadFileWithToken handles the complete process of generating an upload token and uploading a file
 // It returns the token from the successful upload or an error if any step fails
-func UploadFileWithToken(ctx context.Context, baseUrl, filePath, username, password string) (string, error) {
+// The requests are sent with the given HTTP client, so they use the TLS settings and the session of the provider
+func UploadFileWithToken(ctx context.Context, httpClient *http.Client, wapiURL, filePath string) (string, error) {
 	// Generate the upload token
-	uploadInitResponse, err := GenerateUploadToken(ctx, baseUrl, username, password)
+	uploadInitResponse, err := GenerateUploadToken(ctx, httpClient, wapiURL)
 	if err != nil {
 		return "", fmt.Errorf("unable to generate upload token: %w", err)
 	}
 
 	// Upload the file using the token URL
-	if err = UploadFile(ctx, uploadInitResponse.URL, filePath, username, password); err != nil {
+	if err = UploadFile(ctx, httpClient, uploadInitResponse.URL, filePath); err != nil {
 		return "", fmt.Errorf("unable to upload file: %w", err)
 	}
 
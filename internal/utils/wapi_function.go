@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// WAPIResponseError is returned when WAPI answers a direct call with an error status.
+// Like the errors of the NIOS client it exposes the response body, so that the WAPI error can be parsed.
+type WAPIResponseError struct {
+	StatusCode int
+	body       []byte
+	msg        string
+}
+
+func (e *WAPIResponseError) Error() string {
+	return e.msg
+}
+
+// Body returns the body of the error response
+func (e *WAPIResponseError) Body() []byte {
+	return e.body
+}
+
+func newWAPIResponseError(request string, resp *http.Response, body []byte) error {
+	return &WAPIResponseError{
+		StatusCode: resp.StatusCode,
+		body:       body,
+		msg:        fmt.Sprintf("%s request failed with status %d: %s", request, resp.StatusCode, string(body)),
+	}
+}
+
+// CallWAPIFunction invokes a WAPI function on the object identified by objectRef
+// The body is sent as JSON when it is not nil, and the raw response body is returned along with the HTTP response
+// wapiURL is the versioned WAPI base URL, see WAPIBaseURL
+// httpClient must be the HTTP client of the NIOS client, its transport authenticates the request
+func CallWAPIFunction(ctx context.Context, httpClient *http.Client, wapiURL, objectRef, function string, body any) ([]byte, *http.Response, error) {
+	functionURL := fmt.Sprintf("%s/%s?_function=%s", wapiURL, objectRef, function)
+
+	payload := []byte("{}")
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error encoding %s request body: %w", function, err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", functionURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating %s request: %w", function, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	tflog.Debug(ctx, fmt.Sprintf("Making %s request to: %s", function, functionURL))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error making %s request: %w", function, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, fmt.Errorf("error reading %s response: %w", function, err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, resp, newWAPIResponseError(function, resp, respBody)
+	}
+
+	return respBody, resp, nil
+}
+
+// DeleteWAPIObject deletes the object identified by objectRef for object types that the client does not expose a Delete call for
+// A missing object is not treated as an error
+func DeleteWAPIObject(ctx context.Context, httpClient *http.Client, wapiURL, objectRef string) (*http.Response, error) {
+	deleteURL := fmt.Sprintf("%s/%s", wapiURL, objectRef)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", deleteURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating delete request: %w", err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Making delete request to: %s", deleteURL))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making delete request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return resp, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return resp, newWAPIResponseError("delete", resp, bodyBytes)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/infobloxopen/terraform-provider-nios/internal/retry"
+)
+
+// TestCallWAPIFunction_Retry tests that the errors of a WAPI function call are retried like the errors of the NIOS client
+func TestCallWAPIFunction_Retry(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		expectedCalls int
+	}{
+		{
+			name:          "service unavailable",
+			status:        http.StatusServiceUnavailable,
+			body:          "Service Unavailable",
+			expectedCalls: 2,
+		},
+		{
+			name:          "database locked",
+			status:        http.StatusBadRequest,
+			body:          `{"Error": "AdmConDataError: None (IBDataError: IB.Data:The database is locked)", "code": "Client.Ibap.Data", "text": "The database is locked"}`,
+			expectedCalls: 2,
+		},
+		{
+			name:          "invalid token",
+			status:        http.StatusBadRequest,
+			body:          `{"Error": "AdmConProtoError: Invalid token", "code": "Client.Ibap.Proto", "text": "Invalid token"}`,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Query().Get("_function") != "add_certificate" {
+					t.Errorf("Expected add_certificate function, got %s", r.URL.RawQuery)
+				}
+				if calls == 1 {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(tt.body))
+					return
+				}
+				_, _ = w.Write([]byte("{}"))
+			}))
+			defer server.Close()
+
+			err := retry.DoWithTimeout(context.Background(), 10*time.Second, retry.TransientErrors, func(ctx context.Context) (int, error) {
+				_, httpRes, callErr := CallWAPIFunction(ctx, server.Client(), server.URL+"/wapi/v2.13.6", "dtc", "add_certificate", map[string]string{"token": "token"})
+				if httpRes != nil {
+					return httpRes.StatusCode, callErr
+				}
+				return 0, callErr
+			})
+
+			if calls != tt.expectedCalls {
+				t.Errorf("Expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+			if tt.expectedCalls > 1 && err != nil {
+				t.Errorf("Expected the retried call to succeed, got %v", err)
+			}
+			if tt.expectedCalls == 1 {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				if _, ok := retry.ParseWAPIError(err); !ok {
+					t.Errorf("Expected the WAPI error body to be readable, got %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+)
+
+// DefaultWAPIVersion is the WAPI version the NIOS go client is generated for
+const DefaultWAPIVersion = "2.13.6"
+
+var wapiPathRegex = regexp.MustCompile(`/wapi/v([0-9.]+)/?$`)
+
+// WAPIConfiguration is implemented by the configuration of every WAPI service client
+type WAPIConfiguration interface {
+	ServerURL(index int, variables map[string]string) (string, error)
+}
+
+// wapiSchemaResponse represents the response of the WAPI ?_schema call
+type wapiSchemaResponse struct {
+	SupportedVersions []string `json:"supported_versions"`
+}
+
+// NormalizeWAPIVersion strips the optional "v" prefix of a WAPI version
+func NormalizeWAPIVersion(version string) string {
+	return strings.TrimPrefix(strings.TrimSpace(version), "v")
+}
+
+// CompareWAPIVersions compares two WAPI versions such as "2.12" and "2.13.6".
+// It returns -1 if a < b, 0 if a == b and 1 if a > b. Missing components are treated as 0.
+func CompareWAPIVersions(a, b string) int {
+	aParts := strings.Split(NormalizeWAPIVersion(a), ".")
+	bParts := strings.Split(NormalizeWAPIVersion(b), ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aNum, bNum int
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+		if aNum != bNum {
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// SelectWAPIVersion picks the WAPI version to use from the versions supported by the Grid.
+// The newest supported version not newer than DefaultWAPIVersion is preferred, as the provider
+// models are generated for it. If the Grid only supports newer versions, the oldest of them is used.
+func SelectWAPIVersion(supportedVersions []string) (string, error) {
+	var selected, oldestNewer string
+	for _, version := range supportedVersions {
+		version = NormalizeWAPIVersion(version)
+		if CompareWAPIVersions(version, DefaultWAPIVersion) <= 0 {
+			if selected == "" || CompareWAPIVersions(version, selected) > 0 {
+				selected = version
+			}
+		} else if oldestNewer == "" || CompareWAPIVersions(version, oldestNewer) < 0 {
+			oldestNewer = version
+		}
+	}
+	if selected == "" {
+		selected = oldestNewer
+	}
+	if selected == "" {
+		return "", fmt.Errorf("no supported WAPI versions returned by the Grid")
+	}
+	return selected, nil
+}
+
+// DetectWAPIVersion queries the WAPI schema of the Grid and returns the WAPI version to use
+func DetectWAPIVersion(ctx context.Context, httpClient *http.Client, hostURL string) (string, error) {
+	schemaURL := fmt.Sprintf("%s/wapi/v1.0/?_schema", strings.TrimSuffix(hostURL, "/"))
+	req, err := http.NewRequestWithContext(ctx, "GET", schemaURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating schema request: %w", err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Detecting WAPI version using: %s", schemaURL))
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error making schema request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("schema request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var schemaResponse wapiSchemaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&schemaResponse); err != nil {
+		return "", fmt.Errorf("error decoding schema response: %w", err)
+	}
+
+	return SelectWAPIVersion(schemaResponse.SupportedVersions)
+}
+
+// SetWAPIVersion makes every WAPI service client use the given WAPI version
+func SetWAPIVersion(client *niosclient.APIClient, version string) {
+	basePath := "/wapi/v" + NormalizeWAPIVersion(version)
+	client.ACLAPI.Cfg.Servers[0].URL = client.ACLAPI.Cfg.NIOSHostURL + basePath
+	client.CloudAPI.Cfg.Servers[0].URL = client.CloudAPI.Cfg.NIOSHostURL + basePath
+	client.DHCPAPI.Cfg.Servers[0].URL = client.DHCPAPI.Cfg.NIOSHostURL + basePath
+	client.DiscoveryAPI.Cfg.Servers[0].URL = client.DiscoveryAPI.Cfg.NIOSHostURL + basePath
+	client.DNSAPI.Cfg.Servers[0].URL = client.DNSAPI.Cfg.NIOSHostURL + basePath
+	client.DTCAPI.Cfg.Servers[0].URL = client.DTCAPI.Cfg.NIOSHostURL + basePath
+	client.FederatedRealmsAPI.Cfg.Servers[0].URL = client.FederatedRealmsAPI.Cfg.NIOSHostURL + basePath
+	client.GridAPI.Cfg.Servers[0].URL = client.GridAPI.Cfg.NIOSHostURL + basePath
+	client.IPAMAPI.Cfg.Servers[0].URL = client.IPAMAPI.Cfg.NIOSHostURL + basePath
+	client.MicrosoftAPI.Cfg.Servers[0].URL = client.MicrosoftAPI.Cfg.NIOSHostURL + basePath
+	client.MiscAPI.Cfg.Servers[0].URL = client.MiscAPI.Cfg.NIOSHostURL + basePath
+	client.NotificationAPI.Cfg.Servers[0].URL = client.NotificationAPI.Cfg.NIOSHostURL + basePath
+	client.ParentalControlAPI.Cfg.Servers[0].URL = client.ParentalControlAPI.Cfg.NIOSHostURL + basePath
+	client.RIRAPI.Cfg.Servers[0].URL = client.RIRAPI.Cfg.NIOSHostURL + basePath
+	client.RPZAPI.Cfg.Servers[0].URL = client.RPZAPI.Cfg.NIOSHostURL + basePath
+	client.SecurityAPI.Cfg.Servers[0].URL = client.SecurityAPI.Cfg.NIOSHostURL + basePath
+	client.SmartFolderAPI.Cfg.Servers[0].URL = client.SmartFolderAPI.Cfg.NIOSHostURL + basePath
+	client.ThreatInsightAPI.Cfg.Servers[0].URL = client.ThreatInsightAPI.Cfg.NIOSHostURL + basePath
+	client.ThreatProtectionAPI.Cfg.Servers[0].URL = client.ThreatProtectionAPI.Cfg.NIOSHostURL + basePath
+}
+
+// WAPIBaseURL returns the versioned WAPI base URL of a service client configuration, e.g. https://host/wapi/v2.13.6
+func WAPIBaseURL(cfg WAPIConfiguration) string {
+	serverURL, err := cfg.ServerURL(0, nil)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(serverURL, "/")
+}
+
+// WAPIVersion returns the WAPI version used by the client
+func WAPIVersion(client *niosclient.APIClient) string {
+	if client == nil {
+		return ""
+	}
+	matches := wapiPathRegex.FindStringSubmatch(WAPIBaseURL(client.GridAPI.Cfg))
+	if len(matches) != 2 {
+		return ""
+	}
+	return matches[1]
+}
+
+// RequireWAPIVersion returns an error if the WAPI version used by the client is older than minVersion.
+// Resources use it to reject unsupported fields at plan time. It returns nil if the client is not configured yet.
+func RequireWAPIVersion(client *niosclient.APIClient, minVersion, feature string) error {
+	version := WAPIVersion(client)
+	if version == "" || CompareWAPIVersions(version, minVersion) >= 0 {
+		return nil
+	}
+	return fmt.Errorf("%s requires WAPI >= %s, but the provider is configured with WAPI %s", feature, minVersion, version)
+}
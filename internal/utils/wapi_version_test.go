@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	niosclient "github.com/infobloxopen/infoblox-nios-go-client/client"
+	"github.com/infobloxopen/infoblox-nios-go-client/option"
+)
+
+// TestCompareWAPIVersions tests the ordering of WAPI versions
+func TestCompareWAPIVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "2.13.6", b: "2.13.6", expected: 0},
+		{a: "v2.13.6", b: "2.13.6", expected: 0},
+		{a: "2.13", b: "2.13.0", expected: 0},
+		{a: "2.12.3", b: "2.13", expected: -1},
+		{a: "2.9", b: "2.10", expected: -1},
+		{a: "2.13.7", b: "2.13.6", expected: 1},
+	}
+
+	for _, tt := range tests {
+		if got := CompareWAPIVersions(tt.a, tt.b); got != tt.expected {
+			t.Errorf("CompareWAPIVersions(%q, %q): expected %d, got %d", tt.a, tt.b, tt.expected, got)
+		}
+	}
+}
+
+// TestSelectWAPIVersion tests the selection of the WAPI version from the versions supported by the Grid
+func TestSelectWAPIVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		supported []string
+		expected  string
+		expectErr bool
+	}{
+		{name: "default supported", supported: []string{"1.0", "2.12.3", "2.13.6", "2.13.7"}, expected: "2.13.6"},
+		{name: "older grid", supported: []string{"1.0", "2.9", "2.12.3"}, expected: "2.12.3"},
+		{name: "only newer versions", supported: []string{"2.14", "2.13.7"}, expected: "2.13.7"},
+		{name: "no versions", supported: nil, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SelectWAPIVersion(tt.supported)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("Expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestDetectWAPIVersion tests that the WAPI version is detected from the ?_schema response
+func TestDetectWAPIVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/wapi/v1.0/" || !r.URL.Query().Has("_schema") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"requested_version": "1.0", "supported_versions": ["1.0", "2.11", "2.12.3"]}`))
+	}))
+	defer server.Close()
+
+	version, err := DetectWAPIVersion(context.Background(), server.Client(), server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if version != "2.12.3" {
+		t.Errorf("Expected 2.12.3, got %s", version)
+	}
+}
+
+// TestRequireWAPIVersion tests that features newer than the configured WAPI version are rejected
+func TestRequireWAPIVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		expectError bool
+	}{
+		{"older version", "2.11", true},
+		{"same version", "2.12", false},
+		{"newer version", "2.13.6", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := niosclient.NewAPIClient(option.WithNIOSHostUrl("https://grid.example.com"))
+			SetWAPIVersion(client, tt.version)
+
+			err := RequireWAPIVersion(client, "2.12", "nios_dns_record_alias")
+			if tt.expectError && err == nil {
+				t.Errorf("Expected an error for WAPI %s, got nil", tt.version)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error for WAPI %s, got: %v", tt.version, err)
+			}
+		})
+	}
+
+	if err := RequireWAPIVersion(nil, "2.12", "nios_dns_record_alias"); err != nil {
+		t.Errorf("Expected no error for an unconfigured client, got: %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that the value is a positive Go duration string.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "Ensures the string is a positive duration such as 30s, 10m or 1h30m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures the string is a positive duration such as `30s`, `10m` or `1h30m`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("Expected a positive duration consisting of numbers and unit suffixes such as \"30s\", \"10m\" or \"1h30m\", Got: %s", value),
+		)
+	}
+}
+
+func ValidateDuration() validator.String {
+	return durationValidator{}
+}
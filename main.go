@@ -4,10 +4,12 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"github.com/infobloxopen/terraform-provider-nios/internal/provider"
+	"github.com/infobloxopen/terraform-provider-nios/internal/transport"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -30,6 +32,10 @@ var (
 	// https://goreleaser.com/cookbooks/using-main.version/
 )
 
+// logoutTimeout bounds the time spent closing the NIOS sessions on shutdown, Terraform kills
+// providers that do not exit shortly after being stopped
+const logoutTimeout = 2 * time.Second
+
 func main() {
 	var debug bool
 
@@ -43,6 +49,11 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version, commit), opts)
 
+	// Terraform stops the provider once it is done with it, close the NIOS sessions it opened
+	ctx, cancel := context.WithTimeout(context.Background(), logoutTimeout)
+	transport.CloseSessions(ctx)
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}